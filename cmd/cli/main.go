@@ -1,45 +1,280 @@
 package main
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"io"
 	"os"
+	"os/signal"
+	"os/user"
+	"path/filepath"
+	"syscall"
+	_ "time/tzdata"
 
+	"github.com/spf13/pflag"
+	"gorm.io/driver/postgres"
 	"gorm.io/driver/sqlite"
 	"gorm.io/gorm"
+	"transaction/internal/adapter/exchange/binance"
+	"transaction/internal/adapter/notifier"
+	"transaction/internal/adapter/notifier/console"
+	"transaction/internal/adapter/notifier/file"
+	"transaction/internal/adapter/notifier/webhook"
+	"transaction/internal/adapter/repository"
+	"transaction/internal/adapter/repository/gormrepo"
+	pgrepo "transaction/internal/adapter/repository/postgres"
 	sqliterepo "transaction/internal/adapter/repository/sqlite"
+	"transaction/internal/config"
+	"transaction/internal/domain"
 	"transaction/internal/interface/cli"
+	"transaction/internal/usecase/feed"
+	"transaction/internal/usecase/market"
+	"transaction/internal/usecase/monitor"
+	"transaction/internal/usecase/notification"
+	"transaction/internal/usecase/portfolio"
+	"transaction/internal/usecase/schema"
+	signalusecase "transaction/internal/usecase/signal"
 	"transaction/internal/usecase/strategy"
+	"transaction/internal/usecase/trade"
 	"transaction/pkg/logger"
 )
 
+// actorEnv overrides the user recorded in the strategy audit log.
+const actorEnv = "STRATEGY_ACTOR"
+
+// legacyDatabase is where versions without a configuration kept the database.
+const legacyDatabase = "strategies.db"
+
 func main() {
-	// Initialize database connection
-	db, err := gorm.Open(sqlite.Open("strategies.db"), &gorm.Config{})
-	if err != nil {
-		fmt.Fprintf(os.Stderr, "Failed to connect to database: %v\n", err)
-		os.Exit(1)
-	}
+	args := os.Args[1:]
+
+	// Cancel in-flight work when the process is interrupted
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	// Attribute strategy changes to the user running the command
+	ctx = domain.WithActor(ctx, currentActor())
 
-	// Run database migrations
-	if err := sqliterepo.Migrate(db); err != nil {
-		fmt.Fprintf(os.Stderr, "Failed to run migrations: %v\n", err)
+	// Resolve the configuration from the file, the environment and the flags.
+	// The config commands report problems themselves.
+	flags, command := parseGlobalFlags(args)
+	cfg, _, err := config.Load(flags, os.Getenv)
+	if err != nil && command != "config" {
+		fmt.Fprintf(os.Stderr, "Failed to load configuration: %v\n", err)
 		os.Exit(1)
 	}
+	if cfg == nil {
+		cfg = config.Default()
+	}
 
-	// Initialize dependencies
-	repo := sqliterepo.NewStrategyRepository(db)
-	log := logger.NewSimpleLogger()
-	svc := strategy.NewStrategyService(repo, log)
+	log := logger.NewSimpleLoggerWithLevel(cfg.LogLevel())
+	rootCmd := &cli.RootCommand{Logger: log}
 
-	// Create root command
-	rootCmd := &cli.RootCommand{
-		StrategyService: svc,
-		Logger:          log,
+	// Open the database and build the services, unless the command line only
+	// asks for help, manages the configuration or is rejected by the parser
+	if rootCmd.NeedsServices(args) {
+		if err := wire(ctx, rootCmd, cfg, command, flags.Ephemeral); err != nil {
+			fmt.Fprintf(os.Stderr, "%v\n", err)
+			os.Exit(1)
+		}
 	}
 
 	// Execute command
-	if err := rootCmd.Execute(os.Args[1:]); err != nil {
+	if err := rootCmd.Execute(ctx, args); err != nil {
 		log.Error("Command execution failed: " + err.Error())
 		os.Exit(1)
 	}
 }
+
+// parseGlobalFlags reads the configuration flags ahead of the command line
+// parser, which runs only after the services are built, and returns them with
+// the name of the top-level command.
+func parseGlobalFlags(args []string) (*config.Flags, string) {
+	flags := &config.Flags{}
+	fs := pflag.NewFlagSet("global", pflag.ContinueOnError)
+	fs.ParseErrorsWhitelist.UnknownFlags = true
+	fs.Usage = func() {}
+	fs.SetOutput(io.Discard)
+	config.BindFlags(fs, flags)
+
+	// Errors are reported again by the command line parser
+	_ = fs.Parse(args)
+
+	command := ""
+	if fs.NArg() > 0 {
+		command = fs.Arg(0)
+	}
+	return flags, command
+}
+
+// wire opens the database described by cfg, or an empty one in memory when
+// ephemeral is set, and sets the services of rootCmd.
+func wire(ctx context.Context, rootCmd *cli.RootCommand, cfg *config.Config, command string, ephemeral bool) error {
+	log := rootCmd.Logger
+
+	// Initialize database connection
+	var repos *repositories
+	if ephemeral {
+		log.Warn("Running ephemeral: changes are kept in memory and discarded on exit")
+		db, err := openEphemeralDatabase()
+		if err != nil {
+			return fmt.Errorf("failed to create in-memory database: %w", err)
+		}
+		repos = newRepositories(config.DriverSQLite, db)
+	} else {
+		if cfg.Database.Driver == config.DriverSQLite && cfg.Database.Path == config.Default().Database.Path {
+			warnLegacyDatabase(cfg.Database.Path, log)
+		}
+		db, err := openDatabase(cfg.Database, log)
+		if err != nil {
+			return fmt.Errorf("failed to connect to database: %w", err)
+		}
+		repos = newRepositories(cfg.Database.Driver, db)
+	}
+
+	// Initialize dependencies
+	schemaSvc := schema.NewSchemaService(repos.migrator, log)
+	marketSvc := market.NewMarketService(repos.markets, log)
+	svc := strategy.NewStrategyService(repos.strategies, repos.markets, repos.audits, repos.transactor, log)
+	signalSvc := signalusecase.NewSignalService(repos.signals, log)
+	tradeSvc := trade.NewTradeService(repos.trades, repos.markets, repos.transactor, log)
+	priceFeed := binance.NewClient(binance.DefaultBaseURL, nil)
+	portfolioSvc := portfolio.NewPortfolioService(repos.trades, priceFeed, log)
+	notificationSvc := notification.NewNotificationService(repos.notifications, newNotifier(cfg.Notify), log)
+	liveFeed := feed.NewFeedService(log)
+	priceMonitor := monitor.NewPriceMonitor(repos.strategies, repos.signals, priceFeed, notificationSvc, liveFeed, log, cfg.Monitor.Interval)
+
+	// Bring the schema up to date, unless the command manages the schema itself
+	if !managesSchema(command) {
+		if _, err := repos.migrator.Up(ctx); err != nil {
+			return fmt.Errorf("failed to run migrations: %w", err)
+		}
+
+		// Register the bundled markets missing from the registry
+		if _, err := marketSvc.SeedDefaults(ctx); err != nil {
+			return fmt.Errorf("failed to seed markets: %w", err)
+		}
+	}
+
+	rootCmd.StrategyService = svc
+	rootCmd.MarketService = marketSvc
+	rootCmd.SignalService = signalSvc
+	rootCmd.NotificationService = notificationSvc
+	rootCmd.TradeService = tradeSvc
+	rootCmd.PortfolioService = portfolioSvc
+	rootCmd.PriceMonitor = priceMonitor
+	rootCmd.LiveFeed = liveFeed
+	rootCmd.SchemaService = schemaSvc
+	return nil
+}
+
+// repositories are the adapters of one database backend.
+type repositories struct {
+	strategies    repository.IStrategyRepository
+	signals       repository.ISignalRepository
+	notifications repository.INotificationRepository
+	trades        repository.ITradeRepository
+	markets       repository.IMarketRepository
+	audits        repository.IStrategyAuditRepository
+	transactor    repository.ITransactor
+	migrator      repository.IMigrator
+}
+
+// newRepositories builds the repositories of the configured driver on db.
+// Only the migrations and the SQL dialect differ between drivers.
+func newRepositories(driver string, db *gorm.DB) *repositories {
+	dialect, migrator := sqliterepo.Dialect, sqliterepo.NewMigrator(db)
+	if driver == config.DriverPostgres {
+		dialect, migrator = pgrepo.Dialect, pgrepo.NewMigrator(db)
+	}
+	return &repositories{
+		strategies:    gormrepo.NewStrategyRepository(db, dialect),
+		signals:       gormrepo.NewSignalRepository(db),
+		notifications: gormrepo.NewNotificationRepository(db),
+		trades:        gormrepo.NewTradeRepository(db, dialect),
+		markets:       gormrepo.NewMarketRepository(db),
+		audits:        gormrepo.NewStrategyAuditRepository(db),
+		transactor:    gormrepo.NewTransactor(db),
+		migrator:      migrator,
+	}
+}
+
+// openDatabase connects to the configured database. A new SQLite database is
+// created along with its directory and announced, so a mistyped path does not
+// go unnoticed.
+func openDatabase(cfg config.DatabaseConfig, log logger.Logger) (*gorm.DB, error) {
+	if cfg.Driver == config.DriverPostgres {
+		return gorm.Open(postgres.Open(cfg.DSN), &gorm.Config{})
+	}
+
+	if _, err := os.Stat(cfg.Path); errors.Is(err, os.ErrNotExist) {
+		if err := os.MkdirAll(filepath.Dir(cfg.Path), 0o755); err != nil {
+			return nil, err
+		}
+		log.Info("Creating new database", "path", cfg.Path)
+	}
+	return gorm.Open(sqlite.Open(cfg.Path), &gorm.Config{})
+}
+
+// openEphemeralDatabase opens an empty SQLite database living in memory. It is
+// limited to one connection because every connection to :memory: would
+// otherwise see a database of its own.
+func openEphemeralDatabase() (*gorm.DB, error) {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	if err != nil {
+		return nil, err
+	}
+	sqlDB, err := db.DB()
+	if err != nil {
+		return nil, err
+	}
+	sqlDB.SetMaxOpenConns(1)
+	return db, nil
+}
+
+// warnLegacyDatabase points out a database left in the working directory by
+// versions without a configuration when the default database is used instead.
+func warnLegacyDatabase(path string, log logger.Logger) {
+	if _, err := os.Stat(legacyDatabase); err != nil {
+		return
+	}
+	legacy, err := filepath.Abs(legacyDatabase)
+	if err != nil || legacy == path {
+		return
+	}
+	log.Warn("Found " + legacy + " but using " + path + "; move it there or set database.path to keep using it")
+}
+
+// managesSchema reports whether command is the db command, which applies and
+// rolls back migrations itself and must see the schema as it is.
+func managesSchema(command string) bool {
+	return command == "db"
+}
+
+// currentActor names the user recorded in the strategy audit log. The
+// STRATEGY_ACTOR environment variable overrides the operating system user.
+func currentActor() string {
+	if actor := os.Getenv(actorEnv); actor != "" {
+		return actor
+	}
+	if u, err := user.Current(); err == nil && u.Username != "" {
+		return u.Username
+	}
+	return os.Getenv("USER")
+}
+
+// newNotifier builds the notification dispatcher. Signals are always printed to
+// the terminal; the file and webhook sinks are enabled through the configuration.
+func newNotifier(cfg config.NotifyConfig) notifier.INotifier {
+	sinks := []notifier.INotifier{
+		notifier.WithRetry(console.NewNotifier(os.Stdout), notifier.DefaultRetryPolicy),
+	}
+	if path := cfg.File; path != "" {
+		sinks = append(sinks, notifier.WithRetry(file.NewNotifier(path), notifier.DefaultRetryPolicy))
+	}
+	if url := cfg.Webhook; url != "" {
+		sinks = append(sinks, notifier.WithRetry(webhook.NewNotifier(url, nil), notifier.DefaultRetryPolicy))
+	}
+	return notifier.NewDispatcher(sinks...)
+}
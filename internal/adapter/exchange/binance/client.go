@@ -0,0 +1,171 @@
+package binance
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"transaction/internal/adapter/exchange"
+	"transaction/internal/domain"
+
+	"github.com/shopspring/decimal"
+)
+
+const (
+	// DefaultBaseURL is the public Binance REST endpoint.
+	DefaultBaseURL = "https://api.binance.com"
+
+	defaultTimeout = 10 * time.Second
+	tickerPath     = "/api/v3/ticker/price"
+)
+
+// Client implements the IPriceFeed interface against the Binance REST ticker API.
+// Any server exposing a Binance-compatible /api/v3/ticker/price endpoint can be used.
+type Client struct {
+	baseURL    string
+	httpClient *http.Client
+}
+
+// tickerPrice is the payload returned by the ticker price endpoint.
+type tickerPrice struct {
+	Symbol string `json:"symbol"`
+	Price  string `json:"price"`
+}
+
+// apiError is the payload returned by Binance on failed requests.
+type apiError struct {
+	Code int    `json:"code"`
+	Msg  string `json:"msg"`
+}
+
+// NewClient creates a new Binance-backed IPriceFeed.
+// An empty baseURL falls back to DefaultBaseURL and a nil httpClient to one with a 10s timeout.
+func NewClient(baseURL string, httpClient *http.Client) exchange.IPriceFeed {
+	if baseURL == "" {
+		baseURL = DefaultBaseURL
+	}
+	if httpClient == nil {
+		httpClient = &http.Client{Timeout: defaultTimeout}
+	}
+	return &Client{
+		baseURL:    strings.TrimRight(baseURL, "/"),
+		httpClient: httpClient,
+	}
+}
+
+// GetPrice retrieves the latest price for a symbol.
+func (c *Client) GetPrice(ctx context.Context, symbol string) (decimal.Decimal, error) {
+	query := url.Values{}
+	query.Set("symbol", ToExchangeSymbol(symbol))
+
+	var ticker tickerPrice
+	if err := c.get(ctx, query, &ticker); err != nil {
+		return decimal.Zero, fmt.Errorf("failed to get price for %s: %w", symbol, err)
+	}
+
+	price, err := parsePrice(ticker)
+	if err != nil {
+		return decimal.Zero, fmt.Errorf("failed to get price for %s: %w", symbol, err)
+	}
+	return price, nil
+}
+
+// GetPrices retrieves the latest prices for several symbols in a single request.
+func (c *Client) GetPrices(ctx context.Context, symbols []string) (map[string]decimal.Decimal, error) {
+	prices := make(map[string]decimal.Decimal, len(symbols))
+	if len(symbols) == 0 {
+		return prices, nil
+	}
+
+	// Several of our symbols may map to the same exchange symbol (BTC and BTC/USDT).
+	requested := make(map[string][]string, len(symbols))
+	exchangeSymbols := make([]string, 0, len(symbols))
+	for _, symbol := range symbols {
+		exchangeSymbol := ToExchangeSymbol(symbol)
+		if _, ok := requested[exchangeSymbol]; !ok {
+			exchangeSymbols = append(exchangeSymbols, exchangeSymbol)
+		}
+		requested[exchangeSymbol] = append(requested[exchangeSymbol], symbol)
+	}
+
+	encoded, err := json.Marshal(exchangeSymbols)
+	if err != nil {
+		return nil, err
+	}
+	query := url.Values{}
+	query.Set("symbols", string(encoded))
+
+	var tickers []tickerPrice
+	if err := c.get(ctx, query, &tickers); err != nil {
+		return nil, fmt.Errorf("failed to get prices: %w", err)
+	}
+
+	for _, ticker := range tickers {
+		price, err := parsePrice(ticker)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get prices: %w", err)
+		}
+		for _, symbol := range requested[ticker.Symbol] {
+			prices[symbol] = price
+		}
+	}
+
+	for _, symbol := range symbols {
+		if _, ok := prices[symbol]; !ok {
+			return nil, fmt.Errorf("failed to get price for %s: %w", symbol, domain.ErrPriceUnavailable)
+		}
+	}
+	return prices, nil
+}
+
+// get performs a GET request against the ticker endpoint and decodes the JSON body into out.
+func (c *Client) get(ctx context.Context, query url.Values, out interface{}) error {
+	endpoint := c.baseURL + tickerPath + "?" + query.Encode()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return err
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		var apiErr apiError
+		if decodeErr := json.NewDecoder(resp.Body).Decode(&apiErr); decodeErr == nil && apiErr.Msg != "" {
+			return fmt.Errorf("binance returned status %d: %s (code %d): %w",
+				resp.StatusCode, apiErr.Msg, apiErr.Code, domain.ErrPriceUnavailable)
+		}
+		return fmt.Errorf("binance returned status %d: %w", resp.StatusCode, domain.ErrPriceUnavailable)
+	}
+
+	return json.NewDecoder(resp.Body).Decode(out)
+}
+
+// parsePrice converts the string price of a ticker into an exact decimal.
+func parsePrice(ticker tickerPrice) (decimal.Decimal, error) {
+	price, err := decimal.NewFromString(ticker.Price)
+	if err != nil {
+		return decimal.Zero, fmt.Errorf("invalid price %q for %s: %w", ticker.Price, ticker.Symbol, err)
+	}
+	return price, nil
+}
+
+// ToExchangeSymbol converts a strategy symbol into a Binance trading pair.
+// "BTC/USDT" and "btc-usdt" become "BTCUSDT"; a bare base asset such as "BTC" is quoted in domain.DefaultQuoteAsset.
+func ToExchangeSymbol(symbol string) string {
+	symbol = strings.ToUpper(strings.TrimSpace(symbol))
+	if strings.ContainsAny(symbol, "/-_") {
+		return strings.NewReplacer("/", "", "-", "", "_", "").Replace(symbol)
+	}
+	if strings.HasSuffix(symbol, domain.DefaultQuoteAsset) && len(symbol) > len(domain.DefaultQuoteAsset) {
+		return symbol
+	}
+	return symbol + domain.DefaultQuoteAsset
+}
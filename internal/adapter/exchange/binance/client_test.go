@@ -0,0 +1,144 @@
+package binance
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"transaction/internal/domain"
+)
+
+// newTestServer starts a Binance-compatible ticker server backed by a static price table.
+func newTestServer(t *testing.T, prices map[string]string) *httptest.Server {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != tickerPath {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		if raw := r.URL.Query().Get("symbols"); raw != "" {
+			var symbols []string
+			require.NoError(t, json.Unmarshal([]byte(raw), &symbols))
+
+			tickers := make([]tickerPrice, 0, len(symbols))
+			for _, symbol := range symbols {
+				price, ok := prices[symbol]
+				if !ok {
+					writeError(w, -1121, "Invalid symbol.")
+					return
+				}
+				tickers = append(tickers, tickerPrice{Symbol: symbol, Price: price})
+			}
+			_ = json.NewEncoder(w).Encode(tickers)
+			return
+		}
+
+		symbol := r.URL.Query().Get("symbol")
+		price, ok := prices[symbol]
+		if !ok {
+			writeError(w, -1121, "Invalid symbol.")
+			return
+		}
+		_ = json.NewEncoder(w).Encode(tickerPrice{Symbol: symbol, Price: price})
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func writeError(w http.ResponseWriter, code int, msg string) {
+	w.WriteHeader(http.StatusBadRequest)
+	_ = json.NewEncoder(w).Encode(apiError{Code: code, Msg: msg})
+}
+
+func TestGetPrice_Success(t *testing.T) {
+	server := newTestServer(t, map[string]string{"BTCUSDT": "60123.45000000"})
+	client := NewClient(server.URL, server.Client())
+
+	price, err := client.GetPrice(context.Background(), "BTC")
+	require.NoError(t, err)
+	assert.Equal(t, "60123.45", price.String())
+}
+
+func TestGetPrice_InvalidSymbol(t *testing.T) {
+	server := newTestServer(t, map[string]string{"BTCUSDT": "60000"})
+	client := NewClient(server.URL, server.Client())
+
+	_, err := client.GetPrice(context.Background(), "NOPE")
+	assert.Error(t, err)
+	assert.ErrorIs(t, err, domain.ErrPriceUnavailable)
+	assert.Contains(t, err.Error(), "Invalid symbol.")
+}
+
+func TestGetPrice_MalformedPrice(t *testing.T) {
+	server := newTestServer(t, map[string]string{"BTCUSDT": "not-a-number"})
+	client := NewClient(server.URL, server.Client())
+
+	_, err := client.GetPrice(context.Background(), "BTC")
+	assert.Error(t, err)
+}
+
+func TestGetPrice_ContextCancelled(t *testing.T) {
+	server := newTestServer(t, map[string]string{"BTCUSDT": "60000"})
+	client := NewClient(server.URL, server.Client())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := client.GetPrice(ctx, "BTC")
+	assert.ErrorIs(t, err, context.Canceled)
+}
+
+func TestGetPrices_Success(t *testing.T) {
+	server := newTestServer(t, map[string]string{
+		"BTCUSDT": "60000.00",
+		"ETHUSDT": "3000.50",
+	})
+	client := NewClient(server.URL, server.Client())
+
+	prices, err := client.GetPrices(context.Background(), []string{"BTC", "ETH/USDT", "BTC/USDT"})
+	require.NoError(t, err)
+	require.Len(t, prices, 3)
+	assert.Equal(t, "60000", prices["BTC"].String())
+	assert.Equal(t, "3000.5", prices["ETH/USDT"].String())
+	assert.Equal(t, "60000", prices["BTC/USDT"].String())
+}
+
+func TestGetPrices_Empty(t *testing.T) {
+	client := NewClient("http://127.0.0.1:0", nil)
+
+	prices, err := client.GetPrices(context.Background(), nil)
+	require.NoError(t, err)
+	assert.Empty(t, prices)
+}
+
+func TestGetPrices_InvalidSymbol(t *testing.T) {
+	server := newTestServer(t, map[string]string{"BTCUSDT": "60000"})
+	client := NewClient(server.URL, server.Client())
+
+	_, err := client.GetPrices(context.Background(), []string{"BTC", "NOPE"})
+	assert.ErrorIs(t, err, domain.ErrPriceUnavailable)
+}
+
+func TestToExchangeSymbol(t *testing.T) {
+	tests := []struct {
+		name   string
+		symbol string
+		expect string
+	}{
+		{name: "bare base asset", symbol: "BTC", expect: "BTCUSDT"},
+		{name: "slash separated pair", symbol: "ETH/USDT", expect: "ETHUSDT"},
+		{name: "dash separated pair", symbol: "sol-usdc", expect: "SOLUSDC"},
+		{name: "already an exchange symbol", symbol: "BNBUSDT", expect: "BNBUSDT"},
+		{name: "surrounding whitespace", symbol: " btc ", expect: "BTCUSDT"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expect, ToExchangeSymbol(tt.symbol))
+		})
+	}
+}
@@ -0,0 +1,19 @@
+package exchange
+
+import (
+	"context"
+
+	"github.com/shopspring/decimal"
+)
+
+// IPriceFeed defines the interface for retrieving market prices from an exchange.
+type IPriceFeed interface {
+	// GetPrice retrieves the latest price for a symbol.
+	// Returns ErrPriceUnavailable if the feed has no price for the symbol.
+	GetPrice(ctx context.Context, symbol string) (decimal.Decimal, error)
+
+	// GetPrices retrieves the latest prices for several symbols in one call.
+	// The result is keyed by the symbols passed in.
+	// Returns ErrPriceUnavailable if any of the symbols has no price.
+	GetPrices(ctx context.Context, symbols []string) (map[string]decimal.Decimal, error)
+}
@@ -0,0 +1,63 @@
+package static
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"transaction/internal/domain"
+
+	"github.com/shopspring/decimal"
+)
+
+// PriceFeed implements the IPriceFeed interface from an in-memory price table.
+// It is intended for tests and dry runs where no exchange should be contacted.
+type PriceFeed struct {
+	mu     sync.RWMutex
+	prices map[string]decimal.Decimal
+}
+
+// NewPriceFeed creates a new PriceFeed seeded with the given prices.
+func NewPriceFeed(prices map[string]decimal.Decimal) *PriceFeed {
+	feed := &PriceFeed{prices: make(map[string]decimal.Decimal, len(prices))}
+	for symbol, price := range prices {
+		feed.prices[symbol] = price
+	}
+	return feed
+}
+
+// SetPrice sets or replaces the price returned for a symbol.
+func (f *PriceFeed) SetPrice(symbol string, price decimal.Decimal) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.prices[symbol] = price
+}
+
+// GetPrice retrieves the configured price for a symbol.
+func (f *PriceFeed) GetPrice(ctx context.Context, symbol string) (decimal.Decimal, error) {
+	if err := ctx.Err(); err != nil {
+		return decimal.Zero, err
+	}
+
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+
+	price, ok := f.prices[symbol]
+	if !ok {
+		return decimal.Zero, fmt.Errorf("no price for %s: %w", symbol, domain.ErrPriceUnavailable)
+	}
+	return price, nil
+}
+
+// GetPrices retrieves the configured prices for several symbols.
+func (f *PriceFeed) GetPrices(ctx context.Context, symbols []string) (map[string]decimal.Decimal, error) {
+	prices := make(map[string]decimal.Decimal, len(symbols))
+	for _, symbol := range symbols {
+		price, err := f.GetPrice(ctx, symbol)
+		if err != nil {
+			return nil, err
+		}
+		prices[symbol] = price
+	}
+	return prices, nil
+}
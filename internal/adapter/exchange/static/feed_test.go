@@ -0,0 +1,67 @@
+package static
+
+import (
+	"context"
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"transaction/internal/adapter/exchange"
+	"transaction/internal/domain"
+)
+
+func TestPriceFeed_ImplementsIPriceFeed(t *testing.T) {
+	var feed exchange.IPriceFeed = NewPriceFeed(nil)
+	assert.NotNil(t, feed)
+}
+
+func TestGetPrice_Success(t *testing.T) {
+	feed := NewPriceFeed(map[string]decimal.Decimal{"BTC": decimal.NewFromInt(60000)})
+
+	price, err := feed.GetPrice(context.Background(), "BTC")
+	require.NoError(t, err)
+	assert.Equal(t, "60000", price.String())
+}
+
+func TestGetPrice_Unknown(t *testing.T) {
+	feed := NewPriceFeed(map[string]decimal.Decimal{"BTC": decimal.NewFromInt(60000)})
+
+	_, err := feed.GetPrice(context.Background(), "ETH")
+	assert.ErrorIs(t, err, domain.ErrPriceUnavailable)
+}
+
+func TestSetPrice_OverridesPrice(t *testing.T) {
+	feed := NewPriceFeed(map[string]decimal.Decimal{"BTC": decimal.NewFromInt(60000)})
+	feed.SetPrice("BTC", decimal.NewFromInt(58000))
+
+	price, err := feed.GetPrice(context.Background(), "BTC")
+	require.NoError(t, err)
+	assert.Equal(t, "58000", price.String())
+}
+
+func TestGetPrices_Success(t *testing.T) {
+	feed := NewPriceFeed(map[string]decimal.Decimal{"BTC": decimal.NewFromInt(60000), "ETH": decimal.NewFromInt(3000)})
+
+	prices, err := feed.GetPrices(context.Background(), []string{"BTC", "ETH"})
+	require.NoError(t, err)
+	require.Len(t, prices, 2)
+	assert.Equal(t, "60000", prices["BTC"].String())
+	assert.Equal(t, "3000", prices["ETH"].String())
+}
+
+func TestGetPrices_Unknown(t *testing.T) {
+	feed := NewPriceFeed(map[string]decimal.Decimal{"BTC": decimal.NewFromInt(60000)})
+
+	_, err := feed.GetPrices(context.Background(), []string{"BTC", "ETH"})
+	assert.ErrorIs(t, err, domain.ErrPriceUnavailable)
+}
+
+func TestGetPrice_ContextCancelled(t *testing.T) {
+	feed := NewPriceFeed(map[string]decimal.Decimal{"BTC": decimal.NewFromInt(60000)})
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := feed.GetPrice(ctx, "BTC")
+	assert.ErrorIs(t, err, context.Canceled)
+}
@@ -0,0 +1,44 @@
+package console
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+	"time"
+
+	"transaction/internal/adapter/notifier"
+)
+
+// Notifier implements the INotifier interface by printing messages to a terminal.
+type Notifier struct {
+	mu  sync.Mutex
+	out io.Writer
+}
+
+// NewNotifier creates a new console INotifier writing to out, or stdout when out is nil.
+func NewNotifier(out io.Writer) notifier.INotifier {
+	if out == nil {
+		out = os.Stdout
+	}
+	return &Notifier{out: out}
+}
+
+// Name identifies the sink.
+func (n *Notifier) Name() string {
+	return "console"
+}
+
+// Send prints the message title and body on one line.
+func (n *Notifier) Send(ctx context.Context, msg *notifier.Message) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	_, err := fmt.Fprintf(n.out, "[NOTIFY] %s %s: %s\n", time.Now().Format(time.RFC3339), msg.Title, msg.Body)
+	return err
+}
@@ -0,0 +1,32 @@
+package console
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"transaction/internal/adapter/notifier"
+)
+
+func TestSend_WritesLine(t *testing.T) {
+	var out bytes.Buffer
+	n := NewNotifier(&out)
+
+	err := n.Send(context.Background(), &notifier.Message{Title: "BUY signal: BTC", Body: "BTC crossed buy lower"})
+	assert.NoError(t, err)
+	assert.Contains(t, out.String(), "[NOTIFY]")
+	assert.Contains(t, out.String(), "BUY signal: BTC: BTC crossed buy lower\n")
+}
+
+func TestSend_ContextCancelled(t *testing.T) {
+	var out bytes.Buffer
+	n := NewNotifier(&out)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	err := n.Send(ctx, &notifier.Message{Title: "hello"})
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Empty(t, out.String())
+}
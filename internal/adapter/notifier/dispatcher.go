@@ -0,0 +1,43 @@
+package notifier
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+)
+
+// Dispatcher fans a message out to every registered sink concurrently.
+type Dispatcher struct {
+	notifiers []INotifier
+}
+
+// NewDispatcher creates a new INotifier that delivers to all the given sinks.
+func NewDispatcher(notifiers ...INotifier) INotifier {
+	return &Dispatcher{notifiers: notifiers}
+}
+
+// Name identifies the dispatcher.
+func (d *Dispatcher) Name() string {
+	return "dispatcher"
+}
+
+// Send delivers the message to every sink. A failing sink does not prevent
+// delivery to the others; all failures are joined into the returned error.
+func (d *Dispatcher) Send(ctx context.Context, msg *Message) error {
+	errs := make([]error, len(d.notifiers))
+
+	var wg sync.WaitGroup
+	for i, n := range d.notifiers {
+		wg.Add(1)
+		go func(i int, n INotifier) {
+			defer wg.Done()
+			if err := n.Send(ctx, msg); err != nil {
+				errs[i] = fmt.Errorf("%s: %w", n.Name(), err)
+			}
+		}(i, n)
+	}
+	wg.Wait()
+
+	return errors.Join(errs...)
+}
@@ -0,0 +1,93 @@
+package file
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"sync"
+	"time"
+
+	"transaction/internal/adapter/notifier"
+
+	"github.com/shopspring/decimal"
+)
+
+// Notifier implements the INotifier interface by appending one JSON object per
+// message to a file (JSON Lines).
+type Notifier struct {
+	mu   sync.Mutex
+	path string
+}
+
+// record is the JSON line written for each message.
+type record struct {
+	SentAt  time.Time      `json:"sent_at"`
+	Title   string         `json:"title"`
+	Body    string         `json:"body"`
+	Signals []signalRecord `json:"signals,omitempty"`
+}
+
+// signalRecord is the JSON representation of a signal in a record.
+type signalRecord struct {
+	ID            string          `json:"id"`
+	StrategyID    string          `json:"strategy_id"`
+	Symbol        string          `json:"symbol"`
+	Side          string          `json:"side"`
+	TriggerPrice  decimal.Decimal `json:"trigger_price"`
+	ObservedPrice decimal.Decimal `json:"observed_price"`
+	TriggeredAt   time.Time       `json:"triggered_at"`
+}
+
+// NewNotifier creates a new file INotifier appending to path. The file is
+// created on the first message if it does not exist.
+func NewNotifier(path string) notifier.INotifier {
+	return &Notifier{path: path}
+}
+
+// Name identifies the sink.
+func (n *Notifier) Name() string {
+	return "file"
+}
+
+// Send appends the message as a single JSON line.
+func (n *Notifier) Send(ctx context.Context, msg *notifier.Message) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	rec := record{
+		SentAt: time.Now().UTC(),
+		Title:  msg.Title,
+		Body:   msg.Body,
+	}
+	for _, s := range msg.Signals {
+		rec.Signals = append(rec.Signals, signalRecord{
+			ID:            s.ID,
+			StrategyID:    s.StrategyID,
+			Symbol:        s.Symbol,
+			Side:          string(s.Side),
+			TriggerPrice:  s.TriggerPrice,
+			ObservedPrice: s.ObservedPrice,
+			TriggeredAt:   s.TriggeredAt,
+		})
+	}
+
+	line, err := json.Marshal(rec)
+	if err != nil {
+		return err
+	}
+	line = append(line, '\n')
+
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	f, err := os.OpenFile(n.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+	if err != nil {
+		return err
+	}
+	if _, err := f.Write(line); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
@@ -0,0 +1,62 @@
+package file
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"transaction/internal/adapter/notifier"
+	"transaction/internal/domain"
+)
+
+func TestSend_AppendsJSONLines(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "signals.jsonl")
+	n := NewNotifier(path)
+
+	signal := &domain.Signal{
+		ID:            "signal-1",
+		StrategyID:    "strategy-1",
+		Symbol:        "BTC",
+		Side:          domain.SideBuy,
+		TriggerPrice:  decimal.NewFromInt(60000),
+		ObservedPrice: decimal.NewFromInt(59900),
+		TriggeredAt:   time.Date(2025, 11, 5, 10, 0, 0, 0, time.UTC),
+	}
+	require.NoError(t, n.Send(context.Background(), notifier.NewSignalMessage(signal)))
+	require.NoError(t, n.Send(context.Background(), &notifier.Message{Title: "second", Body: "plain"}))
+
+	f, err := os.Open(path)
+	require.NoError(t, err)
+	defer f.Close()
+
+	var records []record
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var rec record
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &rec))
+		records = append(records, rec)
+	}
+	require.NoError(t, scanner.Err())
+
+	require.Len(t, records, 2)
+	assert.Equal(t, "BUY signal: BTC", records[0].Title)
+	require.Len(t, records[0].Signals, 1)
+	assert.Equal(t, "signal-1", records[0].Signals[0].ID)
+	assert.Equal(t, "59900", records[0].Signals[0].ObservedPrice.String())
+	assert.Equal(t, "second", records[1].Title)
+	assert.Empty(t, records[1].Signals)
+}
+
+func TestSend_InvalidPath(t *testing.T) {
+	n := NewNotifier(filepath.Join(t.TempDir(), "missing", "signals.jsonl"))
+
+	err := n.Send(context.Background(), &notifier.Message{Title: "hello"})
+	assert.Error(t, err)
+}
@@ -0,0 +1,55 @@
+package notifier
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"transaction/internal/domain"
+)
+
+// Message is a notification delivered to one or more sinks.
+type Message struct {
+	Title   string
+	Body    string
+	Signals []*domain.Signal
+}
+
+// INotifier defines the interface for delivering notifications to a sink.
+type INotifier interface {
+	// Name identifies the sink in logs and errors.
+	Name() string
+
+	// Send delivers the message. Implementations must honour ctx cancellation.
+	Send(ctx context.Context, msg *Message) error
+}
+
+// NewSignalMessage builds the message announcing a single triggered signal.
+func NewSignalMessage(signal *domain.Signal) *Message {
+	bound := "buy lower"
+	if signal.Side == domain.SideSell {
+		bound = "sell upper"
+	}
+	return &Message{
+		Title: fmt.Sprintf("%s signal: %s", signal.Side, signal.Symbol),
+		Body: fmt.Sprintf("%s crossed %s %s at %s (strategy %s)",
+			signal.Symbol, bound, signal.TriggerPrice, signal.ObservedPrice, signal.StrategyID),
+		Signals: []*domain.Signal{signal},
+	}
+}
+
+// NewDigestMessage builds a single message summarising signals that were held
+// back during quiet hours.
+func NewDigestMessage(signals []*domain.Signal) *Message {
+	lines := make([]string, len(signals))
+	for i, signal := range signals {
+		lines[i] = fmt.Sprintf("%s %s %s at %s (trigger %s)",
+			signal.TriggeredAt.UTC().Format("2006-01-02 15:04"), signal.Side, signal.Symbol,
+			signal.ObservedPrice, signal.TriggerPrice)
+	}
+	return &Message{
+		Title:   fmt.Sprintf("Digest: %d signals during quiet hours", len(signals)),
+		Body:    strings.Join(lines, "\n"),
+		Signals: signals,
+	}
+}
@@ -0,0 +1,168 @@
+package notifier
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"transaction/internal/domain"
+)
+
+// fakeNotifier records the messages it receives and fails a configurable number of times.
+type fakeNotifier struct {
+	mu       sync.Mutex
+	name     string
+	failures int
+	calls    int
+	messages []*Message
+}
+
+func (f *fakeNotifier) Name() string {
+	return f.name
+}
+
+func (f *fakeNotifier) Send(ctx context.Context, msg *Message) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.calls++
+	if f.calls <= f.failures {
+		return errors.New("temporary failure")
+	}
+	f.messages = append(f.messages, msg)
+	return nil
+}
+
+// sinkFunc is a sink sending with a function.
+type sinkFunc func(ctx context.Context, msg *Message) error
+
+func (f sinkFunc) Name() string {
+	return "func"
+}
+
+func (f sinkFunc) Send(ctx context.Context, msg *Message) error {
+	return f(ctx, msg)
+}
+
+// blockingNotifier waits until its context is done.
+type blockingNotifier struct{}
+
+func (b *blockingNotifier) Name() string {
+	return "blocking"
+}
+
+func (b *blockingNotifier) Send(ctx context.Context, msg *Message) error {
+	<-ctx.Done()
+	return ctx.Err()
+}
+
+func TestNewSignalMessage(t *testing.T) {
+	signal := &domain.Signal{
+		StrategyID:    "strategy-1",
+		Symbol:        "BTC/USDT",
+		Side:          domain.SideSell,
+		TriggerPrice:  decimal.NewFromInt(70000),
+		ObservedPrice: decimal.RequireFromString("70100.25"),
+	}
+
+	msg := NewSignalMessage(signal)
+	assert.Equal(t, "SELL signal: BTC/USDT", msg.Title)
+	assert.Equal(t, "BTC/USDT crossed sell upper 70000 at 70100.25 (strategy strategy-1)", msg.Body)
+	assert.Equal(t, []*domain.Signal{signal}, msg.Signals)
+}
+
+func TestNewDigestMessage(t *testing.T) {
+	at := time.Date(2025, 11, 5, 23, 0, 0, 0, time.UTC)
+	signals := []*domain.Signal{
+		{Symbol: "BTC/USDT", Side: domain.SideBuy, TriggerPrice: decimal.NewFromInt(60000), ObservedPrice: decimal.NewFromInt(59900), TriggeredAt: at},
+		{Symbol: "ETH/USDT", Side: domain.SideSell, TriggerPrice: decimal.NewFromInt(3000), ObservedPrice: decimal.NewFromInt(3050), TriggeredAt: at.Add(time.Hour)},
+	}
+
+	msg := NewDigestMessage(signals)
+	assert.Equal(t, "Digest: 2 signals during quiet hours", msg.Title)
+	assert.Equal(t, "2025-11-05 23:00 BUY BTC/USDT at 59900 (trigger 60000)\n"+
+		"2025-11-06 00:00 SELL ETH/USDT at 3050 (trigger 3000)", msg.Body)
+	assert.Len(t, msg.Signals, 2)
+}
+
+func TestDispatcher_DeliversToAllSinks(t *testing.T) {
+	first := &fakeNotifier{name: "first"}
+	second := &fakeNotifier{name: "second"}
+	dispatcher := NewDispatcher(first, second)
+
+	err := dispatcher.Send(context.Background(), &Message{Title: "hello"})
+	assert.NoError(t, err)
+	assert.Len(t, first.messages, 1)
+	assert.Len(t, second.messages, 1)
+}
+
+func TestDispatcher_FailingSinkDoesNotBlockOthers(t *testing.T) {
+	failing := &fakeNotifier{name: "failing", failures: 1}
+	healthy := &fakeNotifier{name: "healthy"}
+	dispatcher := NewDispatcher(failing, healthy)
+
+	err := dispatcher.Send(context.Background(), &Message{Title: "hello"})
+	assert.ErrorContains(t, err, "failing: temporary failure")
+	assert.Len(t, healthy.messages, 1)
+}
+
+func TestWithRetry_SucceedsAfterFailures(t *testing.T) {
+	sink := &fakeNotifier{name: "flaky", failures: 2}
+	retrying := WithRetry(sink, RetryPolicy{Attempts: 3, Backoff: time.Millisecond})
+
+	err := retrying.Send(context.Background(), &Message{Title: "hello"})
+	assert.NoError(t, err)
+	assert.Equal(t, 3, sink.calls)
+	assert.Equal(t, "flaky", retrying.Name())
+}
+
+func TestWithRetry_GivesUpAfterAttempts(t *testing.T) {
+	sink := &fakeNotifier{name: "broken", failures: 5}
+	retrying := WithRetry(sink, RetryPolicy{Attempts: 2, Backoff: time.Millisecond})
+
+	err := retrying.Send(context.Background(), &Message{Title: "hello"})
+	assert.EqualError(t, err, "temporary failure")
+	assert.Equal(t, 2, sink.calls)
+}
+
+func TestWithRetry_StopsOnPermanentError(t *testing.T) {
+	calls := 0
+	sink := sinkFunc(func(ctx context.Context, msg *Message) error {
+		calls++
+		return Permanent(errors.New("rejected"))
+	})
+	retrying := WithRetry(sink, RetryPolicy{Attempts: 3, Backoff: time.Millisecond})
+
+	err := retrying.Send(context.Background(), &Message{Title: "hello"})
+	assert.EqualError(t, err, "rejected")
+	assert.True(t, IsPermanent(err))
+	assert.Equal(t, 1, calls)
+}
+
+func TestWithRetry_TimesOutEachAttempt(t *testing.T) {
+	retrying := WithRetry(&blockingNotifier{}, RetryPolicy{Attempts: 2, Backoff: time.Millisecond, Timeout: 10 * time.Millisecond})
+
+	start := time.Now()
+	err := retrying.Send(context.Background(), &Message{Title: "hello"})
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Less(t, time.Since(start), time.Second)
+}
+
+func TestWithRetry_StopsWhenContextCancelled(t *testing.T) {
+	sink := &fakeNotifier{name: "broken", failures: 5}
+	retrying := WithRetry(sink, RetryPolicy{Attempts: 5, Backoff: time.Hour})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		cancel()
+	}()
+
+	err := retrying.Send(ctx, &Message{Title: "hello"})
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Equal(t, 1, sink.calls)
+}
@@ -0,0 +1,96 @@
+package notifier
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// RetryPolicy controls how often and how long a sink is tried.
+type RetryPolicy struct {
+	Attempts int           // Total number of tries, at least 1
+	Backoff  time.Duration // Wait before the second try, doubled after every failure
+	Timeout  time.Duration // Deadline for each try, zero means no deadline
+}
+
+// DefaultRetryPolicy is used for the built-in sinks.
+var DefaultRetryPolicy = RetryPolicy{
+	Attempts: 3,
+	Backoff:  500 * time.Millisecond,
+	Timeout:  5 * time.Second,
+}
+
+// permanentError marks a failure that trying again cannot fix.
+type permanentError struct {
+	err error
+}
+
+func (e *permanentError) Error() string { return e.err.Error() }
+
+func (e *permanentError) Unwrap() error { return e.err }
+
+// Permanent marks err as a failure that retrying will not fix, such as a
+// request the receiver rejects, so WithRetry gives up at once.
+func Permanent(err error) error {
+	return &permanentError{err: err}
+}
+
+// IsPermanent reports whether err was marked by Permanent.
+func IsPermanent(err error) bool {
+	var permanent *permanentError
+	return errors.As(err, &permanent)
+}
+
+// retryNotifier decorates a sink with per-attempt timeouts and retries.
+type retryNotifier struct {
+	next   INotifier
+	policy RetryPolicy
+}
+
+// WithRetry wraps a sink so each Send is bounded by the policy timeout and
+// retried with exponential backoff until it succeeds, the attempts run out or
+// an attempt fails with a Permanent error.
+func WithRetry(next INotifier, policy RetryPolicy) INotifier {
+	if policy.Attempts < 1 {
+		policy.Attempts = 1
+	}
+	return &retryNotifier{next: next, policy: policy}
+}
+
+// Name returns the name of the wrapped sink.
+func (r *retryNotifier) Name() string {
+	return r.next.Name()
+}
+
+// Send delivers the message, returning the last error if every attempt fails.
+func (r *retryNotifier) Send(ctx context.Context, msg *Message) error {
+	backoff := r.policy.Backoff
+
+	var err error
+	for attempt := 1; attempt <= r.policy.Attempts; attempt++ {
+		if err = r.sendOnce(ctx, msg); err == nil {
+			return nil
+		}
+		if attempt == r.policy.Attempts || IsPermanent(err) {
+			break
+		}
+
+		select {
+		case <-time.After(backoff):
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+		backoff *= 2
+	}
+	return err
+}
+
+// sendOnce performs a single attempt bounded by the policy timeout.
+func (r *retryNotifier) sendOnce(ctx context.Context, msg *Message) error {
+	if r.policy.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, r.policy.Timeout)
+		defer cancel()
+	}
+	return r.next.Send(ctx, msg)
+}
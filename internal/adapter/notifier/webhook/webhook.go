@@ -0,0 +1,85 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"transaction/internal/adapter/notifier"
+)
+
+const defaultTimeout = 10 * time.Second
+
+// Notifier implements the INotifier interface by posting messages to an HTTP webhook.
+// The payload carries the text in both "text" (Slack) and "content" (Discord) so
+// either kind of incoming webhook accepts it.
+type Notifier struct {
+	url        string
+	httpClient *http.Client
+}
+
+// payload is the JSON body posted to the webhook.
+type payload struct {
+	Text    string `json:"text"`
+	Content string `json:"content"`
+}
+
+// NewNotifier creates a new webhook INotifier posting to url.
+// A client with a default timeout is used when httpClient is nil.
+func NewNotifier(url string, httpClient *http.Client) notifier.INotifier {
+	if httpClient == nil {
+		httpClient = &http.Client{Timeout: defaultTimeout}
+	}
+	return &Notifier{url: url, httpClient: httpClient}
+}
+
+// Name identifies the sink.
+func (n *Notifier) Name() string {
+	return "webhook"
+}
+
+// Send posts the message and treats any non-2xx response as a failure. A
+// client error the webhook will keep rejecting is a notifier.Permanent error.
+func (n *Notifier) Send(ctx context.Context, msg *notifier.Message) error {
+	text := msg.Title + "\n" + msg.Body
+	body, err := json.Marshal(payload{Text: text, Content: text})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, n.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := n.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	io.Copy(io.Discard, resp.Body)
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		err := fmt.Errorf("webhook returned status %d", resp.StatusCode)
+		if !retryable(resp.StatusCode) {
+			return notifier.Permanent(err)
+		}
+		return err
+	}
+	return nil
+}
+
+// retryable reports whether a failed request may succeed when sent again. A
+// client error other than a timeout or rate limit, such as a bad request or
+// an unknown webhook, will not.
+func retryable(status int) bool {
+	if status == http.StatusRequestTimeout || status == http.StatusTooManyRequests {
+		return true
+	}
+	return status < 400 || status >= 500
+}
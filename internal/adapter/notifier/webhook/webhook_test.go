@@ -0,0 +1,96 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"transaction/internal/adapter/notifier"
+)
+
+func TestSend_PostsSlackAndDiscordPayload(t *testing.T) {
+	var received payload
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&received))
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	n := NewNotifier(server.URL, server.Client())
+	err := n.Send(context.Background(), &notifier.Message{Title: "BUY signal: BTC", Body: "BTC crossed buy lower"})
+	require.NoError(t, err)
+	assert.Equal(t, "BUY signal: BTC\nBTC crossed buy lower", received.Text)
+	assert.Equal(t, received.Text, received.Content)
+}
+
+func TestSend_ErrorStatus(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	n := NewNotifier(server.URL, server.Client())
+	err := n.Send(context.Background(), &notifier.Message{Title: "hello"})
+	assert.EqualError(t, err, "webhook returned status 500")
+}
+
+func TestSend_RetriesUntilServerRecovers(t *testing.T) {
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	n := notifier.WithRetry(NewNotifier(server.URL, server.Client()), notifier.RetryPolicy{Attempts: 3})
+	err := n.Send(context.Background(), &notifier.Message{Title: "hello"})
+	assert.NoError(t, err)
+	assert.Equal(t, 3, calls)
+}
+
+func TestSend_DoesNotRetryClientErrors(t *testing.T) {
+	for _, status := range []int{http.StatusBadRequest, http.StatusUnauthorized, http.StatusNotFound} {
+		calls := 0
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			calls++
+			w.WriteHeader(status)
+		}))
+
+		n := notifier.WithRetry(NewNotifier(server.URL, server.Client()), notifier.RetryPolicy{Attempts: 3})
+		err := n.Send(context.Background(), &notifier.Message{Title: "hello"})
+		server.Close()
+
+		assert.EqualError(t, err, fmt.Sprintf("webhook returned status %d", status))
+		assert.True(t, notifier.IsPermanent(err))
+		assert.Equal(t, 1, calls, "status %d", status)
+	}
+}
+
+func TestSend_RetriesTimeoutAndRateLimit(t *testing.T) {
+	for _, status := range []int{http.StatusRequestTimeout, http.StatusTooManyRequests} {
+		calls := 0
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			calls++
+			w.WriteHeader(status)
+		}))
+
+		n := notifier.WithRetry(NewNotifier(server.URL, server.Client()), notifier.RetryPolicy{Attempts: 2})
+		err := n.Send(context.Background(), &notifier.Message{Title: "hello"})
+		server.Close()
+
+		assert.Error(t, err)
+		assert.False(t, notifier.IsPermanent(err))
+		assert.Equal(t, 2, calls, "status %d", status)
+	}
+}
@@ -0,0 +1,16 @@
+package repository
+
+import (
+	"context"
+
+	"transaction/internal/domain"
+)
+
+// IStrategyAuditRepository defines the interface for the append-only strategy audit log.
+type IStrategyAuditRepository interface {
+	// Append records a new audit entry. Entries are never modified or removed.
+	Append(ctx context.Context, entry *domain.StrategyAudit) error
+
+	// FindByStrategyID retrieves the audit entries of a strategy, oldest first.
+	FindByStrategyID(ctx context.Context, strategyID string) ([]*domain.StrategyAudit, error)
+}
@@ -0,0 +1,34 @@
+package gormrepo
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+	"transaction/internal/adapter/repository"
+	"transaction/internal/domain"
+)
+
+// StrategyAuditRepository implements the IStrategyAuditRepository interface via GORM.
+type StrategyAuditRepository struct {
+	db *gorm.DB
+}
+
+// NewStrategyAuditRepository creates a new GORM-backed IStrategyAuditRepository.
+func NewStrategyAuditRepository(db *gorm.DB) repository.IStrategyAuditRepository {
+	return &StrategyAuditRepository{db: db}
+}
+
+// Append records a new audit entry.
+func (r *StrategyAuditRepository) Append(ctx context.Context, entry *domain.StrategyAudit) error {
+	return conn(ctx, r.db).Create(entry).Error
+}
+
+// FindByStrategyID retrieves the audit entries of a strategy, oldest first.
+func (r *StrategyAuditRepository) FindByStrategyID(ctx context.Context, strategyID string) ([]*domain.StrategyAudit, error) {
+	entries := make([]*domain.StrategyAudit, 0)
+	result := conn(ctx, r.db).Where("strategy_id = ?", strategyID).Order("created_at ASC, id ASC").Find(&entries)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return entries, nil
+}
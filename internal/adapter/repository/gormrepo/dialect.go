@@ -0,0 +1,19 @@
+// Package gormrepo implements the repositories on GORM. They run on every SQL
+// backend; the schema of each backend lives with its migrations, in the
+// sqlite and postgres packages.
+package gormrepo
+
+import "fmt"
+
+// Dialect holds what the repositories write differently for each database.
+type Dialect struct {
+	// NumericType is the SQL type that decimals, stored as text, are cast to
+	// when sorting by them.
+	NumericType string
+}
+
+// castNumeric returns the SQL expression reading the decimal column as a
+// number, so it sorts by value rather than lexically.
+func (d Dialect) castNumeric(column string) string {
+	return fmt.Sprintf("CAST(%s AS %s)", column, d.NumericType)
+}
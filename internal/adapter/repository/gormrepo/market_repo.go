@@ -0,0 +1,68 @@
+package gormrepo
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"transaction/internal/adapter/repository"
+	"transaction/internal/domain"
+)
+
+// MarketRepository implements the IMarketRepository interface via GORM.
+type MarketRepository struct {
+	db *gorm.DB
+}
+
+// NewMarketRepository creates a new GORM-backed IMarketRepository.
+func NewMarketRepository(db *gorm.DB) repository.IMarketRepository {
+	return &MarketRepository{db: db}
+}
+
+// Create registers a new market.
+func (r *MarketRepository) Create(ctx context.Context, market *domain.Market) (*domain.Market, error) {
+	result := conn(ctx, r.db).Clauses(clause.OnConflict{DoNothing: true}).Create(market)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return nil, domain.ErrMarketExists
+	}
+	return market, nil
+}
+
+// FindBySymbol retrieves a market by its canonical symbol.
+func (r *MarketRepository) FindBySymbol(ctx context.Context, symbol string) (*domain.Market, error) {
+	markets := make([]*domain.Market, 0, 1)
+	result := conn(ctx, r.db).Where("symbol = ?", symbol).Limit(1).Find(&markets)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	if len(markets) == 0 {
+		return nil, domain.ErrMarketNotFound
+	}
+	return markets[0], nil
+}
+
+// FindAll retrieves all markets, ordered by symbol.
+func (r *MarketRepository) FindAll(ctx context.Context) ([]*domain.Market, error) {
+	markets := make([]*domain.Market, 0)
+	result := conn(ctx, r.db).Order("symbol ASC").Find(&markets)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return markets, nil
+}
+
+// Update modifies an existing market.
+func (r *MarketRepository) Update(ctx context.Context, market *domain.Market) (*domain.Market, error) {
+	// Selecting the columns explicitly stops Save from falling back to an insert.
+	result := conn(ctx, r.db).Select("*").Save(market)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return nil, domain.ErrMarketNotFound
+	}
+	return market, nil
+}
@@ -0,0 +1,70 @@
+package gormrepo
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"transaction/internal/adapter/repository"
+	"transaction/internal/domain"
+)
+
+// NotificationRepository implements the INotificationRepository interface via GORM.
+type NotificationRepository struct {
+	db *gorm.DB
+}
+
+// NewNotificationRepository creates a new GORM-backed INotificationRepository.
+func NewNotificationRepository(db *gorm.DB) repository.INotificationRepository {
+	return &NotificationRepository{db: db}
+}
+
+// GetPreferences retrieves the notification preferences, falling back to the defaults.
+func (r *NotificationRepository) GetPreferences(ctx context.Context) (*domain.NotificationPreferences, error) {
+	prefs := &domain.NotificationPreferences{}
+	// Find instead of First: a missing row is the normal state, not an error worth logging.
+	result := conn(ctx, r.db).Where("id = ?", domain.DefaultPreferencesID).Limit(1).Find(prefs)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return domain.DefaultNotificationPreferences(), nil
+	}
+	return prefs, nil
+}
+
+// SavePreferences creates or replaces the notification preferences.
+func (r *NotificationRepository) SavePreferences(ctx context.Context, prefs *domain.NotificationPreferences) (*domain.NotificationPreferences, error) {
+	prefs.ID = domain.DefaultPreferencesID
+	result := conn(ctx, r.db).Save(prefs)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return prefs, nil
+}
+
+// Enqueue holds a signal back until the next digest.
+func (r *NotificationRepository) Enqueue(ctx context.Context, queued *domain.QueuedNotification) error {
+	return conn(ctx, r.db).Clauses(clause.OnConflict{DoNothing: true}).Create(queued).Error
+}
+
+// FindQueued retrieves the queued signals, oldest trigger first.
+func (r *NotificationRepository) FindQueued(ctx context.Context) ([]*domain.Signal, error) {
+	signals := make([]*domain.Signal, 0)
+	result := conn(ctx, r.db).
+		Joins("JOIN queued_notifications ON queued_notifications.signal_id = signals.id").
+		Order("signals.triggered_at ASC").
+		Find(&signals)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return signals, nil
+}
+
+// Dequeue removes the given signals from the queue.
+func (r *NotificationRepository) Dequeue(ctx context.Context, signalIDs []string) error {
+	if len(signalIDs) == 0 {
+		return nil
+	}
+	return conn(ctx, r.db).Where("signal_id IN ?", signalIDs).Delete(&domain.QueuedNotification{}).Error
+}
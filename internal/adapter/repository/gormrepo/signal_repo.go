@@ -0,0 +1,72 @@
+package gormrepo
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+	"transaction/internal/adapter/repository"
+	"transaction/internal/domain"
+)
+
+// SignalRepository implements the ISignalRepository interface via GORM.
+type SignalRepository struct {
+	db *gorm.DB
+}
+
+// NewSignalRepository creates a new GORM-backed ISignalRepository.
+func NewSignalRepository(db *gorm.DB) repository.ISignalRepository {
+	return &SignalRepository{db: db}
+}
+
+// Create persists a new signal and returns the created signal.
+func (r *SignalRepository) Create(ctx context.Context, signal *domain.Signal) (*domain.Signal, error) {
+	result := conn(ctx, r.db).Create(signal)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return signal, nil
+}
+
+// FindByID retrieves a signal by its ID.
+func (r *SignalRepository) FindByID(ctx context.Context, id string) (*domain.Signal, error) {
+	signal := &domain.Signal{}
+	result := conn(ctx, r.db).First(signal, "id = ?", id)
+	if result.Error != nil {
+		if result.Error == gorm.ErrRecordNotFound {
+			return nil, domain.ErrSignalNotFound
+		}
+		return nil, result.Error
+	}
+	return signal, nil
+}
+
+// Find retrieves the signals matching the filter, most recent first.
+func (r *SignalRepository) Find(ctx context.Context, filter repository.SignalFilter) ([]*domain.Signal, error) {
+	query := conn(ctx, r.db).Model(&domain.Signal{})
+	if filter.StrategyID != "" {
+		query = query.Where("strategy_id = ?", filter.StrategyID)
+	}
+	if !filter.Since.IsZero() {
+		query = query.Where("triggered_at >= ?", filter.Since)
+	}
+
+	signals := make([]*domain.Signal, 0)
+	result := query.Order("triggered_at DESC").Find(&signals)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return signals, nil
+}
+
+// Update modifies an existing signal.
+func (r *SignalRepository) Update(ctx context.Context, signal *domain.Signal) (*domain.Signal, error) {
+	// Selecting the columns explicitly stops Save from falling back to an insert.
+	result := conn(ctx, r.db).Select("*").Save(signal)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return nil, domain.ErrSignalNotFound
+	}
+	return signal, nil
+}
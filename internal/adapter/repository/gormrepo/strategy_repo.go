@@ -0,0 +1,221 @@
+package gormrepo
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+	"transaction/internal/adapter/repository"
+	"transaction/internal/domain"
+)
+
+// StrategyRepository implements the IStrategyRepository interface via GORM.
+type StrategyRepository struct {
+	db      *gorm.DB
+	dialect Dialect
+}
+
+// NewStrategyRepository creates a new GORM-backed IStrategyRepository writing
+// SQL for dialect.
+func NewStrategyRepository(db *gorm.DB, dialect Dialect) repository.IStrategyRepository {
+	return &StrategyRepository{db: db, dialect: dialect}
+}
+
+// Create persists a new strategy and returns the created strategy.
+func (r *StrategyRepository) Create(ctx context.Context, strategy *domain.Strategy) (*domain.Strategy, error) {
+	result := conn(ctx, r.db).Create(strategy)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return strategy, nil
+}
+
+// FindByID retrieves a strategy by its ID.
+func (r *StrategyRepository) FindByID(ctx context.Context, id string) (*domain.Strategy, error) {
+	strategy := &domain.Strategy{}
+	result := conn(ctx, r.db).Where("deleted_at IS NULL").First(strategy, "id = ?", id)
+	if result.Error != nil {
+		if result.Error == gorm.ErrRecordNotFound {
+			return nil, domain.ErrStrategyNotFound
+		}
+		return nil, result.Error
+	}
+	return strategy, nil
+}
+
+// FindAll retrieves all strategies that have not been deleted.
+func (r *StrategyRepository) FindAll(ctx context.Context) ([]*domain.Strategy, error) {
+	strategies := make([]*domain.Strategy, 0)
+	result := conn(ctx, r.db).Where("deleted_at IS NULL").Find(&strategies)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return strategies, nil
+}
+
+// FindActive retrieves the active strategies, oldest first.
+func (r *StrategyRepository) FindActive(ctx context.Context) ([]*domain.Strategy, error) {
+	active := true
+	return r.Find(ctx, repository.StrategyFilter{Active: &active})
+}
+
+// FindBySymbol retrieves the strategies of one symbol, oldest first.
+func (r *StrategyRepository) FindBySymbol(ctx context.Context, symbol string) ([]*domain.Strategy, error) {
+	return r.Find(ctx, repository.StrategyFilter{Symbol: symbol})
+}
+
+// Find retrieves the strategies matching the filter in the requested order.
+func (r *StrategyRepository) Find(ctx context.Context, filter repository.StrategyFilter) ([]*domain.Strategy, error) {
+	query := conn(ctx, r.db).Model(&domain.Strategy{})
+	if !filter.IncludeDeleted {
+		query = query.Where("deleted_at IS NULL")
+	}
+	if filter.ID != "" {
+		query = query.Where("id = ?", filter.ID)
+	}
+	if filter.Symbol != "" {
+		query = query.Where("symbol = ?", filter.Symbol)
+	}
+	if filter.Active != nil {
+		query = query.Where("is_active = ?", *filter.Active)
+	}
+	if !filter.CreatedAfter.IsZero() {
+		query = query.Where("created_at >= ?", filter.CreatedAfter)
+	}
+	if !filter.CreatedBefore.IsZero() {
+		query = query.Where("created_at < ?", filter.CreatedBefore)
+	}
+
+	sortBy := filter.SortBy
+	if sortBy == "" {
+		sortBy = repository.StrategySortCreatedAt
+	}
+	sortExpr := sortBy
+	switch sortBy {
+	case repository.StrategySortCreatedAt, repository.StrategySortSymbol:
+	case repository.StrategySortBuyLower, repository.StrategySortSellUpper:
+		sortExpr = r.dialect.castNumeric(sortBy)
+	default:
+		return nil, fmt.Errorf("unsupported sort column %q", sortBy)
+	}
+	direction := "ASC"
+	if filter.Descending {
+		direction = "DESC"
+	}
+	// The secondary keys keep pages stable when the sort column has duplicates.
+	query = query.Order(fmt.Sprintf("%s %s, created_at %s, id %s", sortExpr, direction, direction, direction))
+
+	if filter.Limit > 0 {
+		query = query.Limit(filter.Limit)
+	}
+	if filter.Offset > 0 {
+		query = query.Offset(filter.Offset)
+	}
+
+	strategies := make([]*domain.Strategy, 0)
+	result := query.Find(&strategies)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return strategies, nil
+}
+
+// Update overwrites the configuration of a strategy if its version is unchanged.
+func (r *StrategyRepository) Update(ctx context.Context, strategy *domain.Strategy) (*domain.Strategy, error) {
+	db := conn(ctx, r.db)
+	now := time.Now()
+	result := db.Model(&domain.Strategy{}).
+		Where("id = ? AND version = ? AND deleted_at IS NULL", strategy.ID, strategy.Version).
+		UpdateColumns(map[string]interface{}{
+			"symbol":     strategy.Symbol,
+			"buy_lower":  strategy.BuyLower,
+			"sell_upper": strategy.SellUpper,
+			"cooldown":   strategy.Cooldown,
+			"is_active":  strategy.IsActive,
+			"updated_at": now,
+			"version":    gorm.Expr("version + 1"),
+		})
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	if result.RowsAffected == 0 {
+		// Tell a missing strategy apart from one changed by someone else.
+		if _, err := r.FindByID(ctx, strategy.ID); err != nil {
+			return nil, err
+		}
+		return nil, domain.ErrConcurrentModification
+	}
+
+	strategy.Version++
+	strategy.UpdatedAt = now
+	return strategy, nil
+}
+
+// UpdateTriggerState persists only the edge-trigger state of a strategy.
+func (r *StrategyRepository) UpdateTriggerState(ctx context.Context, strategy *domain.Strategy) error {
+	result := conn(ctx, r.db).Model(&domain.Strategy{}).
+		Where("id = ? AND deleted_at IS NULL", strategy.ID).
+		UpdateColumns(map[string]interface{}{
+			"last_zone":      strategy.LastZone,
+			"last_signal_at": strategy.LastSignalAt,
+		})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return domain.ErrStrategyNotFound
+	}
+	return nil
+}
+
+// Delete soft deletes a strategy by its ID.
+func (r *StrategyRepository) Delete(ctx context.Context, id string) error {
+	result := conn(ctx, r.db).Model(&domain.Strategy{}).
+		Where("id = ? AND deleted_at IS NULL", id).
+		UpdateColumns(map[string]interface{}{
+			"deleted_at": time.Now(),
+			"version":    gorm.Expr("version + 1"),
+		})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return domain.ErrStrategyNotFound
+	}
+	return nil
+}
+
+// Restore brings back a soft deleted strategy and returns it.
+func (r *StrategyRepository) Restore(ctx context.Context, id string) (*domain.Strategy, error) {
+	db := conn(ctx, r.db)
+	result := db.Model(&domain.Strategy{}).
+		Where("id = ? AND deleted_at IS NOT NULL", id).
+		UpdateColumns(map[string]interface{}{
+			"deleted_at": nil,
+			"version":    gorm.Expr("version + 1"),
+		})
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return nil, domain.ErrStrategyNotFound
+	}
+
+	strategy := &domain.Strategy{}
+	if err := db.First(strategy, "id = ?", id).Error; err != nil {
+		return nil, err
+	}
+	return strategy, nil
+}
+
+// Purge permanently removes the strategies soft deleted before the cutoff.
+func (r *StrategyRepository) Purge(ctx context.Context, deletedBefore time.Time) (int64, error) {
+	result := conn(ctx, r.db).
+		Where("deleted_at IS NOT NULL AND deleted_at < ?", deletedBefore).
+		Delete(&domain.Strategy{})
+	if result.Error != nil {
+		return 0, result.Error
+	}
+	return result.RowsAffected, nil
+}
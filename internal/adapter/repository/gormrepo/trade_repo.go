@@ -0,0 +1,117 @@
+package gormrepo
+
+import (
+	"context"
+	"fmt"
+
+	"gorm.io/gorm"
+	"transaction/internal/adapter/repository"
+	"transaction/internal/domain"
+)
+
+// TradeRepository implements the ITradeRepository interface via GORM.
+type TradeRepository struct {
+	db      *gorm.DB
+	dialect Dialect
+}
+
+// NewTradeRepository creates a new GORM-backed ITradeRepository writing
+// SQL for dialect.
+func NewTradeRepository(db *gorm.DB, dialect Dialect) repository.ITradeRepository {
+	return &TradeRepository{db: db, dialect: dialect}
+}
+
+// Create persists a new trade and returns the created trade.
+func (r *TradeRepository) Create(ctx context.Context, trade *domain.Trade) (*domain.Trade, error) {
+	result := conn(ctx, r.db).Create(trade)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return trade, nil
+}
+
+// FindByID retrieves a trade by its ID.
+func (r *TradeRepository) FindByID(ctx context.Context, id string) (*domain.Trade, error) {
+	trade := &domain.Trade{}
+	result := conn(ctx, r.db).First(trade, "id = ?", id)
+	if result.Error != nil {
+		if result.Error == gorm.ErrRecordNotFound {
+			return nil, domain.ErrTradeNotFound
+		}
+		return nil, result.Error
+	}
+	return trade, nil
+}
+
+// FindAll retrieves all trades, oldest execution first.
+func (r *TradeRepository) FindAll(ctx context.Context) ([]*domain.Trade, error) {
+	trades := make([]*domain.Trade, 0)
+	result := conn(ctx, r.db).Order("executed_at ASC").Find(&trades)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return trades, nil
+}
+
+// FindBySymbol retrieves the trades of one symbol, oldest execution first.
+func (r *TradeRepository) FindBySymbol(ctx context.Context, symbol string) ([]*domain.Trade, error) {
+	trades := make([]*domain.Trade, 0)
+	result := conn(ctx, r.db).Where("symbol = ?", symbol).Order("executed_at ASC").Find(&trades)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return trades, nil
+}
+
+// Find retrieves the trades matching the filter in the requested order.
+func (r *TradeRepository) Find(ctx context.Context, filter repository.TradeFilter) ([]*domain.Trade, error) {
+	query := conn(ctx, r.db).Model(&domain.Trade{})
+	if filter.Symbol != "" {
+		query = query.Where("symbol = ?", filter.Symbol)
+	}
+	if filter.Side != "" {
+		query = query.Where("side = ?", filter.Side)
+	}
+	if filter.StrategyID != "" {
+		query = query.Where("strategy_id = ?", filter.StrategyID)
+	}
+	if !filter.From.IsZero() {
+		query = query.Where("executed_at >= ?", filter.From)
+	}
+	if !filter.To.IsZero() {
+		query = query.Where("executed_at < ?", filter.To)
+	}
+
+	sortBy := filter.SortBy
+	if sortBy == "" {
+		sortBy = repository.TradeSortExecutedAt
+	}
+	sortExpr := sortBy
+	switch sortBy {
+	case repository.TradeSortExecutedAt, repository.TradeSortSymbol:
+	case repository.TradeSortQuantity, repository.TradeSortPrice:
+		sortExpr = r.dialect.castNumeric(sortBy)
+	default:
+		return nil, fmt.Errorf("unsupported sort column %q", sortBy)
+	}
+	direction := "ASC"
+	if filter.Descending {
+		direction = "DESC"
+	}
+	// The secondary keys keep pages stable when the sort column has duplicates.
+	query = query.Order(fmt.Sprintf("%s %s, executed_at %s, id %s", sortExpr, direction, direction, direction))
+
+	if filter.Limit > 0 {
+		query = query.Limit(filter.Limit)
+	}
+	if filter.Offset > 0 {
+		query = query.Offset(filter.Offset)
+	}
+
+	trades := make([]*domain.Trade, 0)
+	result := query.Find(&trades)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return trades, nil
+}
@@ -0,0 +1,40 @@
+package gormrepo
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+	"transaction/internal/adapter/repository"
+)
+
+// txKey is the context key under which the active transaction is stored.
+type txKey struct{}
+
+// Transactor implements the ITransactor interface using GORM transactions.
+type Transactor struct {
+	db *gorm.DB
+}
+
+// NewTransactor creates a new GORM-backed ITransactor.
+func NewTransactor(db *gorm.DB) repository.ITransactor {
+	return &Transactor{db: db}
+}
+
+// WithinTransaction runs fn inside a transaction, joining the one already
+// bound to ctx if there is one.
+func (t *Transactor) WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	if _, ok := ctx.Value(txKey{}).(*gorm.DB); ok {
+		return fn(ctx)
+	}
+	return t.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return fn(context.WithValue(ctx, txKey{}, tx))
+	})
+}
+
+// conn returns the transaction bound to ctx, or db scoped to ctx when there is none.
+func conn(ctx context.Context, db *gorm.DB) *gorm.DB {
+	if tx, ok := ctx.Value(txKey{}).(*gorm.DB); ok {
+		return tx
+	}
+	return db.WithContext(ctx)
+}
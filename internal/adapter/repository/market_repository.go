@@ -0,0 +1,26 @@
+package repository
+
+import (
+	"context"
+
+	"transaction/internal/domain"
+)
+
+// IMarketRepository defines the interface for persisting the registry of
+// tradable markets.
+type IMarketRepository interface {
+	// Create registers a new market.
+	// Returns ErrMarketExists if a market with the same symbol is already registered.
+	Create(ctx context.Context, market *domain.Market) (*domain.Market, error)
+
+	// FindBySymbol retrieves a market by its canonical symbol.
+	// Returns ErrMarketNotFound if the market does not exist.
+	FindBySymbol(ctx context.Context, symbol string) (*domain.Market, error)
+
+	// FindAll retrieves all markets, ordered by symbol.
+	FindAll(ctx context.Context) ([]*domain.Market, error)
+
+	// Update modifies an existing market.
+	// Returns ErrMarketNotFound if the market does not exist.
+	Update(ctx context.Context, market *domain.Market) (*domain.Market, error)
+}
@@ -0,0 +1,308 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"transaction/internal/adapter/repository"
+	"transaction/internal/domain"
+)
+
+// StrategyRepository implements the IStrategyRepository interface in memory.
+// It is safe for concurrent use and hands out copies, so callers never share
+// state with the store. It does not take part in transactions: changes are
+// visible at once and are not rolled back.
+type StrategyRepository struct {
+	mu         sync.RWMutex
+	strategies map[string]*domain.Strategy
+}
+
+// NewStrategyRepository creates a new empty in-memory IStrategyRepository.
+func NewStrategyRepository() repository.IStrategyRepository {
+	return &StrategyRepository{strategies: make(map[string]*domain.Strategy)}
+}
+
+// Create persists a new strategy and returns the created strategy.
+func (r *StrategyRepository) Create(ctx context.Context, strategy *domain.Strategy) (*domain.Strategy, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.strategies[strategy.ID]; ok {
+		return nil, fmt.Errorf("strategy %s already exists", strategy.ID)
+	}
+	now := time.Now()
+	if strategy.CreatedAt.IsZero() {
+		strategy.CreatedAt = now
+	}
+	if strategy.UpdatedAt.IsZero() {
+		strategy.UpdatedAt = now
+	}
+	if strategy.Version == 0 {
+		strategy.Version = 1
+	}
+	r.strategies[strategy.ID] = clone(strategy)
+	return strategy, nil
+}
+
+// FindByID retrieves a strategy by its ID.
+func (r *StrategyRepository) FindByID(ctx context.Context, id string) (*domain.Strategy, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	stored, ok := r.strategies[id]
+	if !ok || stored.IsDeleted() {
+		return nil, domain.ErrStrategyNotFound
+	}
+	return clone(stored), nil
+}
+
+// FindAll retrieves all strategies that have not been deleted, oldest first.
+func (r *StrategyRepository) FindAll(ctx context.Context) ([]*domain.Strategy, error) {
+	return r.Find(ctx, repository.StrategyFilter{})
+}
+
+// FindActive retrieves the active strategies, oldest first.
+func (r *StrategyRepository) FindActive(ctx context.Context) ([]*domain.Strategy, error) {
+	active := true
+	return r.Find(ctx, repository.StrategyFilter{Active: &active})
+}
+
+// FindBySymbol retrieves the strategies of one symbol, oldest first.
+func (r *StrategyRepository) FindBySymbol(ctx context.Context, symbol string) ([]*domain.Strategy, error) {
+	return r.Find(ctx, repository.StrategyFilter{Symbol: symbol})
+}
+
+// Find retrieves the strategies matching the filter in the requested order.
+func (r *StrategyRepository) Find(ctx context.Context, filter repository.StrategyFilter) ([]*domain.Strategy, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	sortBy := filter.SortBy
+	if sortBy == "" {
+		sortBy = repository.StrategySortCreatedAt
+	}
+	compare, ok := strategyComparators[sortBy]
+	if !ok {
+		return nil, fmt.Errorf("unsupported sort column %q", sortBy)
+	}
+
+	r.mu.RLock()
+	strategies := make([]*domain.Strategy, 0, len(r.strategies))
+	for _, stored := range r.strategies {
+		if matches(stored, filter) {
+			strategies = append(strategies, clone(stored))
+		}
+	}
+	r.mu.RUnlock()
+
+	// The secondary keys keep pages stable when the sort column has duplicates.
+	sort.Slice(strategies, func(i, j int) bool {
+		a, b := strategies[i], strategies[j]
+		c := compare(a, b)
+		if c == 0 {
+			c = compareTimes(a.CreatedAt, b.CreatedAt)
+		}
+		if c == 0 {
+			c = strings.Compare(a.ID, b.ID)
+		}
+		if filter.Descending {
+			return c > 0
+		}
+		return c < 0
+	})
+
+	if filter.Offset > 0 {
+		if filter.Offset >= len(strategies) {
+			return make([]*domain.Strategy, 0), nil
+		}
+		strategies = strategies[filter.Offset:]
+	}
+	if filter.Limit > 0 && filter.Limit < len(strategies) {
+		strategies = strategies[:filter.Limit]
+	}
+	return strategies, nil
+}
+
+// Update overwrites the configuration of a strategy if its version is unchanged.
+func (r *StrategyRepository) Update(ctx context.Context, strategy *domain.Strategy) (*domain.Strategy, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	stored, ok := r.strategies[strategy.ID]
+	if !ok || stored.IsDeleted() {
+		return nil, domain.ErrStrategyNotFound
+	}
+	if stored.Version != strategy.Version {
+		return nil, domain.ErrConcurrentModification
+	}
+
+	now := time.Now()
+	stored.Symbol = strategy.Symbol
+	stored.BuyLower = strategy.BuyLower
+	stored.SellUpper = strategy.SellUpper
+	stored.Cooldown = cloneDuration(strategy.Cooldown)
+	stored.IsActive = strategy.IsActive
+	stored.UpdatedAt = now
+	stored.Version++
+
+	strategy.Version++
+	strategy.UpdatedAt = now
+	return strategy, nil
+}
+
+// UpdateTriggerState persists only the edge-trigger state of a strategy.
+func (r *StrategyRepository) UpdateTriggerState(ctx context.Context, strategy *domain.Strategy) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	stored, ok := r.strategies[strategy.ID]
+	if !ok || stored.IsDeleted() {
+		return domain.ErrStrategyNotFound
+	}
+	stored.LastZone = strategy.LastZone
+	stored.LastSignalAt = cloneTime(strategy.LastSignalAt)
+	return nil
+}
+
+// Delete soft deletes a strategy by its ID.
+func (r *StrategyRepository) Delete(ctx context.Context, id string) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	stored, ok := r.strategies[id]
+	if !ok || stored.IsDeleted() {
+		return domain.ErrStrategyNotFound
+	}
+	now := time.Now()
+	stored.DeletedAt = &now
+	stored.Version++
+	return nil
+}
+
+// Restore brings back a soft deleted strategy and returns it.
+func (r *StrategyRepository) Restore(ctx context.Context, id string) (*domain.Strategy, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	stored, ok := r.strategies[id]
+	if !ok || !stored.IsDeleted() {
+		return nil, domain.ErrStrategyNotFound
+	}
+	stored.DeletedAt = nil
+	stored.Version++
+	return clone(stored), nil
+}
+
+// Purge permanently removes the strategies soft deleted before the cutoff.
+func (r *StrategyRepository) Purge(ctx context.Context, deletedBefore time.Time) (int64, error) {
+	if err := ctx.Err(); err != nil {
+		return 0, err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var purged int64
+	for id, stored := range r.strategies {
+		if stored.IsDeleted() && stored.DeletedAt.Before(deletedBefore) {
+			delete(r.strategies, id)
+			purged++
+		}
+	}
+	return purged, nil
+}
+
+// strategyComparators order strategies by each sortable column.
+var strategyComparators = map[string]func(a, b *domain.Strategy) int{
+	repository.StrategySortCreatedAt: func(a, b *domain.Strategy) int { return compareTimes(a.CreatedAt, b.CreatedAt) },
+	repository.StrategySortSymbol:    func(a, b *domain.Strategy) int { return strings.Compare(a.Symbol, b.Symbol) },
+	repository.StrategySortBuyLower:  func(a, b *domain.Strategy) int { return a.BuyLower.Cmp(b.BuyLower) },
+	repository.StrategySortSellUpper: func(a, b *domain.Strategy) int { return a.SellUpper.Cmp(b.SellUpper) },
+}
+
+// matches reports whether a stored strategy passes the filter.
+func matches(s *domain.Strategy, filter repository.StrategyFilter) bool {
+	if s.IsDeleted() && !filter.IncludeDeleted {
+		return false
+	}
+	if filter.ID != "" && s.ID != filter.ID {
+		return false
+	}
+	if filter.Symbol != "" && s.Symbol != filter.Symbol {
+		return false
+	}
+	if filter.Active != nil && s.IsActive != *filter.Active {
+		return false
+	}
+	if !filter.CreatedAfter.IsZero() && s.CreatedAt.Before(filter.CreatedAfter) {
+		return false
+	}
+	if !filter.CreatedBefore.IsZero() && !s.CreatedAt.Before(filter.CreatedBefore) {
+		return false
+	}
+	return true
+}
+
+func compareTimes(a, b time.Time) int {
+	switch {
+	case a.Before(b):
+		return -1
+	case a.After(b):
+		return 1
+	}
+	return 0
+}
+
+// clone copies a strategy, including the values its pointers refer to.
+func clone(s *domain.Strategy) *domain.Strategy {
+	c := *s
+	c.Cooldown = cloneDuration(s.Cooldown)
+	c.LastSignalAt = cloneTime(s.LastSignalAt)
+	c.DeletedAt = cloneTime(s.DeletedAt)
+	return &c
+}
+
+func cloneDuration(d *time.Duration) *time.Duration {
+	if d == nil {
+		return nil
+	}
+	c := *d
+	return &c
+}
+
+func cloneTime(t *time.Time) *time.Time {
+	if t == nil {
+		return nil
+	}
+	c := *t
+	return &c
+}
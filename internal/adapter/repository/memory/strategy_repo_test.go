@@ -0,0 +1,101 @@
+package memory
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"transaction/internal/adapter/repository"
+	"transaction/internal/adapter/repository/repotest"
+	"transaction/internal/domain"
+)
+
+func TestStrategyRepository(t *testing.T) {
+	repotest.StrategyRepository(t, func(t *testing.T) repository.IStrategyRepository {
+		return NewStrategyRepository()
+	})
+}
+
+func TestStrategyRepository_ReturnsCopies(t *testing.T) {
+	ctx := context.Background()
+	repo := NewStrategyRepository()
+
+	strategy := &domain.Strategy{
+		ID:        "strategy-1",
+		Symbol:    "BTC/USDT",
+		BuyLower:  decimal.NewFromInt(60000),
+		SellUpper: decimal.NewFromInt(70000),
+		IsActive:  true,
+	}
+	_, err := repo.Create(ctx, strategy)
+	require.NoError(t, err)
+
+	// Changes to the created or found strategy stay out of the store
+	strategy.IsActive = false
+	found, err := repo.FindByID(ctx, "strategy-1")
+	require.NoError(t, err)
+	assert.True(t, found.IsActive)
+
+	found.BuyLower = decimal.NewFromInt(1)
+	again, err := repo.FindByID(ctx, "strategy-1")
+	require.NoError(t, err)
+	assert.Equal(t, "60000", again.BuyLower.String())
+}
+
+func TestStrategyRepository_DuplicateID(t *testing.T) {
+	ctx := context.Background()
+	repo := NewStrategyRepository()
+
+	_, err := repo.Create(ctx, &domain.Strategy{ID: "strategy-1", Symbol: "BTC/USDT"})
+	require.NoError(t, err)
+	_, err = repo.Create(ctx, &domain.Strategy{ID: "strategy-1", Symbol: "ETH/USDT"})
+	assert.Error(t, err)
+}
+
+func TestStrategyRepository_ConcurrentUpdatesConflict(t *testing.T) {
+	ctx := context.Background()
+	repo := NewStrategyRepository()
+
+	_, err := repo.Create(ctx, &domain.Strategy{
+		ID:        "strategy-1",
+		Symbol:    "BTC/USDT",
+		BuyLower:  decimal.NewFromInt(60000),
+		SellUpper: decimal.NewFromInt(70000),
+	})
+	require.NoError(t, err)
+
+	// Every writer starts from version 1, so exactly one of them wins
+	const writers = 20
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	succeeded := 0
+	for i := 0; i < writers; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			s := &domain.Strategy{
+				ID:        "strategy-1",
+				Symbol:    "BTC/USDT",
+				BuyLower:  decimal.NewFromInt(int64(50000 + i)),
+				SellUpper: decimal.NewFromInt(70000),
+				Version:   1,
+			}
+			if _, err := repo.Update(ctx, s); err == nil {
+				mu.Lock()
+				succeeded++
+				mu.Unlock()
+			} else {
+				assert.Equal(t, domain.ErrConcurrentModification, err)
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	assert.Equal(t, 1, succeeded)
+	found, err := repo.FindByID(ctx, "strategy-1")
+	require.NoError(t, err)
+	assert.Equal(t, 2, found.Version)
+}
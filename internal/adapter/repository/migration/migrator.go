@@ -0,0 +1,245 @@
+package migration
+
+import (
+	"context"
+	"fmt"
+	"io/fs"
+	"path"
+	"regexp"
+	"sort"
+	"strconv"
+	"time"
+
+	"gorm.io/gorm"
+	"transaction/internal/adapter/repository"
+)
+
+// fileName matches a migration file and captures its version, name and direction.
+var fileName = regexp.MustCompile(`^(\d+)_(\w+)\.(up|down)\.sql$`)
+
+// schemaMigration records one applied migration in the schema_migrations table.
+type schemaMigration struct {
+	Version   int `gorm:"primaryKey;autoIncrement:false"`
+	Name      string
+	AppliedAt time.Time
+}
+
+func (schemaMigration) TableName() string { return "schema_migrations" }
+
+// migration is a numbered schema change with the SQL to apply and revert it.
+type migration struct {
+	version int
+	name    string
+	up      string
+	down    string
+}
+
+// AdoptFunc brings a database created before numbered migrations existed up
+// to date. It runs in the transaction creating the schema_migrations table and
+// returns the last migration the schema now matches, or 0 for a new database.
+type AdoptFunc func(tx *gorm.DB) (int, error)
+
+// Migrator implements the IMigrator interface with SQL files run in
+// transactions. It works with any database supported by GORM; the SQL
+// itself is written for one dialect.
+type Migrator struct {
+	db    *gorm.DB
+	files fs.FS
+	adopt AdoptFunc
+}
+
+// New creates a Migrator running the migrations/*.sql files of files. adopt
+// may be nil when there is no schema predating the migrations.
+func New(db *gorm.DB, files fs.FS, adopt AdoptFunc) *Migrator {
+	return &Migrator{db: db, files: files, adopt: adopt}
+}
+
+// Up applies every pending migration in version order.
+func (m *Migrator) Up(ctx context.Context) ([]repository.Migration, error) {
+	migrations, applied, err := m.load(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	done := make([]repository.Migration, 0)
+	for _, mig := range migrations {
+		if _, ok := applied[mig.version]; ok {
+			continue
+		}
+		err := m.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+			if err := tx.Exec(mig.up).Error; err != nil {
+				return err
+			}
+			return tx.Create(&schemaMigration{Version: mig.version, Name: mig.name, AppliedAt: time.Now()}).Error
+		})
+		if err != nil {
+			return done, fmt.Errorf("migration %04d_%s failed: %w", mig.version, mig.name, err)
+		}
+		done = append(done, repository.Migration{Version: mig.version, Name: mig.name})
+	}
+	return done, nil
+}
+
+// Down rolls back the latest steps applied migrations, newest first.
+func (m *Migrator) Down(ctx context.Context, steps int) ([]repository.Migration, error) {
+	if steps < 1 {
+		return nil, fmt.Errorf("steps must be at least 1")
+	}
+
+	migrations, _, err := m.load(ctx)
+	if err != nil {
+		return nil, err
+	}
+	byVersion := make(map[int]migration, len(migrations))
+	for _, mig := range migrations {
+		byVersion[mig.version] = mig
+	}
+
+	var records []schemaMigration
+	if err := m.db.WithContext(ctx).Order("version DESC").Limit(steps).Find(&records).Error; err != nil {
+		return nil, err
+	}
+
+	done := make([]repository.Migration, 0, len(records))
+	for _, record := range records {
+		mig, ok := byVersion[record.Version]
+		if !ok {
+			return done, fmt.Errorf("no migration file for applied version %04d_%s", record.Version, record.Name)
+		}
+		err := m.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+			if err := tx.Exec(mig.down).Error; err != nil {
+				return err
+			}
+			return tx.Delete(&schemaMigration{}, mig.version).Error
+		})
+		if err != nil {
+			return done, fmt.Errorf("rollback of %04d_%s failed: %w", mig.version, mig.name, err)
+		}
+		done = append(done, repository.Migration{Version: mig.version, Name: mig.name})
+	}
+	return done, nil
+}
+
+// Status lists every known migration and when it was applied.
+func (m *Migrator) Status(ctx context.Context) ([]repository.MigrationStatus, error) {
+	migrations, applied, err := m.load(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	statuses := make([]repository.MigrationStatus, len(migrations))
+	for i, mig := range migrations {
+		statuses[i] = repository.MigrationStatus{
+			Migration: repository.Migration{Version: mig.version, Name: mig.name},
+		}
+		if record, ok := applied[mig.version]; ok {
+			appliedAt := record.AppliedAt
+			statuses[i].AppliedAt = &appliedAt
+		}
+	}
+	return statuses, nil
+}
+
+// load reads the migration files and the applied migrations, creating the
+// schema_migrations table and adopting an existing schema first if needed.
+func (m *Migrator) load(ctx context.Context) ([]migration, map[int]schemaMigration, error) {
+	migrations, err := read(m.files)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	db := m.db.WithContext(ctx)
+	if !db.Migrator().HasTable(&schemaMigration{}) {
+		if err := m.prepare(db, migrations); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	var records []schemaMigration
+	if err := db.Find(&records).Error; err != nil {
+		return nil, nil, err
+	}
+	applied := make(map[int]schemaMigration, len(records))
+	for _, record := range records {
+		applied[record.Version] = record
+	}
+	return migrations, applied, nil
+}
+
+// prepare creates the schema_migrations table. When the adopt function
+// reports an existing schema, the migrations it covers are recorded as applied.
+func (m *Migrator) prepare(db *gorm.DB, migrations []migration) error {
+	return db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Migrator().CreateTable(&schemaMigration{}); err != nil {
+			return err
+		}
+		if m.adopt == nil {
+			return nil
+		}
+
+		baseline, err := m.adopt(tx)
+		if err != nil {
+			return fmt.Errorf("failed to adopt existing database: %w", err)
+		}
+		for _, mig := range migrations {
+			if mig.version > baseline {
+				break
+			}
+			record := &schemaMigration{Version: mig.version, Name: mig.name, AppliedAt: time.Now()}
+			if err := tx.Create(record).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// read parses the migration files in version order. Every version needs both
+// an up and a down file, and versions must be numbered from 1 without gaps.
+func read(files fs.FS) ([]migration, error) {
+	names, err := fs.Glob(files, "migrations/*.sql")
+	if err != nil {
+		return nil, err
+	}
+
+	byVersion := make(map[int]*migration)
+	for _, name := range names {
+		match := fileName.FindStringSubmatch(path.Base(name))
+		if match == nil {
+			return nil, fmt.Errorf("invalid migration file name %q: use NNNN_name.up.sql or NNNN_name.down.sql", name)
+		}
+		version, _ := strconv.Atoi(match[1])
+		content, err := fs.ReadFile(files, name)
+		if err != nil {
+			return nil, err
+		}
+
+		mig, ok := byVersion[version]
+		if !ok {
+			mig = &migration{version: version, name: match[2]}
+			byVersion[version] = mig
+		} else if mig.name != match[2] {
+			return nil, fmt.Errorf("migration %04d has two names: %s and %s", version, mig.name, match[2])
+		}
+		if match[3] == "up" {
+			mig.up = string(content)
+		} else {
+			mig.down = string(content)
+		}
+	}
+
+	migrations := make([]migration, 0, len(byVersion))
+	for _, mig := range byVersion {
+		if mig.up == "" || mig.down == "" {
+			return nil, fmt.Errorf("migration %04d_%s needs both an up and a down file", mig.version, mig.name)
+		}
+		migrations = append(migrations, *mig)
+	}
+	sort.Slice(migrations, func(i, j int) bool { return migrations[i].version < migrations[j].version })
+	for i, mig := range migrations {
+		if mig.version != i+1 {
+			return nil, fmt.Errorf("migration versions must start at 1 without gaps: expected %04d, found %04d", i+1, mig.version)
+		}
+	}
+	return migrations, nil
+}
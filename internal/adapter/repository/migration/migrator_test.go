@@ -0,0 +1,130 @@
+package migration
+
+import (
+	"context"
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+// testFiles holds two migrations creating independent tables.
+var testFiles = fstest.MapFS{
+	"migrations/0001_create_things.up.sql":   {Data: []byte("CREATE TABLE things (id text);")},
+	"migrations/0001_create_things.down.sql": {Data: []byte("DROP TABLE things;")},
+	"migrations/0002_create_others.up.sql":   {Data: []byte("CREATE TABLE others (id text);")},
+	"migrations/0002_create_others.down.sql": {Data: []byte("DROP TABLE others;")},
+}
+
+func openEmptyDB(t *testing.T) *gorm.DB {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	require.NoError(t, err)
+	return db
+}
+
+func appliedVersions(t *testing.T, m *Migrator) []int {
+	statuses, err := m.Status(context.Background())
+	require.NoError(t, err)
+	versions := make([]int, 0)
+	for _, status := range statuses {
+		if status.AppliedAt != nil {
+			versions = append(versions, status.Version)
+		}
+	}
+	return versions
+}
+
+func TestMigrator_UpAndDown(t *testing.T) {
+	db := openEmptyDB(t)
+	ctx := context.Background()
+	migrator := New(db, testFiles, nil)
+
+	applied, err := migrator.Up(ctx)
+	require.NoError(t, err)
+	require.Len(t, applied, 2)
+	assert.Equal(t, "create_things", applied[0].Name)
+	assert.True(t, db.Migrator().HasTable("others"))
+
+	rolledBack, err := migrator.Down(ctx, 1)
+	require.NoError(t, err)
+	require.Len(t, rolledBack, 1)
+	assert.Equal(t, 2, rolledBack[0].Version)
+	assert.False(t, db.Migrator().HasTable("others"))
+	assert.Equal(t, []int{1}, appliedVersions(t, migrator))
+}
+
+func TestMigrator_FailedMigrationIsRolledBack(t *testing.T) {
+	db := openEmptyDB(t)
+	ctx := context.Background()
+	migrator := New(db, fstest.MapFS{
+		"migrations/0001_create_things.up.sql":   {Data: []byte("CREATE TABLE things (id text);")},
+		"migrations/0001_create_things.down.sql": {Data: []byte("DROP TABLE things;")},
+		"migrations/0002_broken.up.sql":          {Data: []byte("CREATE TABLE others (id text); INSERT INTO missing VALUES (1);")},
+		"migrations/0002_broken.down.sql":        {Data: []byte("DROP TABLE others;")},
+	}, nil)
+
+	applied, err := migrator.Up(ctx)
+	assert.ErrorContains(t, err, "0002_broken")
+	require.Len(t, applied, 1)
+	assert.Equal(t, []int{1}, appliedVersions(t, migrator))
+	assert.True(t, db.Migrator().HasTable("things"))
+	assert.False(t, db.Migrator().HasTable("others"))
+}
+
+func TestMigrator_AdoptsExistingSchema(t *testing.T) {
+	db := openEmptyDB(t)
+	ctx := context.Background()
+	require.NoError(t, db.Exec("CREATE TABLE things (id text)").Error)
+
+	migrator := New(db, testFiles, func(tx *gorm.DB) (int, error) {
+		if tx.Migrator().HasTable("things") {
+			return 1, nil
+		}
+		return 0, nil
+	})
+
+	applied, err := migrator.Up(ctx)
+	require.NoError(t, err)
+	require.Len(t, applied, 1)
+	assert.Equal(t, 2, applied[0].Version)
+	assert.Equal(t, []int{1, 2}, appliedVersions(t, migrator))
+}
+
+func TestRead_Invalid(t *testing.T) {
+	tests := []struct {
+		name  string
+		files fstest.MapFS
+	}{
+		{
+			name: "missing down file",
+			files: fstest.MapFS{
+				"migrations/0001_create_things.up.sql": {Data: []byte("CREATE TABLE things (id text);")},
+			},
+		},
+		{
+			name: "gap in versions",
+			files: fstest.MapFS{
+				"migrations/0001_a.up.sql":   {Data: []byte("SELECT 1;")},
+				"migrations/0001_a.down.sql": {Data: []byte("SELECT 1;")},
+				"migrations/0003_c.up.sql":   {Data: []byte("SELECT 1;")},
+				"migrations/0003_c.down.sql": {Data: []byte("SELECT 1;")},
+			},
+		},
+		{
+			name: "bad file name",
+			files: fstest.MapFS{
+				"migrations/create_things.sql": {Data: []byte("SELECT 1;")},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := read(tt.files)
+			assert.Error(t, err)
+		})
+	}
+}
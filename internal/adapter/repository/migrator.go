@@ -0,0 +1,32 @@
+package repository
+
+import (
+	"context"
+	"time"
+)
+
+// Migration identifies one numbered schema migration.
+type Migration struct {
+	Version int    // Position in the migration history, starting at 1
+	Name    string // Short description taken from the migration file name
+}
+
+// MigrationStatus reports whether a schema migration has been applied.
+type MigrationStatus struct {
+	Migration
+	AppliedAt *time.Time // When the migration was applied, nil while it is pending
+}
+
+// IMigrator defines the interface for managing the database schema.
+type IMigrator interface {
+	// Up applies every pending migration in version order and returns the
+	// migrations it applied. Each migration runs in its own transaction.
+	Up(ctx context.Context) ([]Migration, error)
+
+	// Down rolls back the latest steps applied migrations, newest first, and
+	// returns the migrations it rolled back.
+	Down(ctx context.Context, steps int) ([]Migration, error)
+
+	// Status lists every known migration in version order.
+	Status(ctx context.Context) ([]MigrationStatus, error)
+}
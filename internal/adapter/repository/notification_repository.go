@@ -0,0 +1,27 @@
+package repository
+
+import (
+	"context"
+
+	"transaction/internal/domain"
+)
+
+// INotificationRepository defines the interface for persisting notification
+// preferences and the queue of signals held back during quiet hours.
+type INotificationRepository interface {
+	// GetPreferences retrieves the user-level notification preferences.
+	// Returns the default preferences if none have been saved yet.
+	GetPreferences(ctx context.Context) (*domain.NotificationPreferences, error)
+
+	// SavePreferences creates or replaces the notification preferences.
+	SavePreferences(ctx context.Context, prefs *domain.NotificationPreferences) (*domain.NotificationPreferences, error)
+
+	// Enqueue holds a signal back until the next digest. Queuing a signal twice has no effect.
+	Enqueue(ctx context.Context, queued *domain.QueuedNotification) error
+
+	// FindQueued retrieves the queued signals, oldest trigger first.
+	FindQueued(ctx context.Context) ([]*domain.Signal, error)
+
+	// Dequeue removes the given signals from the queue.
+	Dequeue(ctx context.Context, signalIDs []string) error
+}
@@ -0,0 +1,7 @@
+package postgres
+
+import "transaction/internal/adapter/repository/gormrepo"
+
+// Dialect is how the gormrepo repositories write SQL for PostgreSQL, which
+// sorts decimals exactly as NUMERIC.
+var Dialect = gormrepo.Dialect{NumericType: "NUMERIC"}
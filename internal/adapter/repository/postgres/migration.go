@@ -0,0 +1,21 @@
+package postgres
+
+import (
+	"embed"
+
+	"gorm.io/gorm"
+	"transaction/internal/adapter/repository"
+	"transaction/internal/adapter/repository/migration"
+)
+
+// migrationFiles holds the numbered schema migrations, one
+// NNNN_name.up.sql and NNNN_name.down.sql pair per version. They are
+// numbered like the SQLite migrations so both schemas evolve together.
+//
+//go:embed migrations/*.sql
+var migrationFiles embed.FS
+
+// NewMigrator creates a new IMigrator running the embedded migrations.
+func NewMigrator(db *gorm.DB) repository.IMigrator {
+	return migration.New(db, migrationFiles, nil)
+}
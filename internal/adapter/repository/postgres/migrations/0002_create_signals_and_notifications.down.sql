@@ -0,0 +1,3 @@
+DROP TABLE "queued_notifications";
+DROP TABLE "notification_preferences";
+DROP TABLE "signals";
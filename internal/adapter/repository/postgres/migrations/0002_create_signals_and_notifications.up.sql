@@ -0,0 +1,31 @@
+CREATE TABLE "signals" (
+	"id" text,
+	"strategy_id" text,
+	"symbol" text,
+	"side" text,
+	"trigger_price" text,
+	"observed_price" text,
+	"triggered_at" timestamptz,
+	"acknowledged" boolean,
+	"acknowledged_at" timestamptz,
+	"created_at" timestamptz,
+	PRIMARY KEY ("id")
+);
+CREATE INDEX "idx_signals_strategy_id" ON "signals"("strategy_id");
+CREATE INDEX "idx_signals_triggered_at" ON "signals"("triggered_at");
+
+CREATE TABLE "notification_preferences" (
+	"id" text,
+	"timezone" text,
+	"quiet_hours" text,
+	"muted_strategies" text,
+	"min_severity" text,
+	"updated_at" timestamptz,
+	PRIMARY KEY ("id")
+);
+
+CREATE TABLE "queued_notifications" (
+	"signal_id" text,
+	"queued_at" timestamptz,
+	PRIMARY KEY ("signal_id")
+);
@@ -0,0 +1,15 @@
+CREATE TABLE "trades" (
+	"id" text,
+	"symbol" text,
+	"side" text,
+	"quantity" text,
+	"price" text,
+	"fee" text,
+	"strategy_id" text,
+	"executed_at" timestamptz,
+	"created_at" timestamptz,
+	PRIMARY KEY ("id")
+);
+CREATE INDEX "idx_trades_symbol" ON "trades"("symbol");
+CREATE INDEX "idx_trades_strategy_id" ON "trades"("strategy_id");
+CREATE INDEX "idx_trades_executed_at" ON "trades"("executed_at");
@@ -0,0 +1,12 @@
+CREATE TABLE "markets" (
+	"symbol" text,
+	"base_asset" text,
+	"quote_asset" text,
+	"tick_size" text,
+	"lot_size" text,
+	"min_notional" text,
+	"enabled" boolean,
+	"created_at" timestamptz,
+	"updated_at" timestamptz,
+	PRIMARY KEY ("symbol")
+);
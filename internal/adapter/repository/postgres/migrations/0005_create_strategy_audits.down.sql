@@ -0,0 +1,2 @@
+DROP TABLE "strategy_audits";
+DROP FUNCTION "strategy_audits_append_only"();
@@ -0,0 +1,23 @@
+CREATE TABLE "strategy_audits" (
+	"id" text,
+	"strategy_id" text,
+	"action" text,
+	"actor" text,
+	"before" text,
+	"after" text,
+	"created_at" timestamptz,
+	PRIMARY KEY ("id")
+);
+CREATE INDEX "idx_strategy_audits_strategy_id" ON "strategy_audits"("strategy_id");
+CREATE INDEX "idx_strategy_audits_created_at" ON "strategy_audits"("created_at");
+
+-- The audit log is append-only: reject any change to an existing entry
+CREATE FUNCTION "strategy_audits_append_only"() RETURNS trigger AS $$
+BEGIN
+	RAISE EXCEPTION 'strategy audit log is append-only';
+END;
+$$ LANGUAGE plpgsql;
+CREATE TRIGGER "strategy_audits_no_update" BEFORE UPDATE ON "strategy_audits"
+FOR EACH ROW EXECUTE FUNCTION "strategy_audits_append_only"();
+CREATE TRIGGER "strategy_audits_no_delete" BEFORE DELETE ON "strategy_audits"
+FOR EACH ROW EXECUTE FUNCTION "strategy_audits_append_only"();
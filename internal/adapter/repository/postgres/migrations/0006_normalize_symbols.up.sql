@@ -0,0 +1,9 @@
+-- Rewrite symbols stored before they were normalized, such as "BTC" or
+-- "eth-usdt", to the BASE/QUOTE form of domain.NormalizeSymbol. A bare base
+-- asset is quoted in USDT.
+UPDATE "strategies" SET "symbol" = UPPER(REPLACE(REPLACE(TRIM("symbol"), '-', '/'), '_', '/'));
+UPDATE "strategies" SET "symbol" = "symbol" || '/USDT' WHERE "symbol" <> '' AND "symbol" NOT LIKE '%/%';
+UPDATE "trades" SET "symbol" = UPPER(REPLACE(REPLACE(TRIM("symbol"), '-', '/'), '_', '/'));
+UPDATE "trades" SET "symbol" = "symbol" || '/USDT' WHERE "symbol" <> '' AND "symbol" NOT LIKE '%/%';
+UPDATE "signals" SET "symbol" = UPPER(REPLACE(REPLACE(TRIM("symbol"), '-', '/'), '_', '/'));
+UPDATE "signals" SET "symbol" = "symbol" || '/USDT' WHERE "symbol" <> '' AND "symbol" NOT LIKE '%/%';
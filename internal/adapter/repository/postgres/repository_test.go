@@ -0,0 +1,134 @@
+package postgres
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"transaction/internal/adapter/repository"
+	"transaction/internal/adapter/repository/gormrepo"
+	"transaction/internal/adapter/repository/repotest"
+	"transaction/internal/domain"
+)
+
+// testDSNEnv names the database the tests run against. The tests are skipped
+// when it is not set. Every table in the database is emptied by the tests.
+const testDSNEnv = "STRATEGY_TEST_POSTGRES_DSN"
+
+// testDSN returns the test database, skipping the test when none is set.
+func testDSN(t *testing.T) string {
+	dsn := os.Getenv(testDSNEnv)
+	if dsn == "" {
+		t.Skipf("set %s to run the PostgreSQL tests", testDSNEnv)
+	}
+	return dsn
+}
+
+// setupTestDB connects to the test database, applies the migrations and
+// empties the tables.
+func setupTestDB(t *testing.T, dsn string) *gorm.DB {
+	db, err := gorm.Open(postgres.Open(dsn), &gorm.Config{})
+	require.NoError(t, err, "failed to connect to test database")
+	t.Cleanup(func() {
+		if sqlDB, err := db.DB(); err == nil {
+			sqlDB.Close()
+		}
+	})
+
+	// Run migrations
+	_, err = NewMigrator(db).Up(context.Background())
+	require.NoError(t, err, "failed to run migrations")
+
+	// The audit log rejects deletes, but TRUNCATE does not fire row triggers
+	err = db.Exec(`TRUNCATE "strategies", "signals", "notification_preferences",
+		"queued_notifications", "trades", "markets", "strategy_audits"`).Error
+	require.NoError(t, err, "failed to empty tables")
+
+	return db
+}
+
+// setupRepositories returns the repositories of the emptied test database.
+func setupRepositories(t *testing.T, dsn string) *repotest.Repositories {
+	db := setupTestDB(t, dsn)
+	return &repotest.Repositories{
+		Strategies:    gormrepo.NewStrategyRepository(db, Dialect),
+		Signals:       gormrepo.NewSignalRepository(db),
+		Notifications: gormrepo.NewNotificationRepository(db),
+		Audits:        gormrepo.NewStrategyAuditRepository(db),
+		Transactor:    gormrepo.NewTransactor(db),
+	}
+}
+
+func TestStrategyRepository(t *testing.T) {
+	dsn := testDSN(t)
+	repotest.StrategyRepository(t, func(t *testing.T) repository.IStrategyRepository {
+		return gormrepo.NewStrategyRepository(setupTestDB(t, dsn), Dialect)
+	})
+}
+
+func TestSignalRepository(t *testing.T) {
+	dsn := testDSN(t)
+	repotest.SignalRepository(t, func(t *testing.T) repository.ISignalRepository {
+		return gormrepo.NewSignalRepository(setupTestDB(t, dsn))
+	})
+}
+
+func TestTradeRepository(t *testing.T) {
+	dsn := testDSN(t)
+	repotest.TradeRepository(t, func(t *testing.T) repository.ITradeRepository {
+		return gormrepo.NewTradeRepository(setupTestDB(t, dsn), Dialect)
+	})
+}
+
+func TestMarketRepository(t *testing.T) {
+	dsn := testDSN(t)
+	repotest.MarketRepository(t, func(t *testing.T) repository.IMarketRepository {
+		return gormrepo.NewMarketRepository(setupTestDB(t, dsn))
+	})
+}
+
+func TestNotificationRepository(t *testing.T) {
+	dsn := testDSN(t)
+	repotest.NotificationRepository(t, func(t *testing.T) *repotest.Repositories {
+		return setupRepositories(t, dsn)
+	})
+}
+
+func TestStrategyAuditRepository(t *testing.T) {
+	dsn := testDSN(t)
+	repotest.StrategyAuditRepository(t, func(t *testing.T) repository.IStrategyAuditRepository {
+		return gormrepo.NewStrategyAuditRepository(setupTestDB(t, dsn))
+	})
+}
+
+func TestTransactor(t *testing.T) {
+	dsn := testDSN(t)
+	repotest.Transactor(t, func(t *testing.T) *repotest.Repositories {
+		return setupRepositories(t, dsn)
+	})
+}
+
+func TestMigrate_AuditIsAppendOnly(t *testing.T) {
+	db := setupTestDB(t, testDSN(t))
+	ctx := context.Background()
+	repo := gormrepo.NewStrategyAuditRepository(db)
+
+	entry := repotest.NewTestAudit(t, "audit-1", domain.AuditCreate, time.Now())
+	require.NoError(t, repo.Append(ctx, entry))
+
+	err := db.Model(&domain.StrategyAudit{}).Where("id = ?", entry.ID).Update("actor", "mallory").Error
+	assert.ErrorContains(t, err, "append-only")
+
+	err = db.Delete(&domain.StrategyAudit{}, "id = ?", entry.ID).Error
+	assert.ErrorContains(t, err, "append-only")
+
+	entries, err := repo.FindByStrategyID(ctx, "strategy-1")
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	assert.Equal(t, "alice", entries[0].Actor)
+}
@@ -0,0 +1,135 @@
+package repotest
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"transaction/internal/adapter/repository"
+	"transaction/internal/domain"
+)
+
+// StrategyAuditRepository runs the IStrategyAuditRepository contract against
+// every backend. newRepo is called once per case and must return a
+// repository backed by an empty, migrated database.
+func StrategyAuditRepository(t *testing.T, newRepo func(t *testing.T) repository.IStrategyAuditRepository) {
+	tests := []struct {
+		name string
+		run  func(t *testing.T, repo repository.IStrategyAuditRepository)
+	}{
+		{"FindByStrategyID_OldestFirst", testAuditFindByStrategyIDOldestFirst},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.run(t, newRepo(t))
+		})
+	}
+}
+
+// Transactor runs the ITransactor contract against every backend. newRepos
+// is called once per case and must return the transactor, strategy and audit
+// repositories of an empty, migrated database.
+func Transactor(t *testing.T, newRepos func(t *testing.T) *Repositories) {
+	tests := []struct {
+		name string
+		run  func(t *testing.T, repos *Repositories)
+	}{
+		{"WithinTransaction_RollsBackOnError", testWithinTransactionRollsBackOnError},
+		{"WithinTransaction_CommitsAndJoinsOuterTransaction", testWithinTransactionCommitsAndJoinsOuterTransaction},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.run(t, newRepos(t))
+		})
+	}
+}
+
+// NewTestAudit returns the audit entry of a change to strategy-1 by alice.
+func NewTestAudit(t *testing.T, id string, action domain.AuditAction, at time.Time) *domain.StrategyAudit {
+	strategy := &domain.Strategy{
+		ID:        "strategy-1",
+		Symbol:    "BTC/USDT",
+		BuyLower:  decimal.NewFromInt(60000),
+		SellUpper: decimal.NewFromInt(70000),
+		IsActive:  true,
+	}
+	entry, err := domain.NewStrategyAudit(id, action, "alice", nil, strategy, at)
+	require.NoError(t, err)
+	return entry
+}
+
+func testAuditFindByStrategyIDOldestFirst(t *testing.T, repo repository.IStrategyAuditRepository) {
+	ctx := context.Background()
+	base := time.Date(2025, 11, 5, 10, 0, 0, 0, time.UTC)
+
+	require.NoError(t, repo.Append(ctx, NewTestAudit(t, "audit-2", domain.AuditUpdate, base.Add(time.Hour))))
+	require.NoError(t, repo.Append(ctx, NewTestAudit(t, "audit-1", domain.AuditCreate, base)))
+
+	entries, err := repo.FindByStrategyID(ctx, "strategy-1")
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+	assert.Equal(t, "audit-1", entries[0].ID)
+	assert.Equal(t, domain.AuditCreate, entries[0].Action)
+	assert.Equal(t, "alice", entries[0].Actor)
+	assert.Equal(t, "audit-2", entries[1].ID)
+
+	entries, err = repo.FindByStrategyID(ctx, "other")
+	require.NoError(t, err)
+	assert.Empty(t, entries)
+}
+
+func testWithinTransactionRollsBackOnError(t *testing.T, repos *Repositories) {
+	ctx := context.Background()
+
+	errBoom := errors.New("boom")
+	err := repos.Transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		_, err := repos.Strategies.Create(ctx, &domain.Strategy{
+			ID:        "strategy-1",
+			Symbol:    "BTC/USDT",
+			BuyLower:  decimal.NewFromInt(60000),
+			SellUpper: decimal.NewFromInt(70000),
+		})
+		require.NoError(t, err)
+		require.NoError(t, repos.Audits.Append(ctx, NewTestAudit(t, "audit-1", domain.AuditCreate, time.Now())))
+		return errBoom
+	})
+	assert.ErrorIs(t, err, errBoom)
+
+	_, err = repos.Strategies.FindByID(ctx, "strategy-1")
+	assert.Equal(t, domain.ErrStrategyNotFound, err)
+	entries, err := repos.Audits.FindByStrategyID(ctx, "strategy-1")
+	require.NoError(t, err)
+	assert.Empty(t, entries)
+}
+
+func testWithinTransactionCommitsAndJoinsOuterTransaction(t *testing.T, repos *Repositories) {
+	ctx := context.Background()
+
+	err := repos.Transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		_, err := repos.Strategies.Create(ctx, &domain.Strategy{
+			ID:        "strategy-1",
+			Symbol:    "BTC/USDT",
+			BuyLower:  decimal.NewFromInt(60000),
+			SellUpper: decimal.NewFromInt(70000),
+		})
+		if err != nil {
+			return err
+		}
+		return repos.Transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+			return repos.Audits.Append(ctx, NewTestAudit(t, "audit-1", domain.AuditCreate, time.Now()))
+		})
+	})
+	require.NoError(t, err)
+
+	_, err = repos.Strategies.FindByID(ctx, "strategy-1")
+	assert.NoError(t, err)
+	entries, err := repos.Audits.FindByStrategyID(ctx, "strategy-1")
+	require.NoError(t, err)
+	assert.Len(t, entries, 1)
+}
@@ -0,0 +1,112 @@
+package repotest
+
+import (
+	"context"
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"transaction/internal/adapter/repository"
+	"transaction/internal/domain"
+)
+
+// MarketRepository runs the IMarketRepository contract against every
+// backend. newRepo is called once per case and must return a repository
+// backed by an empty, migrated database.
+func MarketRepository(t *testing.T, newRepo func(t *testing.T) repository.IMarketRepository) {
+	tests := []struct {
+		name string
+		run  func(t *testing.T, repo repository.IMarketRepository)
+	}{
+		{"Create_Success", testMarketCreateSuccess},
+		{"Create_Duplicate", testMarketCreateDuplicate},
+		{"FindBySymbol_NotFound", testMarketFindBySymbolNotFound},
+		{"FindAll_OrderedBySymbol", testMarketFindAllOrderedBySymbol},
+		{"Update_Disable", testMarketUpdateDisable},
+		{"Update_NotFound", testMarketUpdateNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.run(t, newRepo(t))
+		})
+	}
+}
+
+func newTestMarket(base string) *domain.Market {
+	return domain.NewMarket(base, "USDT",
+		decimal.RequireFromString("0.01"), decimal.RequireFromString("0.00001"), decimal.NewFromInt(5))
+}
+
+func testMarketCreateSuccess(t *testing.T, repo repository.IMarketRepository) {
+	ctx := context.Background()
+
+	_, err := repo.Create(ctx, newTestMarket("BTC"))
+	require.NoError(t, err)
+
+	found, err := repo.FindBySymbol(ctx, "BTC/USDT")
+	require.NoError(t, err)
+	assert.Equal(t, "BTC", found.BaseAsset)
+	assert.Equal(t, "USDT", found.QuoteAsset)
+	assert.Equal(t, "0.01", found.TickSize.String())
+	assert.Equal(t, "0.00001", found.LotSize.String())
+	assert.Equal(t, "5", found.MinNotional.String())
+	assert.True(t, found.Enabled)
+}
+
+func testMarketCreateDuplicate(t *testing.T, repo repository.IMarketRepository) {
+	ctx := context.Background()
+
+	_, err := repo.Create(ctx, newTestMarket("BTC"))
+	require.NoError(t, err)
+
+	_, err = repo.Create(ctx, newTestMarket("BTC"))
+	assert.Equal(t, domain.ErrMarketExists, err)
+}
+
+func testMarketFindBySymbolNotFound(t *testing.T, repo repository.IMarketRepository) {
+	ctx := context.Background()
+
+	_, err := repo.FindBySymbol(ctx, "NOPE/USDT")
+	assert.Equal(t, domain.ErrMarketNotFound, err)
+}
+
+func testMarketFindAllOrderedBySymbol(t *testing.T, repo repository.IMarketRepository) {
+	ctx := context.Background()
+
+	for _, base := range []string{"SOL", "BTC", "ETH"} {
+		_, err := repo.Create(ctx, newTestMarket(base))
+		require.NoError(t, err)
+	}
+
+	markets, err := repo.FindAll(ctx)
+	require.NoError(t, err)
+	require.Len(t, markets, 3)
+	assert.Equal(t, "BTC/USDT", markets[0].Symbol)
+	assert.Equal(t, "ETH/USDT", markets[1].Symbol)
+	assert.Equal(t, "SOL/USDT", markets[2].Symbol)
+}
+
+func testMarketUpdateDisable(t *testing.T, repo repository.IMarketRepository) {
+	ctx := context.Background()
+
+	market := newTestMarket("BTC")
+	_, err := repo.Create(ctx, market)
+	require.NoError(t, err)
+
+	market.Enabled = false
+	_, err = repo.Update(ctx, market)
+	require.NoError(t, err)
+
+	found, err := repo.FindBySymbol(ctx, "BTC/USDT")
+	require.NoError(t, err)
+	assert.False(t, found.Enabled)
+}
+
+func testMarketUpdateNotFound(t *testing.T, repo repository.IMarketRepository) {
+	ctx := context.Background()
+
+	_, err := repo.Update(ctx, newTestMarket("BTC"))
+	assert.Equal(t, domain.ErrMarketNotFound, err)
+}
@@ -0,0 +1,99 @@
+package repotest
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"transaction/internal/domain"
+)
+
+// NotificationRepository runs the INotificationRepository contract against
+// every backend. newRepos is called once per case and must return the
+// notification and signal repositories of an empty, migrated database.
+func NotificationRepository(t *testing.T, newRepos func(t *testing.T) *Repositories) {
+	tests := []struct {
+		name string
+		run  func(t *testing.T, repos *Repositories)
+	}{
+		{"GetPreferences_Defaults", testGetPreferencesDefaults},
+		{"SavePreferences_RoundTrip", testSavePreferencesRoundTrip},
+		{"Queue", testNotificationQueue},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.run(t, newRepos(t))
+		})
+	}
+}
+
+func testGetPreferencesDefaults(t *testing.T, repos *Repositories) {
+	ctx := context.Background()
+
+	prefs, err := repos.Notifications.GetPreferences(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, domain.DefaultPreferencesID, prefs.ID)
+	assert.Equal(t, "UTC", prefs.Timezone)
+	assert.Equal(t, domain.SeverityInfo, prefs.MinSeverity)
+	assert.Empty(t, prefs.QuietHours)
+}
+
+func testSavePreferencesRoundTrip(t *testing.T, repos *Repositories) {
+	ctx := context.Background()
+	repo := repos.Notifications
+
+	prefs := domain.DefaultNotificationPreferences()
+	prefs.Timezone = "Asia/Taipei"
+	prefs.QuietHours = domain.MuteWindows{{Start: 22 * 60, End: 7 * 60}}
+	prefs.MinSeverity = domain.SeverityWarning
+	prefs.Mute("strategy-1")
+
+	_, err := repo.SavePreferences(ctx, prefs)
+	require.NoError(t, err)
+
+	// Saving again replaces the same row.
+	prefs.Unmute("strategy-1")
+	prefs.Mute("strategy-2")
+	_, err = repo.SavePreferences(ctx, prefs)
+	require.NoError(t, err)
+
+	found, err := repo.GetPreferences(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "Asia/Taipei", found.Timezone)
+	assert.Equal(t, domain.MuteWindows{{Start: 22 * 60, End: 7 * 60}}, found.QuietHours)
+	assert.Equal(t, domain.StringList{"strategy-2"}, found.MutedStrategies)
+	assert.Equal(t, domain.SeverityWarning, found.MinSeverity)
+}
+
+func testNotificationQueue(t *testing.T, repos *Repositories) {
+	ctx := context.Background()
+	repo := repos.Notifications
+
+	base := time.Date(2025, 11, 5, 22, 0, 0, 0, time.UTC)
+	later := newTestSignal("strategy-1", domain.SideSell, base.Add(time.Hour))
+	earlier := newTestSignal("strategy-1", domain.SideBuy, base)
+	unqueued := newTestSignal("strategy-2", domain.SideBuy, base)
+	for _, signal := range []*domain.Signal{later, earlier, unqueued} {
+		_, err := repos.Signals.Create(ctx, signal)
+		require.NoError(t, err)
+	}
+
+	require.NoError(t, repo.Enqueue(ctx, &domain.QueuedNotification{SignalID: later.ID, QueuedAt: base}))
+	require.NoError(t, repo.Enqueue(ctx, &domain.QueuedNotification{SignalID: earlier.ID, QueuedAt: base}))
+	require.NoError(t, repo.Enqueue(ctx, &domain.QueuedNotification{SignalID: earlier.ID, QueuedAt: base}))
+
+	queued, err := repo.FindQueued(ctx)
+	require.NoError(t, err)
+	require.Len(t, queued, 2)
+	assert.Equal(t, earlier.ID, queued[0].ID)
+	assert.Equal(t, later.ID, queued[1].ID)
+
+	require.NoError(t, repo.Dequeue(ctx, []string{earlier.ID, later.ID}))
+
+	queued, err = repo.FindQueued(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, queued)
+}
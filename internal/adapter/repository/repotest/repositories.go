@@ -0,0 +1,13 @@
+package repotest
+
+import "transaction/internal/adapter/repository"
+
+// Repositories are the repositories of one backend sharing a database, for
+// the contracts involving more than one of them.
+type Repositories struct {
+	Strategies    repository.IStrategyRepository
+	Signals       repository.ISignalRepository
+	Notifications repository.INotificationRepository
+	Audits        repository.IStrategyAuditRepository
+	Transactor    repository.ITransactor
+}
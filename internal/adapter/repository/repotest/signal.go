@@ -0,0 +1,134 @@
+package repotest
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"transaction/internal/adapter/repository"
+	"transaction/internal/domain"
+)
+
+// SignalRepository runs the ISignalRepository contract against every
+// backend. newRepo is called once per case and must return a repository
+// backed by an empty, migrated database.
+func SignalRepository(t *testing.T, newRepo func(t *testing.T) repository.ISignalRepository) {
+	tests := []struct {
+		name string
+		run  func(t *testing.T, repo repository.ISignalRepository)
+	}{
+		{"Create_Success", testSignalCreateSuccess},
+		{"FindByID_NotFound", testSignalFindByIDNotFound},
+		{"Find_Filters", testSignalFindFilters},
+		{"Update_Acknowledge", testSignalUpdateAcknowledge},
+		{"Update_NotFound", testSignalUpdateNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.run(t, newRepo(t))
+		})
+	}
+}
+
+func newTestSignal(strategyID string, side domain.Side, triggeredAt time.Time) *domain.Signal {
+	return &domain.Signal{
+		ID:            uuid.New().String(),
+		StrategyID:    strategyID,
+		Symbol:        "BTC",
+		Side:          side,
+		TriggerPrice:  decimal.NewFromInt(60000),
+		ObservedPrice: decimal.NewFromInt(59500),
+		TriggeredAt:   triggeredAt,
+	}
+}
+
+func testSignalCreateSuccess(t *testing.T, repo repository.ISignalRepository) {
+	ctx := context.Background()
+
+	signal := newTestSignal("strategy-1", domain.SideBuy, time.Now())
+
+	created, err := repo.Create(ctx, signal)
+	require.NoError(t, err)
+	assert.Equal(t, signal.ID, created.ID)
+
+	found, err := repo.FindByID(ctx, signal.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "strategy-1", found.StrategyID)
+	assert.Equal(t, domain.SideBuy, found.Side)
+	assert.Equal(t, "60000", found.TriggerPrice.String())
+	assert.Equal(t, "59500", found.ObservedPrice.String())
+	assert.False(t, found.Acknowledged)
+}
+
+func testSignalFindByIDNotFound(t *testing.T, repo repository.ISignalRepository) {
+	ctx := context.Background()
+
+	_, err := repo.FindByID(ctx, "non-existent-id")
+	assert.Equal(t, domain.ErrSignalNotFound, err)
+}
+
+func testSignalFindFilters(t *testing.T, repo repository.ISignalRepository) {
+	ctx := context.Background()
+
+	base := time.Date(2025, 11, 5, 10, 0, 0, 0, time.UTC)
+	old := newTestSignal("strategy-1", domain.SideBuy, base.Add(-48*time.Hour))
+	recent := newTestSignal("strategy-1", domain.SideSell, base)
+	latest := newTestSignal("strategy-1", domain.SideBuy, base.Add(time.Hour))
+	other := newTestSignal("strategy-2", domain.SideBuy, base)
+	for _, signal := range []*domain.Signal{old, recent, latest, other} {
+		_, err := repo.Create(ctx, signal)
+		require.NoError(t, err)
+	}
+
+	t.Run("no filter returns everything most recent first", func(t *testing.T) {
+		signals, err := repo.Find(ctx, repository.SignalFilter{})
+		require.NoError(t, err)
+		require.Len(t, signals, 4)
+		assert.Equal(t, latest.ID, signals[0].ID)
+		assert.Equal(t, old.ID, signals[3].ID)
+	})
+
+	t.Run("filter by strategy", func(t *testing.T) {
+		signals, err := repo.Find(ctx, repository.SignalFilter{StrategyID: "strategy-2"})
+		require.NoError(t, err)
+		require.Len(t, signals, 1)
+		assert.Equal(t, other.ID, signals[0].ID)
+	})
+
+	t.Run("filter by strategy and since", func(t *testing.T) {
+		signals, err := repo.Find(ctx, repository.SignalFilter{StrategyID: "strategy-1", Since: base})
+		require.NoError(t, err)
+		require.Len(t, signals, 2)
+		assert.Equal(t, latest.ID, signals[0].ID)
+		assert.Equal(t, recent.ID, signals[1].ID)
+	})
+}
+
+func testSignalUpdateAcknowledge(t *testing.T, repo repository.ISignalRepository) {
+	ctx := context.Background()
+
+	signal := newTestSignal("strategy-1", domain.SideBuy, time.Now())
+	_, err := repo.Create(ctx, signal)
+	require.NoError(t, err)
+
+	signal.Acknowledge(time.Now())
+	_, err = repo.Update(ctx, signal)
+	require.NoError(t, err)
+
+	found, err := repo.FindByID(ctx, signal.ID)
+	require.NoError(t, err)
+	assert.True(t, found.Acknowledged)
+	assert.NotNil(t, found.AcknowledgedAt)
+}
+
+func testSignalUpdateNotFound(t *testing.T, repo repository.ISignalRepository) {
+	ctx := context.Background()
+
+	_, err := repo.Update(ctx, newTestSignal("strategy-1", domain.SideBuy, time.Now()))
+	assert.Equal(t, domain.ErrSignalNotFound, err)
+}
@@ -0,0 +1,531 @@
+package repotest
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"transaction/internal/adapter/repository"
+	"transaction/internal/domain"
+)
+
+// StrategyRepository runs the IStrategyRepository contract against every
+// backend. newRepo is called once per case and must return a repository
+// backed by an empty, migrated database.
+func StrategyRepository(t *testing.T, newRepo func(t *testing.T) repository.IStrategyRepository) {
+	tests := []struct {
+		name string
+		run  func(t *testing.T, repo repository.IStrategyRepository)
+	}{
+		{"Create_Success", testCreateSuccess},
+		{"FindByID_Success", testFindByIDSuccess},
+		{"FindByID_NotFound", testFindByIDNotFound},
+		{"FindAll_Empty", testFindAllEmpty},
+		{"FindAll_Multiple", testFindAllMultiple},
+		{"FindActive", testFindActive},
+		{"FindBySymbol", testFindBySymbol},
+		{"Find_Filters", testFindFilters},
+		{"Find_UnsupportedSort", testFindUnsupportedSort},
+		{"Update_Success", testUpdateSuccess},
+		{"Update_IncrementsVersionAndKeepsOtherColumns", testUpdateIncrementsVersionAndKeepsOtherColumns},
+		{"Update_StaleVersion", testUpdateStaleVersion},
+		{"Update_NotFoundDoesNotInsert", testUpdateNotFoundDoesNotInsert},
+		{"Create_WithCooldown", testCreateWithCooldown},
+		{"UpdateTriggerState_Success", testUpdateTriggerStateSuccess},
+		{"UpdateTriggerState_NotFound", testUpdateTriggerStateNotFound},
+		{"Delete_Success", testDeleteSuccess},
+		{"FindAll_CancelledContext", testFindAllCancelledContext},
+		{"Create_CancelledContext", testCreateCancelledContext},
+		{"Delete_IsSoft", testDeleteIsSoft},
+		{"Restore_Success", testRestoreSuccess},
+		{"Restore_NotDeleted", testRestoreNotDeleted},
+		{"Purge_RemovesOnlyOldDeletions", testPurgeRemovesOnlyOldDeletions},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.run(t, newRepo(t))
+		})
+	}
+}
+
+func testCreateSuccess(t *testing.T, repo repository.IStrategyRepository) {
+	ctx := context.Background()
+
+	strategy := &domain.Strategy{
+		ID:        uuid.New().String(),
+		Symbol:    "BTC",
+		BuyLower:  decimal.NewFromInt(40000),
+		SellUpper: decimal.NewFromInt(60000),
+		IsActive:  true,
+	}
+
+	created, err := repo.Create(ctx, strategy)
+	require.NoError(t, err)
+	assert.NotNil(t, created)
+	assert.Equal(t, strategy.ID, created.ID)
+	assert.Equal(t, "BTC", created.Symbol)
+	assert.Equal(t, "40000", created.BuyLower.String())
+	assert.Equal(t, "60000", created.SellUpper.String())
+	assert.True(t, created.IsActive)
+}
+
+func testFindByIDSuccess(t *testing.T, repo repository.IStrategyRepository) {
+	ctx := context.Background()
+
+	strategy := &domain.Strategy{
+		ID:        uuid.New().String(),
+		Symbol:    "ETH",
+		BuyLower:  decimal.NewFromInt(2000),
+		SellUpper: decimal.NewFromInt(4000),
+		IsActive:  true,
+	}
+	_, err := repo.Create(ctx, strategy)
+	require.NoError(t, err)
+
+	found, err := repo.FindByID(ctx, strategy.ID)
+	require.NoError(t, err)
+	assert.Equal(t, strategy.ID, found.ID)
+	assert.Equal(t, "ETH", found.Symbol)
+}
+
+func testFindByIDNotFound(t *testing.T, repo repository.IStrategyRepository) {
+	ctx := context.Background()
+
+	_, err := repo.FindByID(ctx, "non-existent-id")
+	assert.Error(t, err)
+	assert.Equal(t, domain.ErrStrategyNotFound, err)
+}
+
+func testFindAllEmpty(t *testing.T, repo repository.IStrategyRepository) {
+	ctx := context.Background()
+
+	strategies, err := repo.FindAll(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, strategies)
+}
+
+func testFindAllMultiple(t *testing.T, repo repository.IStrategyRepository) {
+	ctx := context.Background()
+
+	strategy1 := &domain.Strategy{
+		ID:        uuid.New().String(),
+		Symbol:    "BTC",
+		BuyLower:  decimal.NewFromInt(40000),
+		SellUpper: decimal.NewFromInt(60000),
+		IsActive:  true,
+	}
+	strategy2 := &domain.Strategy{
+		ID:        uuid.New().String(),
+		Symbol:    "ETH",
+		BuyLower:  decimal.NewFromInt(2000),
+		SellUpper: decimal.NewFromInt(4000),
+		IsActive:  false,
+	}
+
+	_, err := repo.Create(ctx, strategy1)
+	require.NoError(t, err)
+	_, err = repo.Create(ctx, strategy2)
+	require.NoError(t, err)
+
+	strategies, err := repo.FindAll(ctx)
+	require.NoError(t, err)
+	assert.Len(t, strategies, 2)
+}
+
+// seedFilterStrategies creates strategies with distinct creation times, oldest first.
+func seedFilterStrategies(t *testing.T, repo repository.IStrategyRepository, base time.Time) []*domain.Strategy {
+	strategies := []*domain.Strategy{
+		{ID: "btc-active", Symbol: "BTC/USDT", BuyLower: decimal.NewFromInt(60000), SellUpper: decimal.NewFromInt(70000), IsActive: true},
+		{ID: "eth-active", Symbol: "ETH/USDT", BuyLower: decimal.NewFromInt(3000), SellUpper: decimal.NewFromInt(4000), IsActive: true},
+		{ID: "btc-inactive", Symbol: "BTC/USDT", BuyLower: decimal.NewFromInt(50000), SellUpper: decimal.NewFromInt(80000), IsActive: false},
+		{ID: "sol-active", Symbol: "SOL/USDT", BuyLower: decimal.NewFromInt(90), SellUpper: decimal.NewFromInt(200), IsActive: true},
+	}
+	for i, strategy := range strategies {
+		strategy.CreatedAt = base.Add(time.Duration(i) * time.Hour)
+		_, err := repo.Create(context.Background(), strategy)
+		require.NoError(t, err)
+	}
+	return strategies
+}
+
+func strategyIDs(strategies []*domain.Strategy) []string {
+	ids := make([]string, len(strategies))
+	for i, strategy := range strategies {
+		ids[i] = strategy.ID
+	}
+	return ids
+}
+
+func testFindActive(t *testing.T, repo repository.IStrategyRepository) {
+	ctx := context.Background()
+	seedFilterStrategies(t, repo, time.Date(2025, 11, 5, 10, 0, 0, 0, time.UTC))
+
+	strategies, err := repo.FindActive(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"btc-active", "eth-active", "sol-active"}, strategyIDs(strategies))
+}
+
+func testFindBySymbol(t *testing.T, repo repository.IStrategyRepository) {
+	ctx := context.Background()
+	seedFilterStrategies(t, repo, time.Date(2025, 11, 5, 10, 0, 0, 0, time.UTC))
+
+	strategies, err := repo.FindBySymbol(ctx, "BTC/USDT")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"btc-active", "btc-inactive"}, strategyIDs(strategies))
+}
+
+func testFindFilters(t *testing.T, repo repository.IStrategyRepository) {
+	ctx := context.Background()
+	base := time.Date(2025, 11, 5, 10, 0, 0, 0, time.UTC)
+	seedFilterStrategies(t, repo, base)
+
+	active := true
+	inactive := false
+	tests := []struct {
+		name   string
+		filter repository.StrategyFilter
+		want   []string
+	}{
+		{name: "no filter oldest first", filter: repository.StrategyFilter{}, want: []string{"btc-active", "eth-active", "btc-inactive", "sol-active"}},
+		{name: "by symbol and active", filter: repository.StrategyFilter{Symbol: "BTC/USDT", Active: &active}, want: []string{"btc-active"}},
+		{name: "inactive only", filter: repository.StrategyFilter{Active: &inactive}, want: []string{"btc-inactive"}},
+		{name: "created range is half open", filter: repository.StrategyFilter{CreatedAfter: base.Add(time.Hour), CreatedBefore: base.Add(3 * time.Hour)}, want: []string{"eth-active", "btc-inactive"}},
+		{name: "newest first", filter: repository.StrategyFilter{Descending: true}, want: []string{"sol-active", "btc-inactive", "eth-active", "btc-active"}},
+		{name: "by symbol then age", filter: repository.StrategyFilter{SortBy: repository.StrategySortSymbol}, want: []string{"btc-active", "btc-inactive", "eth-active", "sol-active"}},
+		{name: "by buy lower compares numerically", filter: repository.StrategyFilter{SortBy: repository.StrategySortBuyLower, Limit: 2}, want: []string{"sol-active", "eth-active"}},
+		{name: "by sell upper descending", filter: repository.StrategyFilter{SortBy: repository.StrategySortSellUpper, Descending: true, Limit: 1}, want: []string{"btc-inactive"}},
+		{name: "second page", filter: repository.StrategyFilter{Limit: 2, Offset: 2}, want: []string{"btc-inactive", "sol-active"}},
+		{name: "by id", filter: repository.StrategyFilter{ID: "eth-active"}, want: []string{"eth-active"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			strategies, err := repo.Find(ctx, tt.filter)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, strategyIDs(strategies))
+		})
+	}
+}
+
+func testFindUnsupportedSort(t *testing.T, repo repository.IStrategyRepository) {
+	ctx := context.Background()
+
+	_, err := repo.Find(ctx, repository.StrategyFilter{SortBy: "id; DROP TABLE strategies"})
+	assert.Error(t, err)
+}
+
+func testUpdateSuccess(t *testing.T, repo repository.IStrategyRepository) {
+	ctx := context.Background()
+
+	strategy := &domain.Strategy{
+		ID:        uuid.New().String(),
+		Symbol:    "BTC",
+		BuyLower:  decimal.NewFromInt(40000),
+		SellUpper: decimal.NewFromInt(60000),
+		IsActive:  true,
+	}
+	_, err := repo.Create(ctx, strategy)
+	require.NoError(t, err)
+
+	// Update the strategy
+	strategy.BuyLower = decimal.NewFromInt(35000)
+	strategy.SellUpper = decimal.NewFromInt(65000)
+	strategy.IsActive = false
+
+	updated, err := repo.Update(ctx, strategy)
+	require.NoError(t, err)
+	assert.Equal(t, "35000", updated.BuyLower.String())
+	assert.Equal(t, "65000", updated.SellUpper.String())
+	assert.False(t, updated.IsActive)
+}
+
+func testUpdateIncrementsVersionAndKeepsOtherColumns(t *testing.T, repo repository.IStrategyRepository) {
+	ctx := context.Background()
+
+	signalAt := time.Date(2025, 11, 5, 10, 0, 0, 0, time.UTC)
+	strategy := &domain.Strategy{
+		ID:           uuid.New().String(),
+		Symbol:       "BTC",
+		BuyLower:     decimal.NewFromInt(40000),
+		SellUpper:    decimal.NewFromInt(60000),
+		IsActive:     true,
+		LastZone:     domain.ZoneBuy,
+		LastSignalAt: &signalAt,
+	}
+	_, err := repo.Create(ctx, strategy)
+	require.NoError(t, err)
+	assert.Equal(t, 1, strategy.Version)
+
+	stored, err := repo.FindByID(ctx, strategy.ID)
+	require.NoError(t, err)
+
+	// A stale copy must not clobber the trigger state written by the monitor.
+	stale := *stored
+	stale.LastZone = domain.ZoneNeutral
+	stale.LastSignalAt = nil
+	stale.CreatedAt = time.Time{}
+	stale.BuyLower = decimal.NewFromInt(35000)
+
+	updated, err := repo.Update(ctx, &stale)
+	require.NoError(t, err)
+	assert.Equal(t, 2, updated.Version)
+
+	found, err := repo.FindByID(ctx, strategy.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 2, found.Version)
+	assert.Equal(t, "35000", found.BuyLower.String())
+	assert.Equal(t, domain.ZoneBuy, found.LastZone)
+	require.NotNil(t, found.LastSignalAt)
+	assert.True(t, found.LastSignalAt.Equal(signalAt))
+	assert.True(t, found.CreatedAt.Equal(stored.CreatedAt))
+}
+
+func testUpdateStaleVersion(t *testing.T, repo repository.IStrategyRepository) {
+	ctx := context.Background()
+
+	strategy := &domain.Strategy{
+		ID:        uuid.New().String(),
+		Symbol:    "BTC",
+		BuyLower:  decimal.NewFromInt(40000),
+		SellUpper: decimal.NewFromInt(60000),
+		IsActive:  true,
+	}
+	_, err := repo.Create(ctx, strategy)
+	require.NoError(t, err)
+
+	first, err := repo.FindByID(ctx, strategy.ID)
+	require.NoError(t, err)
+	second, err := repo.FindByID(ctx, strategy.ID)
+	require.NoError(t, err)
+
+	first.BuyLower = decimal.NewFromInt(35000)
+	_, err = repo.Update(ctx, first)
+	require.NoError(t, err)
+
+	second.SellUpper = decimal.NewFromInt(65000)
+	_, err = repo.Update(ctx, second)
+	assert.Equal(t, domain.ErrConcurrentModification, err)
+
+	found, err := repo.FindByID(ctx, strategy.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "35000", found.BuyLower.String())
+	assert.Equal(t, "60000", found.SellUpper.String())
+}
+
+func testUpdateNotFoundDoesNotInsert(t *testing.T, repo repository.IStrategyRepository) {
+	ctx := context.Background()
+
+	_, err := repo.Update(ctx, &domain.Strategy{
+		ID:        "missing",
+		Symbol:    "BTC",
+		BuyLower:  decimal.NewFromInt(40000),
+		SellUpper: decimal.NewFromInt(60000),
+		Version:   1,
+	})
+	assert.Equal(t, domain.ErrStrategyNotFound, err)
+
+	strategies, err := repo.Find(ctx, repository.StrategyFilter{IncludeDeleted: true})
+	require.NoError(t, err)
+	assert.Empty(t, strategies)
+}
+
+func testCreateWithCooldown(t *testing.T, repo repository.IStrategyRepository) {
+	ctx := context.Background()
+
+	cooldown := 30 * time.Minute
+	strategy := &domain.Strategy{
+		ID:        uuid.New().String(),
+		Symbol:    "BTC",
+		BuyLower:  decimal.NewFromInt(40000),
+		SellUpper: decimal.NewFromInt(60000),
+		IsActive:  true,
+		Cooldown:  &cooldown,
+	}
+	_, err := repo.Create(ctx, strategy)
+	require.NoError(t, err)
+
+	found, err := repo.FindByID(ctx, strategy.ID)
+	require.NoError(t, err)
+	require.NotNil(t, found.Cooldown)
+	assert.Equal(t, cooldown, *found.Cooldown)
+	assert.Equal(t, domain.ZoneNeutral, found.LastZone)
+	assert.Nil(t, found.LastSignalAt)
+}
+
+func testUpdateTriggerStateSuccess(t *testing.T, repo repository.IStrategyRepository) {
+	ctx := context.Background()
+
+	strategy := &domain.Strategy{
+		ID:        uuid.New().String(),
+		Symbol:    "BTC",
+		BuyLower:  decimal.NewFromInt(40000),
+		SellUpper: decimal.NewFromInt(60000),
+		IsActive:  true,
+	}
+	_, err := repo.Create(ctx, strategy)
+	require.NoError(t, err)
+
+	// A stale copy carrying other changes must not overwrite the configuration.
+	stale := *strategy
+	stale.SellUpper = decimal.NewFromInt(90000)
+	side, signaled := stale.Evaluate(decimal.NewFromInt(39000), time.Now())
+	require.True(t, signaled)
+	require.Equal(t, domain.SideBuy, side)
+
+	err = repo.UpdateTriggerState(ctx, &stale)
+	require.NoError(t, err)
+
+	found, err := repo.FindByID(ctx, strategy.ID)
+	require.NoError(t, err)
+	assert.Equal(t, domain.ZoneBuy, found.LastZone)
+	require.NotNil(t, found.LastSignalAt)
+	assert.WithinDuration(t, *stale.LastSignalAt, *found.LastSignalAt, time.Millisecond)
+	assert.Equal(t, "60000", found.SellUpper.String())
+}
+
+func testUpdateTriggerStateNotFound(t *testing.T, repo repository.IStrategyRepository) {
+	ctx := context.Background()
+
+	err := repo.UpdateTriggerState(ctx, &domain.Strategy{ID: "non-existent-id"})
+	assert.Equal(t, domain.ErrStrategyNotFound, err)
+}
+
+func testDeleteSuccess(t *testing.T, repo repository.IStrategyRepository) {
+	ctx := context.Background()
+
+	strategy := &domain.Strategy{
+		ID:        uuid.New().String(),
+		Symbol:    "BTC",
+		BuyLower:  decimal.NewFromInt(40000),
+		SellUpper: decimal.NewFromInt(60000),
+		IsActive:  true,
+	}
+	_, err := repo.Create(ctx, strategy)
+	require.NoError(t, err)
+
+	// Verify it exists
+	_, err = repo.FindByID(ctx, strategy.ID)
+	require.NoError(t, err)
+
+	// Delete it
+	err = repo.Delete(ctx, strategy.ID)
+	require.NoError(t, err)
+
+	// Verify it's gone
+	_, err = repo.FindByID(ctx, strategy.ID)
+	assert.Error(t, err)
+	assert.Equal(t, domain.ErrStrategyNotFound, err)
+}
+
+func testFindAllCancelledContext(t *testing.T, repo repository.IStrategyRepository) {
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	strategies, err := repo.FindAll(ctx)
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Nil(t, strategies)
+}
+
+func testCreateCancelledContext(t *testing.T, repo repository.IStrategyRepository) {
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	strategy := &domain.Strategy{
+		ID:        uuid.New().String(),
+		Symbol:    "BTC",
+		BuyLower:  decimal.NewFromInt(40000),
+		SellUpper: decimal.NewFromInt(60000),
+		IsActive:  true,
+	}
+	_, err := repo.Create(ctx, strategy)
+	assert.ErrorIs(t, err, context.Canceled)
+
+	strategies, err := repo.FindAll(context.Background())
+	require.NoError(t, err)
+	assert.Empty(t, strategies)
+}
+
+func testDeleteIsSoft(t *testing.T, repo repository.IStrategyRepository) {
+	ctx := context.Background()
+	seedFilterStrategies(t, repo, time.Date(2025, 11, 5, 10, 0, 0, 0, time.UTC))
+
+	require.NoError(t, repo.Delete(ctx, "btc-active"))
+	assert.Equal(t, domain.ErrStrategyNotFound, repo.Delete(ctx, "btc-active"))
+
+	all, err := repo.FindAll(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"eth-active", "btc-inactive", "sol-active"}, strategyIDs(all))
+
+	active, err := repo.FindActive(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"eth-active", "sol-active"}, strategyIDs(active))
+
+	withDeleted, err := repo.Find(ctx, repository.StrategyFilter{Symbol: "BTC/USDT", IncludeDeleted: true})
+	require.NoError(t, err)
+	require.Equal(t, []string{"btc-active", "btc-inactive"}, strategyIDs(withDeleted))
+	assert.True(t, withDeleted[0].IsDeleted())
+	assert.False(t, withDeleted[1].IsDeleted())
+
+	byID, err := repo.Find(ctx, repository.StrategyFilter{ID: "btc-active"})
+	require.NoError(t, err)
+	assert.Empty(t, byID)
+	byID, err = repo.Find(ctx, repository.StrategyFilter{ID: "btc-active", IncludeDeleted: true})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"btc-active"}, strategyIDs(byID))
+
+	err = repo.UpdateTriggerState(ctx, &domain.Strategy{ID: "btc-active", LastZone: domain.ZoneBuy})
+	assert.Equal(t, domain.ErrStrategyNotFound, err)
+}
+
+func testRestoreSuccess(t *testing.T, repo repository.IStrategyRepository) {
+	ctx := context.Background()
+	seedFilterStrategies(t, repo, time.Date(2025, 11, 5, 10, 0, 0, 0, time.UTC))
+
+	require.NoError(t, repo.Delete(ctx, "btc-active"))
+
+	restored, err := repo.Restore(ctx, "btc-active")
+	require.NoError(t, err)
+	assert.False(t, restored.IsDeleted())
+	assert.Equal(t, "60000", restored.BuyLower.String())
+
+	found, err := repo.FindByID(ctx, "btc-active")
+	require.NoError(t, err)
+	assert.True(t, found.IsActive)
+}
+
+func testRestoreNotDeleted(t *testing.T, repo repository.IStrategyRepository) {
+	ctx := context.Background()
+	seedFilterStrategies(t, repo, time.Date(2025, 11, 5, 10, 0, 0, 0, time.UTC))
+
+	_, err := repo.Restore(ctx, "btc-active")
+	assert.Equal(t, domain.ErrStrategyNotFound, err)
+
+	_, err = repo.Restore(ctx, "unknown")
+	assert.Equal(t, domain.ErrStrategyNotFound, err)
+}
+
+func testPurgeRemovesOnlyOldDeletions(t *testing.T, repo repository.IStrategyRepository) {
+	ctx := context.Background()
+	seedFilterStrategies(t, repo, time.Date(2025, 11, 5, 10, 0, 0, 0, time.UTC))
+
+	require.NoError(t, repo.Delete(ctx, "btc-active"))
+	time.Sleep(10 * time.Millisecond)
+	cutoff := time.Now()
+	time.Sleep(10 * time.Millisecond)
+	require.NoError(t, repo.Delete(ctx, "eth-active"))
+
+	purged, err := repo.Purge(ctx, cutoff)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), purged)
+
+	remaining, err := repo.Find(ctx, repository.StrategyFilter{IncludeDeleted: true})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"eth-active", "btc-inactive", "sol-active"}, strategyIDs(remaining))
+}
@@ -0,0 +1,184 @@
+package repotest
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"transaction/internal/adapter/repository"
+	"transaction/internal/domain"
+)
+
+// TradeRepository runs the ITradeRepository contract against every backend.
+// newRepo is called once per case and must return a repository backed by an
+// empty, migrated database.
+func TradeRepository(t *testing.T, newRepo func(t *testing.T) repository.ITradeRepository) {
+	tests := []struct {
+		name string
+		run  func(t *testing.T, repo repository.ITradeRepository)
+	}{
+		{"Create_Success", testTradeCreateSuccess},
+		{"FindByID_NotFound", testTradeFindByIDNotFound},
+		{"FindAll_OrderedByExecution", testTradeFindAllOrderedByExecution},
+		{"FindBySymbol", testTradeFindBySymbol},
+		{"Find_Filters", testTradeFindFilters},
+		{"Find_UnsupportedSort", testTradeFindUnsupportedSort},
+		{"Find_CancelledContext", testTradeFindCancelledContext},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.run(t, newRepo(t))
+		})
+	}
+}
+
+func newTestTrade(side domain.Side, executedAt time.Time) *domain.Trade {
+	return &domain.Trade{
+		ID:         uuid.New().String(),
+		Symbol:     "BTC",
+		Side:       side,
+		Quantity:   decimal.RequireFromString("0.5"),
+		Price:      decimal.NewFromInt(60000),
+		Fee:        decimal.RequireFromString("12.5"),
+		ExecutedAt: executedAt,
+	}
+}
+
+func testTradeCreateSuccess(t *testing.T, repo repository.ITradeRepository) {
+	ctx := context.Background()
+
+	strategyID := "strategy-1"
+	trade := newTestTrade(domain.SideBuy, time.Now())
+	trade.StrategyID = &strategyID
+
+	created, err := repo.Create(ctx, trade)
+	require.NoError(t, err)
+	assert.Equal(t, trade.ID, created.ID)
+
+	found, err := repo.FindByID(ctx, trade.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "BTC", found.Symbol)
+	assert.Equal(t, domain.SideBuy, found.Side)
+	assert.Equal(t, "0.5", found.Quantity.String())
+	assert.Equal(t, "60000", found.Price.String())
+	assert.Equal(t, "12.5", found.Fee.String())
+	require.NotNil(t, found.StrategyID)
+	assert.Equal(t, "strategy-1", *found.StrategyID)
+}
+
+func testTradeFindByIDNotFound(t *testing.T, repo repository.ITradeRepository) {
+	ctx := context.Background()
+
+	_, err := repo.FindByID(ctx, "non-existent-id")
+	assert.Equal(t, domain.ErrTradeNotFound, err)
+}
+
+func testTradeFindAllOrderedByExecution(t *testing.T, repo repository.ITradeRepository) {
+	ctx := context.Background()
+
+	base := time.Date(2025, 11, 5, 10, 0, 0, 0, time.UTC)
+	later := newTestTrade(domain.SideSell, base.Add(time.Hour))
+	earlier := newTestTrade(domain.SideBuy, base)
+	for _, trade := range []*domain.Trade{later, earlier} {
+		_, err := repo.Create(ctx, trade)
+		require.NoError(t, err)
+	}
+
+	trades, err := repo.FindAll(ctx)
+	require.NoError(t, err)
+	require.Len(t, trades, 2)
+	assert.Equal(t, earlier.ID, trades[0].ID)
+	assert.Equal(t, later.ID, trades[1].ID)
+	assert.Nil(t, trades[0].StrategyID)
+}
+
+func testTradeFindBySymbol(t *testing.T, repo repository.ITradeRepository) {
+	ctx := context.Background()
+
+	base := time.Date(2025, 11, 5, 10, 0, 0, 0, time.UTC)
+	btc := newTestTrade(domain.SideBuy, base)
+	eth := newTestTrade(domain.SideBuy, base)
+	eth.Symbol = "ETH"
+	for _, trade := range []*domain.Trade{btc, eth} {
+		_, err := repo.Create(ctx, trade)
+		require.NoError(t, err)
+	}
+
+	trades, err := repo.FindBySymbol(ctx, "ETH")
+	require.NoError(t, err)
+	require.Len(t, trades, 1)
+	assert.Equal(t, eth.ID, trades[0].ID)
+}
+
+func testTradeFindFilters(t *testing.T, repo repository.ITradeRepository) {
+	ctx := context.Background()
+
+	base := time.Date(2025, 11, 5, 10, 0, 0, 0, time.UTC)
+	strategyID := "strategy-1"
+
+	oldBuy := newTestTrade(domain.SideBuy, base.Add(-48*time.Hour))
+	buy := newTestTrade(domain.SideBuy, base)
+	buy.StrategyID = &strategyID
+	buy.Price = decimal.NewFromInt(61000)
+	sell := newTestTrade(domain.SideSell, base.Add(time.Hour))
+	sell.Quantity = decimal.RequireFromString("0.1")
+	ethBuy := newTestTrade(domain.SideBuy, base.Add(2*time.Hour))
+	ethBuy.Symbol = "ETH"
+	ethBuy.Price = decimal.NewFromInt(9000)
+	for _, trade := range []*domain.Trade{oldBuy, buy, sell, ethBuy} {
+		_, err := repo.Create(ctx, trade)
+		require.NoError(t, err)
+	}
+
+	ids := func(trades []*domain.Trade) []string {
+		result := make([]string, len(trades))
+		for i, trade := range trades {
+			result[i] = trade.ID
+		}
+		return result
+	}
+
+	tests := []struct {
+		name   string
+		filter repository.TradeFilter
+		want   []string
+	}{
+		{name: "no filter oldest first", filter: repository.TradeFilter{}, want: []string{oldBuy.ID, buy.ID, sell.ID, ethBuy.ID}},
+		{name: "by symbol", filter: repository.TradeFilter{Symbol: "ETH"}, want: []string{ethBuy.ID}},
+		{name: "by side", filter: repository.TradeFilter{Side: domain.SideSell}, want: []string{sell.ID}},
+		{name: "by strategy", filter: repository.TradeFilter{StrategyID: "strategy-1"}, want: []string{buy.ID}},
+		{name: "date range is half open", filter: repository.TradeFilter{From: base, To: base.Add(time.Hour)}, want: []string{buy.ID}},
+		{name: "newest first", filter: repository.TradeFilter{Descending: true}, want: []string{ethBuy.ID, sell.ID, buy.ID, oldBuy.ID}},
+		{name: "by price descending", filter: repository.TradeFilter{SortBy: repository.TradeSortPrice, Descending: true, Limit: 2}, want: []string{buy.ID, sell.ID}},
+		{name: "by price compares numerically", filter: repository.TradeFilter{SortBy: repository.TradeSortPrice, Limit: 1}, want: []string{ethBuy.ID}},
+		{name: "second page", filter: repository.TradeFilter{Limit: 2, Offset: 2}, want: []string{sell.ID, ethBuy.ID}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			trades, err := repo.Find(ctx, tt.filter)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, ids(trades))
+		})
+	}
+}
+
+func testTradeFindUnsupportedSort(t *testing.T, repo repository.ITradeRepository) {
+	ctx := context.Background()
+
+	_, err := repo.Find(ctx, repository.TradeFilter{SortBy: "fee; DROP TABLE trades"})
+	assert.Error(t, err)
+}
+
+func testTradeFindCancelledContext(t *testing.T, repo repository.ITradeRepository) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := repo.Find(ctx, repository.TradeFilter{})
+	assert.ErrorIs(t, err, context.Canceled)
+}
@@ -0,0 +1,32 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"transaction/internal/domain"
+)
+
+// SignalFilter narrows the signals returned by ISignalRepository.Find.
+// Zero-valued fields are ignored.
+type SignalFilter struct {
+	StrategyID string    // Only signals of this strategy
+	Since      time.Time // Only signals triggered at or after this time
+}
+
+// ISignalRepository defines the interface for persisting Signal entities.
+type ISignalRepository interface {
+	// Create persists a new signal and returns the created signal.
+	Create(ctx context.Context, signal *domain.Signal) (*domain.Signal, error)
+
+	// FindByID retrieves a signal by its ID.
+	// Returns ErrSignalNotFound if the signal does not exist.
+	FindByID(ctx context.Context, id string) (*domain.Signal, error)
+
+	// Find retrieves the signals matching the filter, most recent first.
+	Find(ctx context.Context, filter SignalFilter) ([]*domain.Signal, error)
+
+	// Update modifies an existing signal.
+	// Returns ErrSignalNotFound if the signal does not exist.
+	Update(ctx context.Context, signal *domain.Signal) (*domain.Signal, error)
+}
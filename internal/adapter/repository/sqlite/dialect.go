@@ -0,0 +1,7 @@
+package sqlite
+
+import "transaction/internal/adapter/repository/gormrepo"
+
+// Dialect is how the gormrepo repositories write SQL for SQLite, which has no
+// decimal type and sorts decimals as floating point numbers.
+var Dialect = gormrepo.Dialect{NumericType: "REAL"}
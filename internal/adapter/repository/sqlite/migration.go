@@ -1,16 +1,85 @@
 package sqlite
 
 import (
+	"context"
+	"embed"
+	"io/fs"
+
 	"gorm.io/gorm"
+	"transaction/internal/adapter/repository"
+	"transaction/internal/adapter/repository/migration"
 	"transaction/internal/domain"
 )
 
-// Migrate runs all database migrations.
+// migrationFiles holds the numbered schema migrations, one
+// NNNN_name.up.sql and NNNN_name.down.sql pair per version.
+//
+//go:embed migrations/*.sql
+var migrationFiles embed.FS
+
+// legacyBaseline is the last migration whose schema was created by GORM's
+// AutoMigrate before numbered migrations were introduced.
+const legacyBaseline = 5
+
+// NewMigrator creates a new IMigrator running the embedded migrations.
+func NewMigrator(db *gorm.DB) repository.IMigrator {
+	return newMigrator(db, migrationFiles)
+}
+
+// newMigrator creates a Migrator reading migrations/*.sql from files.
+func newMigrator(db *gorm.DB, files fs.FS) *migration.Migrator {
+	return migration.New(db, files, adoptLegacyDatabase)
+}
+
+// Migrate applies every pending migration.
 func Migrate(db *gorm.DB) error {
-	return db.AutoMigrate(&domain.Strategy{})
+	_, err := NewMigrator(db).Up(context.Background())
+	return err
 }
 
 // RunMigration is an alias for Migrate for convenience.
 func RunMigration(db *gorm.DB) error {
 	return Migrate(db)
 }
+
+// adoptLegacyDatabase brings a database created by AutoMigrate before
+// numbered migrations existed up to the legacy baseline. A new database is
+// left alone.
+func adoptLegacyDatabase(tx *gorm.DB) (int, error) {
+	if !tx.Migrator().HasTable(&domain.Strategy{}) {
+		return 0, nil
+	}
+	if err := adoptLegacySchema(tx); err != nil {
+		return 0, err
+	}
+	return legacyBaseline, nil
+}
+
+// adoptLegacySchema upgrades a database created by AutoMigrate to the schema
+// of the legacy baseline, converting older column types along the way.
+func adoptLegacySchema(db *gorm.DB) error {
+	err := db.AutoMigrate(
+		&domain.Strategy{},
+		&domain.Signal{},
+		&domain.NotificationPreferences{},
+		&domain.QueuedNotification{},
+		&domain.Trade{},
+		&domain.Market{},
+		&domain.StrategyAudit{},
+	)
+	if err != nil {
+		return err
+	}
+
+	for _, trigger := range []string{
+		`CREATE TRIGGER IF NOT EXISTS strategy_audits_no_update BEFORE UPDATE ON strategy_audits
+		BEGIN SELECT RAISE(ABORT, 'strategy audit log is append-only'); END`,
+		`CREATE TRIGGER IF NOT EXISTS strategy_audits_no_delete BEFORE DELETE ON strategy_audits
+		BEGIN SELECT RAISE(ABORT, 'strategy audit log is append-only'); END`,
+	} {
+		if err := db.Exec(trigger).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
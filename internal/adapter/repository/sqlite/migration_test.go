@@ -0,0 +1,198 @@
+package sqlite
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"transaction/internal/adapter/repository"
+	"transaction/internal/adapter/repository/gormrepo"
+	"transaction/internal/adapter/repository/repotest"
+	"transaction/internal/domain"
+)
+
+// legacyStrategy and legacyTrade mirror the tables created before prices and
+// quantities were stored as decimals.
+type legacyStrategy struct {
+	ID        string `gorm:"primaryKey"`
+	Symbol    string
+	BuyLower  float64
+	SellUpper float64
+	IsActive  bool
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+func (legacyStrategy) TableName() string { return "strategies" }
+
+type legacyTrade struct {
+	ID         string `gorm:"primaryKey"`
+	Symbol     string
+	Side       string
+	Quantity   float64
+	Price      float64
+	Fee        float64
+	ExecutedAt time.Time
+	CreatedAt  time.Time
+}
+
+func (legacyTrade) TableName() string { return "trades" }
+
+func TestMigrate_ConvertsLegacyFloatColumns(t *testing.T) {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	require.NoError(t, err)
+
+	require.NoError(t, db.AutoMigrate(&legacyStrategy{}, &legacyTrade{}))
+	require.NoError(t, db.Create(&legacyStrategy{
+		ID: "strategy-1", Symbol: "BTC", BuyLower: 60000.5, SellUpper: 70000, IsActive: true,
+	}).Error)
+	require.NoError(t, db.Create(&legacyTrade{
+		ID: "trade-1", Symbol: "BTC", Side: "BUY", Quantity: 0.1, Price: 60000.25, Fee: 1.5,
+		ExecutedAt: time.Date(2025, 11, 5, 10, 0, 0, 0, time.UTC),
+	}).Error)
+
+	require.NoError(t, Migrate(db))
+	ctx := context.Background()
+
+	strategy, err := gormrepo.NewStrategyRepository(db, Dialect).FindByID(ctx, "strategy-1")
+	require.NoError(t, err)
+	assert.Equal(t, "BTC/USDT", strategy.Symbol)
+	assert.Equal(t, "60000.5", strategy.BuyLower.String())
+	assert.Equal(t, "70000", strategy.SellUpper.String())
+
+	trade, err := gormrepo.NewTradeRepository(db, Dialect).FindByID(ctx, "trade-1")
+	require.NoError(t, err)
+	assert.Equal(t, "BTC/USDT", trade.Symbol)
+	assert.Equal(t, "0.1", trade.Quantity.String())
+	assert.Equal(t, "60000.25", trade.Price.String())
+	assert.Equal(t, "1.5", trade.Fee.String())
+
+	var columnType string
+	require.NoError(t, db.Raw("SELECT typeof(quantity) FROM trades WHERE id = ?", "trade-1").Scan(&columnType).Error)
+	assert.Equal(t, "text", columnType)
+}
+
+func openEmptyDB(t *testing.T) *gorm.DB {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	require.NoError(t, err)
+	return db
+}
+
+func appliedVersions(t *testing.T, m repository.IMigrator) []int {
+	statuses, err := m.Status(context.Background())
+	require.NoError(t, err)
+	versions := make([]int, 0)
+	for _, status := range statuses {
+		if status.AppliedAt != nil {
+			versions = append(versions, status.Version)
+		}
+	}
+	return versions
+}
+
+func TestMigrator_UpOnEmptyDatabase(t *testing.T) {
+	db := openEmptyDB(t)
+	ctx := context.Background()
+	migrator := newMigrator(db, migrationFiles)
+
+	applied, err := migrator.Up(ctx)
+	require.NoError(t, err)
+	require.Len(t, applied, 6)
+	assert.Equal(t, 1, applied[0].Version)
+	assert.Equal(t, "create_strategies", applied[0].Name)
+	assert.Equal(t, []int{1, 2, 3, 4, 5, 6}, appliedVersions(t, migrator))
+
+	for _, table := range []string{"strategies", "signals", "notification_preferences",
+		"queued_notifications", "trades", "markets", "strategy_audits"} {
+		assert.True(t, db.Migrator().HasTable(table), table)
+	}
+
+	again, err := migrator.Up(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, again)
+}
+
+func TestMigrator_DownAndUpOnPopulatedDatabase(t *testing.T) {
+	db := openEmptyDB(t)
+	ctx := context.Background()
+	migrator := newMigrator(db, migrationFiles)
+	_, err := migrator.Up(ctx)
+	require.NoError(t, err)
+
+	_, err = gormrepo.NewStrategyRepository(db, Dialect).Create(ctx, &domain.Strategy{
+		ID:        "strategy-1",
+		Symbol:    "BTC/USDT",
+		BuyLower:  decimal.NewFromInt(30000),
+		SellUpper: decimal.NewFromInt(50000),
+		IsActive:  true,
+	})
+	require.NoError(t, err)
+	require.NoError(t, gormrepo.NewStrategyAuditRepository(db).Append(ctx, repotest.NewTestAudit(t, "audit-1", domain.AuditCreate, time.Now())))
+
+	rolledBack, err := migrator.Down(ctx, 2)
+	require.NoError(t, err)
+	require.Len(t, rolledBack, 2)
+	assert.Equal(t, 6, rolledBack[0].Version)
+	assert.Equal(t, 5, rolledBack[1].Version)
+	assert.False(t, db.Migrator().HasTable("strategy_audits"))
+	assert.Equal(t, []int{1, 2, 3, 4}, appliedVersions(t, migrator))
+
+	reapplied, err := migrator.Up(ctx)
+	require.NoError(t, err)
+	require.Len(t, reapplied, 2)
+	assert.Equal(t, 5, reapplied[0].Version)
+	assert.Equal(t, 6, reapplied[1].Version)
+
+	strategy, err := gormrepo.NewStrategyRepository(db, Dialect).FindByID(ctx, "strategy-1")
+	require.NoError(t, err)
+	assert.Equal(t, "30000", strategy.BuyLower.String())
+
+	// The reapplied audit table starts empty but is append-only again
+	require.NoError(t, gormrepo.NewStrategyAuditRepository(db).Append(ctx, repotest.NewTestAudit(t, "audit-2", domain.AuditUpdate, time.Now())))
+	assert.Error(t, db.Exec("DELETE FROM strategy_audits").Error)
+}
+
+func TestMigrator_DownEverything(t *testing.T) {
+	db := openEmptyDB(t)
+	ctx := context.Background()
+	migrator := newMigrator(db, migrationFiles)
+	_, err := migrator.Up(ctx)
+	require.NoError(t, err)
+
+	rolledBack, err := migrator.Down(ctx, 10)
+	require.NoError(t, err)
+	assert.Len(t, rolledBack, 6)
+	assert.Empty(t, appliedVersions(t, migrator))
+	assert.False(t, db.Migrator().HasTable("strategies"))
+
+	_, err = migrator.Down(ctx, 0)
+	assert.Error(t, err)
+}
+
+func TestMigrator_AdoptsLegacyDatabase(t *testing.T) {
+	db := openEmptyDB(t)
+	ctx := context.Background()
+
+	require.NoError(t, db.AutoMigrate(&legacyStrategy{}))
+	require.NoError(t, db.Create(&legacyStrategy{
+		ID: "strategy-1", Symbol: "eth-usdt", BuyLower: 3000, SellUpper: 4000, IsActive: true,
+	}).Error)
+
+	migrator := newMigrator(db, migrationFiles)
+	applied, err := migrator.Up(ctx)
+	require.NoError(t, err)
+	require.Len(t, applied, 1)
+	assert.Equal(t, 6, applied[0].Version)
+	assert.Equal(t, []int{1, 2, 3, 4, 5, 6}, appliedVersions(t, migrator))
+
+	strategy, err := gormrepo.NewStrategyRepository(db, Dialect).FindByID(ctx, "strategy-1")
+	require.NoError(t, err)
+	assert.Equal(t, "ETH/USDT", strategy.Symbol)
+	assert.Equal(t, 1, strategy.Version)
+	assert.True(t, db.Migrator().HasTable("strategy_audits"))
+}
@@ -0,0 +1,19 @@
+CREATE TABLE `strategies` (
+	`id` text,
+	`symbol` text,
+	`buy_lower` text,
+	`sell_upper` text,
+	`is_active` numeric,
+	`cooldown` integer,
+	`last_zone` text,
+	`last_signal_at` datetime,
+	`version` integer NOT NULL DEFAULT 1,
+	`created_at` datetime,
+	`updated_at` datetime,
+	`deleted_at` datetime,
+	PRIMARY KEY (`id`)
+);
+CREATE INDEX `idx_strategies_symbol` ON `strategies`(`symbol`);
+CREATE INDEX `idx_strategies_is_active` ON `strategies`(`is_active`);
+CREATE INDEX `idx_strategies_created_at` ON `strategies`(`created_at`);
+CREATE INDEX `idx_strategies_deleted_at` ON `strategies`(`deleted_at`);
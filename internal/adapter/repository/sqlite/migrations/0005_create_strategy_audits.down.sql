@@ -0,0 +1 @@
+DROP TABLE `strategy_audits`;
@@ -0,0 +1,18 @@
+CREATE TABLE `strategy_audits` (
+	`id` text,
+	`strategy_id` text,
+	`action` text,
+	`actor` text,
+	`before` text,
+	`after` text,
+	`created_at` datetime,
+	PRIMARY KEY (`id`)
+);
+CREATE INDEX `idx_strategy_audits_strategy_id` ON `strategy_audits`(`strategy_id`);
+CREATE INDEX `idx_strategy_audits_created_at` ON `strategy_audits`(`created_at`);
+
+-- The audit log is append-only: reject any change to an existing entry
+CREATE TRIGGER `strategy_audits_no_update` BEFORE UPDATE ON `strategy_audits`
+BEGIN SELECT RAISE(ABORT, 'strategy audit log is append-only'); END;
+CREATE TRIGGER `strategy_audits_no_delete` BEFORE DELETE ON `strategy_audits`
+BEGIN SELECT RAISE(ABORT, 'strategy audit log is append-only'); END;
@@ -0,0 +1,3 @@
+-- The original spelling of the symbols is not kept, so rolling back leaves
+-- them in the BASE/QUOTE form.
+SELECT 1;
@@ -0,0 +1,123 @@
+package sqlite
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"transaction/internal/adapter/repository"
+	"transaction/internal/adapter/repository/gormrepo"
+	"transaction/internal/adapter/repository/repotest"
+	"transaction/internal/domain"
+)
+
+// setupTestDB creates an in-memory SQLite database for testing.
+func setupTestDB(t *testing.T) *gorm.DB {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	require.NoError(t, err, "failed to open in-memory database")
+
+	// Run migrations
+	err = Migrate(db)
+	require.NoError(t, err, "failed to run migrations")
+
+	return db
+}
+
+// setupRepositories returns the repositories of a new test database.
+func setupRepositories(t *testing.T) *repotest.Repositories {
+	db := setupTestDB(t)
+	return &repotest.Repositories{
+		Strategies:    gormrepo.NewStrategyRepository(db, Dialect),
+		Signals:       gormrepo.NewSignalRepository(db),
+		Notifications: gormrepo.NewNotificationRepository(db),
+		Audits:        gormrepo.NewStrategyAuditRepository(db),
+		Transactor:    gormrepo.NewTransactor(db),
+	}
+}
+
+func TestStrategyRepository(t *testing.T) {
+	repotest.StrategyRepository(t, func(t *testing.T) repository.IStrategyRepository {
+		return gormrepo.NewStrategyRepository(setupTestDB(t), Dialect)
+	})
+}
+
+func TestSignalRepository(t *testing.T) {
+	repotest.SignalRepository(t, func(t *testing.T) repository.ISignalRepository {
+		return gormrepo.NewSignalRepository(setupTestDB(t))
+	})
+}
+
+func TestTradeRepository(t *testing.T) {
+	repotest.TradeRepository(t, func(t *testing.T) repository.ITradeRepository {
+		return gormrepo.NewTradeRepository(setupTestDB(t), Dialect)
+	})
+}
+
+func TestMarketRepository(t *testing.T) {
+	repotest.MarketRepository(t, func(t *testing.T) repository.IMarketRepository {
+		return gormrepo.NewMarketRepository(setupTestDB(t))
+	})
+}
+
+func TestNotificationRepository(t *testing.T) {
+	repotest.NotificationRepository(t, setupRepositories)
+}
+
+func TestStrategyAuditRepository(t *testing.T) {
+	repotest.StrategyAuditRepository(t, func(t *testing.T) repository.IStrategyAuditRepository {
+		return gormrepo.NewStrategyAuditRepository(setupTestDB(t))
+	})
+}
+
+func TestTransactor(t *testing.T) {
+	repotest.Transactor(t, setupRepositories)
+}
+
+func TestMigrate_CreatesStrategyIndexes(t *testing.T) {
+	db := setupTestDB(t)
+
+	for _, index := range []string{"idx_strategies_symbol", "idx_strategies_is_active", "idx_strategies_created_at"} {
+		assert.True(t, db.Migrator().HasIndex(&domain.Strategy{}, index), index)
+	}
+}
+
+func TestMigrate_AuditIsAppendOnly(t *testing.T) {
+	db := setupTestDB(t)
+	ctx := context.Background()
+	repo := gormrepo.NewStrategyAuditRepository(db)
+
+	entry := repotest.NewTestAudit(t, "audit-1", domain.AuditCreate, time.Now())
+	require.NoError(t, repo.Append(ctx, entry))
+
+	err := db.Model(&domain.StrategyAudit{}).Where("id = ?", entry.ID).Update("actor", "mallory").Error
+	assert.ErrorContains(t, err, "append-only")
+
+	err = db.Delete(&domain.StrategyAudit{}, "id = ?", entry.ID).Error
+	assert.ErrorContains(t, err, "append-only")
+
+	entries, err := repo.FindByStrategyID(ctx, "strategy-1")
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	assert.Equal(t, "alice", entries[0].Actor)
+}
+
+func TestSavePreferences_KeepsOneRow(t *testing.T) {
+	db := setupTestDB(t)
+	ctx := context.Background()
+	repo := gormrepo.NewNotificationRepository(db)
+
+	prefs := domain.DefaultNotificationPreferences()
+	_, err := repo.SavePreferences(ctx, prefs)
+	require.NoError(t, err)
+	prefs.Timezone = "Asia/Taipei"
+	_, err = repo.SavePreferences(ctx, prefs)
+	require.NoError(t, err)
+
+	var count int64
+	db.Model(&domain.NotificationPreferences{}).Count(&count)
+	assert.Equal(t, int64(1), count)
+}
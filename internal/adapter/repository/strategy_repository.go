@@ -1,24 +1,80 @@
 package repository
 
-import "transaction/internal/domain"
+import (
+	"context"
+	"time"
+
+	"transaction/internal/domain"
+)
+
+// Columns IStrategyRepository.Find can sort by.
+const (
+	StrategySortCreatedAt = "created_at"
+	StrategySortSymbol    = "symbol"
+	StrategySortBuyLower  = "buy_lower"
+	StrategySortSellUpper = "sell_upper"
+)
+
+// StrategyFilter narrows, orders and pages the strategies returned by
+// IStrategyRepository.Find. Zero-valued fields are ignored.
+type StrategyFilter struct {
+	ID             string    // Only the strategy with this ID
+	Symbol         string    // Only strategies of this symbol
+	Active         *bool     // Only active or only inactive strategies
+	CreatedAfter   time.Time // Only strategies created at or after this time
+	CreatedBefore  time.Time // Only strategies created before this time
+	SortBy         string    // One of the StrategySort columns, defaults to StrategySortCreatedAt
+	Descending     bool      // Sort in descending order
+	Limit          int       // Maximum number of strategies, zero means no limit
+	Offset         int       // Number of matching strategies to skip
+	IncludeDeleted bool      // Also return soft deleted strategies
+}
 
 // IStrategyRepository defines the interface for persisting Strategy entities.
+// Deleted strategies are kept as soft deleted rows; every method except Find
+// with IncludeDeleted, Restore and Purge behaves as if they did not exist.
 type IStrategyRepository interface {
 	// Create persists a new strategy and returns the created strategy with ID.
-	Create(strategy *domain.Strategy) (*domain.Strategy, error)
+	Create(ctx context.Context, strategy *domain.Strategy) (*domain.Strategy, error)
 
 	// FindByID retrieves a strategy by its ID.
 	// Returns ErrStrategyNotFound if the strategy does not exist.
-	FindByID(id string) (*domain.Strategy, error)
+	FindByID(ctx context.Context, id string) (*domain.Strategy, error)
 
 	// FindAll retrieves all strategies from the repository.
-	FindAll() ([]*domain.Strategy, error)
+	FindAll(ctx context.Context) ([]*domain.Strategy, error)
+
+	// FindActive retrieves the active strategies, oldest first.
+	FindActive(ctx context.Context) ([]*domain.Strategy, error)
 
-	// Update modifies an existing strategy.
+	// FindBySymbol retrieves the strategies of one symbol, oldest first.
+	FindBySymbol(ctx context.Context, symbol string) ([]*domain.Strategy, error)
+
+	// Find retrieves the strategies matching the filter in the requested order.
+	Find(ctx context.Context, filter StrategyFilter) ([]*domain.Strategy, error)
+
+	// Update overwrites the configuration of an existing strategy (symbol,
+	// bounds, cooldown and active flag) if its stored version still equals
+	// strategy.Version, and increments the version. The trigger state and
+	// creation time are left untouched.
+	// Returns ErrStrategyNotFound if the strategy does not exist and
+	// ErrConcurrentModification if it was changed since it was read.
+	Update(ctx context.Context, strategy *domain.Strategy) (*domain.Strategy, error)
+
+	// UpdateTriggerState persists only the edge-trigger state (LastZone, LastSignalAt)
+	// of a strategy so that concurrent edits to its configuration are not overwritten.
 	// Returns ErrStrategyNotFound if the strategy does not exist.
-	Update(strategy *domain.Strategy) (*domain.Strategy, error)
+	UpdateTriggerState(ctx context.Context, strategy *domain.Strategy) error
 
-	// Delete removes a strategy by its ID.
+	// Delete soft deletes a strategy by its ID.
 	// Returns ErrStrategyNotFound if the strategy does not exist.
-	Delete(id string) error
+	Delete(ctx context.Context, id string) error
+
+	// Restore brings back a soft deleted strategy and returns it.
+	// Returns ErrStrategyNotFound if there is no deleted strategy with the ID.
+	Restore(ctx context.Context, id string) (*domain.Strategy, error)
+
+	// Purge permanently removes the strategies soft deleted before the cutoff
+	// and returns how many were removed.
+	Purge(ctx context.Context, deletedBefore time.Time) (int64, error)
 }
@@ -0,0 +1,49 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"transaction/internal/domain"
+)
+
+// Columns ITradeRepository.Find can sort by.
+const (
+	TradeSortExecutedAt = "executed_at"
+	TradeSortSymbol     = "symbol"
+	TradeSortQuantity   = "quantity"
+	TradeSortPrice      = "price"
+)
+
+// TradeFilter narrows, orders and pages the trades returned by ITradeRepository.Find.
+// Zero-valued fields are ignored.
+type TradeFilter struct {
+	Symbol     string      // Only trades of this symbol
+	Side       domain.Side // Only buys or only sells
+	StrategyID string      // Only trades made for this strategy
+	From       time.Time   // Only trades executed at or after this time
+	To         time.Time   // Only trades executed before this time
+	SortBy     string      // One of the TradeSort columns, defaults to TradeSortExecutedAt
+	Descending bool        // Sort in descending order
+	Limit      int         // Maximum number of trades, zero means no limit
+	Offset     int         // Number of matching trades to skip
+}
+
+// ITradeRepository defines the interface for persisting Trade entities.
+type ITradeRepository interface {
+	// Create persists a new trade and returns the created trade.
+	Create(ctx context.Context, trade *domain.Trade) (*domain.Trade, error)
+
+	// FindByID retrieves a trade by its ID.
+	// Returns ErrTradeNotFound if the trade does not exist.
+	FindByID(ctx context.Context, id string) (*domain.Trade, error)
+
+	// FindAll retrieves all trades, oldest execution first.
+	FindAll(ctx context.Context) ([]*domain.Trade, error)
+
+	// FindBySymbol retrieves the trades of one symbol, oldest execution first.
+	FindBySymbol(ctx context.Context, symbol string) ([]*domain.Trade, error)
+
+	// Find retrieves the trades matching the filter in the requested order.
+	Find(ctx context.Context, filter TradeFilter) ([]*domain.Trade, error)
+}
@@ -0,0 +1,12 @@
+package repository
+
+import "context"
+
+// ITransactor runs a unit of work atomically across repositories.
+type ITransactor interface {
+	// WithinTransaction calls fn with a context bound to a transaction. Repository
+	// calls made with that context join the transaction, which is committed when
+	// fn returns nil and rolled back otherwise. Nested calls join the outer
+	// transaction.
+	WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error
+}
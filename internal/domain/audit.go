@@ -0,0 +1,162 @@
+package domain
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// UnknownActor is recorded when a change is made without an identified actor.
+const UnknownActor = "unknown"
+
+// AuditAction names the kind of change recorded in the audit log.
+type AuditAction string
+
+const (
+	// AuditCreate records a new strategy.
+	AuditCreate AuditAction = "CREATE"
+
+	// AuditUpdate records a change to the configuration of a strategy.
+	AuditUpdate AuditAction = "UPDATE"
+
+	// AuditDelete records the removal of a strategy.
+	AuditDelete AuditAction = "DELETE"
+
+	// AuditToggle records a strategy being activated or deactivated.
+	AuditToggle AuditAction = "TOGGLE"
+
+	// AuditRestore records a soft deleted strategy being brought back.
+	AuditRestore AuditAction = "RESTORE"
+)
+
+// StrategyAudit is an append-only record of one change to a strategy, with
+// JSON snapshots of the strategy before and after the change. Before is empty
+// for a creation and After is empty for a deletion.
+type StrategyAudit struct {
+	ID         string      `gorm:"primaryKey"`
+	StrategyID string      `gorm:"index"`
+	Action     AuditAction // CREATE, UPDATE, DELETE, TOGGLE or RESTORE
+	Actor      string      // Who made the change
+	Before     string      `gorm:"type:text"` // Strategy before the change as JSON
+	After      string      `gorm:"type:text"` // Strategy after the change as JSON
+	CreatedAt  time.Time   `gorm:"index"`
+}
+
+// NewStrategyAudit records a change from before to after. Either snapshot may
+// be nil, but not both.
+func NewStrategyAudit(id string, action AuditAction, actor string, before, after *Strategy, at time.Time) (*StrategyAudit, error) {
+	subject := after
+	if subject == nil {
+		subject = before
+	}
+	if subject == nil {
+		return nil, fmt.Errorf("audit entry needs a strategy snapshot")
+	}
+
+	beforeJSON, err := snapshot(before)
+	if err != nil {
+		return nil, err
+	}
+	afterJSON, err := snapshot(after)
+	if err != nil {
+		return nil, err
+	}
+
+	return &StrategyAudit{
+		ID:         id,
+		StrategyID: subject.ID,
+		Action:     action,
+		Actor:      actor,
+		Before:     beforeJSON,
+		After:      afterJSON,
+		CreatedAt:  at,
+	}, nil
+}
+
+// snapshot encodes a strategy as JSON, or returns an empty string for nil.
+func snapshot(strategy *Strategy) (string, error) {
+	if strategy == nil {
+		return "", nil
+	}
+	data, err := json.Marshal(strategy)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode strategy snapshot: %w", err)
+	}
+	return string(data), nil
+}
+
+// Snapshots decodes the before and after snapshots. A missing snapshot is nil.
+func (a *StrategyAudit) Snapshots() (before, after *Strategy, err error) {
+	if before, err = restore(a.Before); err != nil {
+		return nil, nil, err
+	}
+	if after, err = restore(a.After); err != nil {
+		return nil, nil, err
+	}
+	return before, after, nil
+}
+
+// restore decodes a JSON strategy snapshot, or returns nil for an empty string.
+func restore(data string) (*Strategy, error) {
+	if data == "" {
+		return nil, nil
+	}
+	strategy := &Strategy{}
+	if err := json.Unmarshal([]byte(data), strategy); err != nil {
+		return nil, fmt.Errorf("invalid strategy snapshot: %w", err)
+	}
+	return strategy, nil
+}
+
+// FieldChange describes one user-visible strategy field that differs between
+// two snapshots. From is empty for a creation and To is empty for a deletion.
+type FieldChange struct {
+	Field string
+	From  string
+	To    string
+}
+
+// DiffStrategies lists the configuration fields that differ between before and
+// after. Either may be nil, in which case every field of the other is listed.
+// Bookkeeping fields such as the trigger state and timestamps are ignored.
+func DiffStrategies(before, after *Strategy) []FieldChange {
+	fields := func(s *Strategy) []string {
+		if s == nil {
+			return make([]string, 5)
+		}
+		return []string{
+			s.Symbol,
+			s.BuyLower.String(),
+			s.SellUpper.String(),
+			s.CooldownPeriod().String(),
+			fmt.Sprintf("%t", s.IsActive),
+		}
+	}
+	names := []string{"symbol", "buy_lower", "sell_upper", "cooldown", "active"}
+
+	from, to := fields(before), fields(after)
+	changes := make([]FieldChange, 0)
+	for i, name := range names {
+		if from[i] != to[i] {
+			changes = append(changes, FieldChange{Field: name, From: from[i], To: to[i]})
+		}
+	}
+	return changes
+}
+
+// actorKey is the context key under which the acting user is stored.
+type actorKey struct{}
+
+// WithActor returns a context recording who is making changes.
+func WithActor(ctx context.Context, actor string) context.Context {
+	return context.WithValue(ctx, actorKey{}, actor)
+}
+
+// ActorFromContext returns the actor stored by WithActor, or UnknownActor.
+func ActorFromContext(ctx context.Context) string {
+	if actor, ok := ctx.Value(actorKey{}).(string); ok && actor != "" {
+		return actor
+	}
+	return UnknownActor
+}
@@ -0,0 +1,84 @@
+package domain
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func auditTestStrategy() *Strategy {
+	return &Strategy{
+		ID:        "strategy-1",
+		Symbol:    "BTC/USDT",
+		BuyLower:  decimal.RequireFromString("60000.5"),
+		SellUpper: decimal.NewFromInt(70000),
+		IsActive:  true,
+	}
+}
+
+func TestNewStrategyAudit_RoundTripsSnapshots(t *testing.T) {
+	before := auditTestStrategy()
+	after := auditTestStrategy()
+	after.BuyLower = decimal.NewFromInt(55000)
+	at := time.Date(2025, 11, 5, 10, 0, 0, 0, time.UTC)
+
+	audit, err := NewStrategyAudit("audit-1", AuditUpdate, "alice", before, after, at)
+	require.NoError(t, err)
+	assert.Equal(t, "strategy-1", audit.StrategyID)
+	assert.Equal(t, "alice", audit.Actor)
+	assert.Equal(t, at, audit.CreatedAt)
+
+	gotBefore, gotAfter, err := audit.Snapshots()
+	require.NoError(t, err)
+	assert.Equal(t, "60000.5", gotBefore.BuyLower.String())
+	assert.Equal(t, "55000", gotAfter.BuyLower.String())
+}
+
+func TestNewStrategyAudit_MissingSnapshots(t *testing.T) {
+	created, err := NewStrategyAudit("audit-1", AuditCreate, "alice", nil, auditTestStrategy(), time.Now())
+	require.NoError(t, err)
+	assert.Empty(t, created.Before)
+	assert.Equal(t, "strategy-1", created.StrategyID)
+
+	deleted, err := NewStrategyAudit("audit-2", AuditDelete, "alice", auditTestStrategy(), nil, time.Now())
+	require.NoError(t, err)
+	assert.Empty(t, deleted.After)
+	assert.Equal(t, "strategy-1", deleted.StrategyID)
+
+	before, after, err := deleted.Snapshots()
+	require.NoError(t, err)
+	assert.NotNil(t, before)
+	assert.Nil(t, after)
+
+	_, err = NewStrategyAudit("audit-3", AuditUpdate, "alice", nil, nil, time.Now())
+	assert.Error(t, err)
+}
+
+func TestDiffStrategies(t *testing.T) {
+	before := auditTestStrategy()
+	after := auditTestStrategy()
+	after.BuyLower = decimal.NewFromInt(55000)
+	after.IsActive = false
+	after.LastZone = ZoneBuy
+
+	assert.Equal(t, []FieldChange{
+		{Field: "buy_lower", From: "60000.5", To: "55000"},
+		{Field: "active", From: "true", To: "false"},
+	}, DiffStrategies(before, after))
+
+	assert.Empty(t, DiffStrategies(before, auditTestStrategy()))
+
+	created := DiffStrategies(nil, before)
+	require.Len(t, created, 5)
+	assert.Equal(t, FieldChange{Field: "symbol", From: "", To: "BTC/USDT"}, created[0])
+}
+
+func TestActorFromContext(t *testing.T) {
+	assert.Equal(t, UnknownActor, ActorFromContext(context.Background()))
+	assert.Equal(t, "alice", ActorFromContext(WithActor(context.Background(), "alice")))
+	assert.Equal(t, UnknownActor, ActorFromContext(WithActor(context.Background(), "")))
+}
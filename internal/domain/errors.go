@@ -2,14 +2,59 @@ package domain
 
 import "errors"
 
-// Domain-level errors for strategy management.
+// Domain-level errors.
 var (
 	// ErrInvalidStrategy indicates that the strategy configuration is invalid.
 	ErrInvalidStrategy = errors.New("invalid strategy")
 
+	// ErrInsufficientHoldings indicates that a sell exceeds the quantity held.
+	ErrInsufficientHoldings = errors.New("insufficient holdings")
+
 	// ErrInvalidPrice indicates that the price value is invalid.
 	ErrInvalidPrice = errors.New("invalid price")
 
 	// ErrStrategyNotFound indicates that the requested strategy does not exist.
 	ErrStrategyNotFound = errors.New("strategy not found")
+
+	// ErrConcurrentModification indicates that the strategy was changed by
+	// someone else since it was read.
+	ErrConcurrentModification = errors.New("strategy was modified concurrently")
+
+	// ErrSignalNotFound indicates that the requested signal does not exist.
+	ErrSignalNotFound = errors.New("signal not found")
+
+	// ErrTradeNotFound indicates that the requested trade does not exist.
+	ErrTradeNotFound = errors.New("trade not found")
+
+	// ErrMarketNotFound indicates that the symbol is not a registered market.
+	ErrMarketNotFound = errors.New("market not found")
+
+	// ErrMarketExists indicates that a market with the same symbol is already registered.
+	ErrMarketExists = errors.New("market already exists")
+
+	// ErrMarketDisabled indicates that the market no longer accepts new strategies.
+	ErrMarketDisabled = errors.New("market disabled")
+
+	// ErrPriceUnavailable indicates that no price could be obtained for a symbol.
+	ErrPriceUnavailable = errors.New("price unavailable")
+
+	// ErrSlowConsumer indicates that a live feed client could not keep up and
+	// was disconnected.
+	ErrSlowConsumer = errors.New("live feed client fell behind")
 )
+
+// ValidationError marks input rejected by a business rule, as opposed to a
+// failure of the system. Its message is the wrapped error's, written for the
+// user who supplied the input.
+type ValidationError struct {
+	Err error
+}
+
+// Invalid wraps err in a ValidationError.
+func Invalid(err error) error {
+	return &ValidationError{Err: err}
+}
+
+func (e *ValidationError) Error() string { return e.Err.Error() }
+
+func (e *ValidationError) Unwrap() error { return e.Err }
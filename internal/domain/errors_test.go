@@ -2,6 +2,7 @@ package domain
 
 import (
 	"errors"
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -20,6 +21,12 @@ func TestDomainErrors(t *testing.T) {
 			wantErr: true,
 			wantMsg: "invalid strategy",
 		},
+		{
+			name:    "ErrInsufficientHoldings should be defined",
+			err:     ErrInsufficientHoldings,
+			wantErr: true,
+			wantMsg: "insufficient holdings",
+		},
 		{
 			name:    "ErrInvalidPrice should be defined",
 			err:     ErrInvalidPrice,
@@ -32,6 +39,48 @@ func TestDomainErrors(t *testing.T) {
 			wantErr: true,
 			wantMsg: "strategy not found",
 		},
+		{
+			name:    "ErrConcurrentModification should be defined",
+			err:     ErrConcurrentModification,
+			wantErr: true,
+			wantMsg: "strategy was modified concurrently",
+		},
+		{
+			name:    "ErrSignalNotFound should be defined",
+			err:     ErrSignalNotFound,
+			wantErr: true,
+			wantMsg: "signal not found",
+		},
+		{
+			name:    "ErrTradeNotFound should be defined",
+			err:     ErrTradeNotFound,
+			wantErr: true,
+			wantMsg: "trade not found",
+		},
+		{
+			name:    "ErrMarketNotFound should be defined",
+			err:     ErrMarketNotFound,
+			wantErr: true,
+			wantMsg: "market not found",
+		},
+		{
+			name:    "ErrMarketExists should be defined",
+			err:     ErrMarketExists,
+			wantErr: true,
+			wantMsg: "market already exists",
+		},
+		{
+			name:    "ErrMarketDisabled should be defined",
+			err:     ErrMarketDisabled,
+			wantErr: true,
+			wantMsg: "market disabled",
+		},
+		{
+			name:    "ErrPriceUnavailable should be defined",
+			err:     ErrPriceUnavailable,
+			wantErr: true,
+			wantMsg: "price unavailable",
+		},
 	}
 
 	for _, tt := range tests {
@@ -50,3 +99,14 @@ func TestErrorsAsType(t *testing.T) {
 		assert.False(t, errors.Is(ErrInvalidStrategy, ErrInvalidPrice))
 	})
 }
+
+func TestValidationError(t *testing.T) {
+	err := Invalid(fmt.Errorf("%w: %q is not a registered trading pair", ErrMarketNotFound, "BTC/USD"))
+
+	var validationErr *ValidationError
+	assert.True(t, errors.As(err, &validationErr))
+	assert.True(t, errors.Is(err, ErrMarketNotFound))
+	assert.Equal(t, `market not found: "BTC/USD" is not a registered trading pair`, err.Error())
+
+	assert.False(t, errors.As(ErrStrategyNotFound, &validationErr))
+}
@@ -0,0 +1,140 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// DefaultQuoteAsset is the quote asset assumed when a symbol names only its base asset.
+const DefaultQuoteAsset = "USDT"
+
+// Market is a trading pair strategies may be created for, with the trading
+// rules of the exchange it is quoted on.
+type Market struct {
+	Symbol      string          `gorm:"primaryKey"` // Trading pair, e.g. BTC/USDT
+	BaseAsset   string          // Asset being bought or sold, e.g. BTC
+	QuoteAsset  string          // Asset prices are quoted in, e.g. USDT
+	TickSize    decimal.Decimal `gorm:"type:text"` // Smallest price increment
+	LotSize     decimal.Decimal `gorm:"type:text"` // Smallest quantity increment
+	MinNotional decimal.Decimal `gorm:"type:text"` // Smallest order value in the quote asset
+	Enabled     bool            // Whether new strategies may use the market
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// NormalizeSymbol converts user input into a canonical trading pair.
+// "btc-usdt", "BTC_USDT" and "BTC/USDT" become "BTC/USDT"; a bare base asset
+// such as "BTC" is quoted in DefaultQuoteAsset.
+func NormalizeSymbol(symbol string) string {
+	symbol = strings.ToUpper(strings.TrimSpace(symbol))
+	symbol = strings.NewReplacer("-", "/", "_", "/").Replace(symbol)
+	if symbol != "" && !strings.Contains(symbol, "/") {
+		symbol += "/" + DefaultQuoteAsset
+	}
+	return symbol
+}
+
+// ParseSymbol normalizes symbol like NormalizeSymbol and checks that the
+// result is a trading pair BASE/QUOTE whose assets are letters and digits.
+func ParseSymbol(symbol string) (string, error) {
+	normalized := NormalizeSymbol(symbol)
+	base, quote, ok := strings.Cut(normalized, "/")
+	if !ok || !isAsset(base) || !isAsset(quote) {
+		return "", fmt.Errorf("invalid symbol %q: expected a trading pair such as BTC/USDT", strings.TrimSpace(symbol))
+	}
+	return normalized, nil
+}
+
+// isAsset reports whether s is a non-empty run of letters and digits.
+func isAsset(s string) bool {
+	if s == "" {
+		return false
+	}
+	for _, r := range s {
+		if (r < 'A' || r > 'Z') && (r < '0' || r > '9') {
+			return false
+		}
+	}
+	return true
+}
+
+// NewMarket creates a market for the pair base/quote.
+func NewMarket(base, quote string, tickSize, lotSize, minNotional decimal.Decimal) *Market {
+	base = strings.ToUpper(strings.TrimSpace(base))
+	quote = strings.ToUpper(strings.TrimSpace(quote))
+	return &Market{
+		Symbol:      base + "/" + quote,
+		BaseAsset:   base,
+		QuoteAsset:  quote,
+		TickSize:    tickSize,
+		LotSize:     lotSize,
+		MinNotional: minNotional,
+		Enabled:     true,
+	}
+}
+
+// Validate checks if the market has a usable configuration.
+func (m *Market) Validate() error {
+	if m.BaseAsset == "" || m.QuoteAsset == "" {
+		return errors.New("base and quote asset are required")
+	}
+	if m.Symbol != m.BaseAsset+"/"+m.QuoteAsset {
+		return fmt.Errorf("symbol %q does not match %s/%s", m.Symbol, m.BaseAsset, m.QuoteAsset)
+	}
+	if !m.TickSize.IsPositive() {
+		return errors.New("tick size must be positive")
+	}
+	if !m.LotSize.IsPositive() {
+		return errors.New("lot size must be positive")
+	}
+	if m.MinNotional.IsNegative() {
+		return errors.New("min notional must not be negative")
+	}
+	if err := DefaultPrecision.CheckPrice("tick size", m.TickSize); err != nil {
+		return err
+	}
+	return DefaultPrecision.CheckQuantity("lot size", m.LotSize)
+}
+
+// Precision returns the decimal places of the market, those of its tick size
+// for prices and of its lot size for quantities.
+func (m *Market) Precision() Precision {
+	return Precision{Price: placesOf(m.TickSize), Quantity: placesOf(m.LotSize)}
+}
+
+// CheckPrice returns an error if price has more decimal places than the
+// market precision or is not a whole number of ticks.
+func (m *Market) CheckPrice(field string, price decimal.Decimal) error {
+	if err := m.Precision().CheckPrice(field, price); err != nil {
+		return fmt.Errorf("%w on %s", err, m.Symbol)
+	}
+	if !price.Mod(m.TickSize).IsZero() {
+		return fmt.Errorf("%s %s is not a multiple of the %s tick size %s", field, price, m.Symbol, m.TickSize)
+	}
+	return nil
+}
+
+// CheckQuantity returns an error if quantity has more decimal places than the
+// market precision or is not a whole number of lots.
+func (m *Market) CheckQuantity(field string, quantity decimal.Decimal) error {
+	if err := m.Precision().CheckQuantity(field, quantity); err != nil {
+		return fmt.Errorf("%w on %s", err, m.Symbol)
+	}
+	if !quantity.Mod(m.LotSize).IsZero() {
+		return fmt.Errorf("%s %s is not a multiple of the %s lot size %s", field, quantity, m.Symbol, m.LotSize)
+	}
+	return nil
+}
+
+// CheckNotional returns an error if an order of quantity at price is worth
+// less than the market minimum notional.
+func (m *Market) CheckNotional(quantity, price decimal.Decimal) error {
+	if notional := quantity.Mul(price); notional.LessThan(m.MinNotional) {
+		return fmt.Errorf("order value %s is below the %s minimum notional %s %s", notional, m.Symbol, m.MinNotional, m.QuoteAsset)
+	}
+	return nil
+}
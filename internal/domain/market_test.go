@@ -0,0 +1,136 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizeSymbol(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{input: "BTC/USDT", want: "BTC/USDT"},
+		{input: " btc-usdt ", want: "BTC/USDT"},
+		{input: "eth_btc", want: "ETH/BTC"},
+		{input: "SOL", want: "SOL/USDT"},
+		{input: "", want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			assert.Equal(t, tt.want, NormalizeSymbol(tt.input))
+		})
+	}
+}
+
+func TestParseSymbol(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    string
+		wantErr bool
+	}{
+		{input: "btc-usdt", want: "BTC/USDT"},
+		{input: "SOL", want: "SOL/USDT"},
+		{input: "1000sats/usdt", want: "1000SATS/USDT"},
+		{input: "", wantErr: true},
+		{input: "BTC/", wantErr: true},
+		{input: "/USDT", wantErr: true},
+		{input: "BTC/USDT/ETH", wantErr: true},
+		{input: "BTC USDT", wantErr: true},
+		{input: "*", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := ParseSymbol(tt.input)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestMarketValidate(t *testing.T) {
+	valid := func() *Market {
+		return NewMarket("btc", "usdt", decimal.RequireFromString("0.01"), decimal.RequireFromString("0.00001"), decimal.NewFromInt(5))
+	}
+
+	tests := []struct {
+		name    string
+		modify  func(m *Market)
+		wantErr bool
+	}{
+		{name: "valid market", modify: func(m *Market) {}},
+		{name: "missing quote asset", modify: func(m *Market) { m.QuoteAsset = "" }, wantErr: true},
+		{name: "symbol does not match assets", modify: func(m *Market) { m.Symbol = "BTC/USD" }, wantErr: true},
+		{name: "zero tick size", modify: func(m *Market) { m.TickSize = decimal.Zero }, wantErr: true},
+		{name: "negative lot size", modify: func(m *Market) { m.LotSize = decimal.NewFromInt(-1) }, wantErr: true},
+		{name: "negative min notional", modify: func(m *Market) { m.MinNotional = decimal.NewFromInt(-1) }, wantErr: true},
+		{name: "tick size finer than the default precision", modify: func(m *Market) { m.TickSize = decimal.RequireFromString("0.000000001") }, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			market := valid()
+			tt.modify(market)
+
+			err := market.Validate()
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestMarketPrecision(t *testing.T) {
+	market := NewMarket("DOGE", "USDT", decimal.RequireFromString("0.00001"), decimal.RequireFromString("1.0"), decimal.NewFromInt(1))
+	assert.Equal(t, Precision{Price: 5, Quantity: 0}, market.Precision())
+
+	market = NewMarket("BTC", "USDT", decimal.RequireFromString("0.50"), decimal.RequireFromString("0.00001"), decimal.NewFromInt(5))
+	assert.Equal(t, Precision{Price: 1, Quantity: 5}, market.Precision())
+}
+
+func TestMarketCheckPrice(t *testing.T) {
+	market := NewMarket("DOGE", "USDT", decimal.RequireFromString("0.00001"), decimal.NewFromInt(1), decimal.NewFromInt(1))
+
+	assert.Equal(t, "DOGE/USDT", market.Symbol)
+	assert.True(t, market.Enabled)
+	assert.NoError(t, market.CheckPrice("price", decimal.RequireFromString("0.12345")))
+	assert.EqualError(t, market.CheckPrice("price", decimal.RequireFromString("0.123456")),
+		"price 0.123456 must have at most 5 decimal places on DOGE/USDT")
+
+	market = NewMarket("BTC", "USDT", decimal.RequireFromString("0.5"), decimal.RequireFromString("0.00001"), decimal.NewFromInt(5))
+	assert.NoError(t, market.CheckPrice("price", decimal.RequireFromString("60000.5")))
+	assert.EqualError(t, market.CheckPrice("price", decimal.RequireFromString("60000.2")),
+		"price 60000.2 is not a multiple of the BTC/USDT tick size 0.5")
+}
+
+func TestMarketCheckQuantity(t *testing.T) {
+	market := NewMarket("BTC", "USDT", decimal.RequireFromString("0.01"), decimal.RequireFromString("0.00001"), decimal.NewFromInt(5))
+
+	assert.NoError(t, market.CheckQuantity("quantity", decimal.RequireFromString("0.12345")))
+	assert.NoError(t, market.CheckQuantity("quantity", decimal.NewFromInt(3)))
+	assert.EqualError(t, market.CheckQuantity("quantity", decimal.RequireFromString("0.123456")),
+		"quantity 0.123456 must have at most 5 decimal places on BTC/USDT")
+
+	market = NewMarket("ETH", "USDT", decimal.RequireFromString("0.01"), decimal.RequireFromString("0.25"), decimal.NewFromInt(5))
+	assert.EqualError(t, market.CheckQuantity("quantity", decimal.RequireFromString("1.3")),
+		"quantity 1.3 is not a multiple of the ETH/USDT lot size 0.25")
+}
+
+func TestMarketCheckNotional(t *testing.T) {
+	market := NewMarket("BTC", "USDT", decimal.RequireFromString("0.01"), decimal.RequireFromString("0.00001"), decimal.NewFromInt(5))
+
+	assert.NoError(t, market.CheckNotional(decimal.RequireFromString("0.0001"), decimal.NewFromInt(50000)))
+	assert.NoError(t, market.CheckNotional(decimal.RequireFromString("0.001"), decimal.NewFromInt(60000)))
+	assert.EqualError(t, market.CheckNotional(decimal.RequireFromString("0.00001"), decimal.NewFromInt(60000)),
+		"order value 0.6 is below the BTC/USDT minimum notional 5 USDT")
+}
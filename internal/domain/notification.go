@@ -0,0 +1,280 @@
+package domain
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// DefaultPreferencesID is the key of the single user-level preferences row.
+const DefaultPreferencesID = "default"
+
+// Severity ranks how urgent a signal is.
+type Severity string
+
+const (
+	// SeverityInfo is a signal that barely crossed its bound.
+	SeverityInfo Severity = "info"
+
+	// SeverityWarning is a signal that crossed its bound by at least WarningDeviation.
+	SeverityWarning Severity = "warning"
+
+	// SeverityCritical is a signal that crossed its bound by at least CriticalDeviation.
+	SeverityCritical Severity = "critical"
+)
+
+// Relative distances between the observed price and the trigger price
+// at which a signal is escalated.
+var (
+	WarningDeviation  = decimal.RequireFromString("0.02")
+	CriticalDeviation = decimal.RequireFromString("0.05")
+)
+
+// ParseSeverity converts a severity name into a Severity.
+func ParseSeverity(value string) (Severity, error) {
+	switch Severity(strings.ToLower(value)) {
+	case SeverityInfo:
+		return SeverityInfo, nil
+	case SeverityWarning:
+		return SeverityWarning, nil
+	case SeverityCritical:
+		return SeverityCritical, nil
+	default:
+		return "", fmt.Errorf("unknown severity %q: use info, warning or critical", value)
+	}
+}
+
+// rank orders severities from least to most urgent.
+func (s Severity) rank() int {
+	switch s {
+	case SeverityWarning:
+		return 1
+	case SeverityCritical:
+		return 2
+	default:
+		return 0
+	}
+}
+
+// AtLeast reports whether s is as urgent as other or more.
+func (s Severity) AtLeast(other Severity) bool {
+	return s.rank() >= other.rank()
+}
+
+// MuteWindow is a daily time range, in minutes after midnight, during which
+// notifications are held back. A window whose end is before its start wraps
+// past midnight (e.g. 22:00-07:00).
+type MuteWindow struct {
+	Start int
+	End   int
+}
+
+// ParseMuteWindow parses a window written as HH:MM-HH:MM.
+func ParseMuteWindow(value string) (MuteWindow, error) {
+	parts := strings.Split(strings.TrimSpace(value), "-")
+	if len(parts) != 2 {
+		return MuteWindow{}, fmt.Errorf("invalid mute window %q: use HH:MM-HH:MM", value)
+	}
+	start, err := parseClock(parts[0])
+	if err != nil {
+		return MuteWindow{}, fmt.Errorf("invalid mute window %q: %w", value, err)
+	}
+	end, err := parseClock(parts[1])
+	if err != nil {
+		return MuteWindow{}, fmt.Errorf("invalid mute window %q: %w", value, err)
+	}
+	if start == end {
+		return MuteWindow{}, fmt.Errorf("invalid mute window %q: start and end must differ", value)
+	}
+	return MuteWindow{Start: start, End: end}, nil
+}
+
+// parseClock parses HH:MM into minutes after midnight.
+func parseClock(value string) (int, error) {
+	t, err := time.Parse("15:04", strings.TrimSpace(value))
+	if err != nil {
+		return 0, fmt.Errorf("%q is not a HH:MM time", value)
+	}
+	return t.Hour()*60 + t.Minute(), nil
+}
+
+// Contains reports whether the wall clock time of t falls inside the window.
+// The start is inclusive and the end exclusive.
+func (w MuteWindow) Contains(t time.Time) bool {
+	minute := t.Hour()*60 + t.Minute()
+	if w.Start < w.End {
+		return minute >= w.Start && minute < w.End
+	}
+	return minute >= w.Start || minute < w.End
+}
+
+// String formats the window as HH:MM-HH:MM.
+func (w MuteWindow) String() string {
+	return fmt.Sprintf("%02d:%02d-%02d:%02d", w.Start/60, w.Start%60, w.End/60, w.End%60)
+}
+
+// MuteWindows is a list of mute windows stored as comma separated text.
+type MuteWindows []MuteWindow
+
+// Value implements driver.Valuer.
+func (ws MuteWindows) Value() (driver.Value, error) {
+	parts := make([]string, len(ws))
+	for i, w := range ws {
+		parts[i] = w.String()
+	}
+	return strings.Join(parts, ","), nil
+}
+
+// Scan implements sql.Scanner.
+func (ws *MuteWindows) Scan(src interface{}) error {
+	text, err := scanText(src)
+	if err != nil {
+		return err
+	}
+	*ws = nil
+	for _, part := range splitList(text) {
+		w, err := ParseMuteWindow(part)
+		if err != nil {
+			return err
+		}
+		*ws = append(*ws, w)
+	}
+	return nil
+}
+
+// StringList is a list of strings stored as comma separated text.
+type StringList []string
+
+// Value implements driver.Valuer.
+func (l StringList) Value() (driver.Value, error) {
+	return strings.Join(l, ","), nil
+}
+
+// Scan implements sql.Scanner.
+func (l *StringList) Scan(src interface{}) error {
+	text, err := scanText(src)
+	if err != nil {
+		return err
+	}
+	*l = splitList(text)
+	return nil
+}
+
+// scanText reads a TEXT column value.
+func scanText(src interface{}) (string, error) {
+	switch v := src.(type) {
+	case nil:
+		return "", nil
+	case string:
+		return v, nil
+	case []byte:
+		return string(v), nil
+	default:
+		return "", fmt.Errorf("unsupported type %T for text column", src)
+	}
+}
+
+// splitList splits comma separated text, dropping empty entries.
+func splitList(text string) []string {
+	var items []string
+	for _, item := range strings.Split(text, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+// NotificationPreferences holds the user-level rules deciding which signals are
+// delivered and when.
+type NotificationPreferences struct {
+	ID              string      `gorm:"primaryKey"`
+	Timezone        string      // IANA zone the mute windows are expressed in
+	QuietHours      MuteWindows `gorm:"type:text"` // Windows during which notifications are queued
+	MutedStrategies StringList  `gorm:"type:text"` // Strategies whose signals are never notified
+	MinSeverity     Severity    // Signals below this severity are not notified
+	UpdatedAt       time.Time
+}
+
+// DefaultNotificationPreferences returns the preferences used before the user
+// configures any: no quiet hours, nothing muted, every severity delivered.
+func DefaultNotificationPreferences() *NotificationPreferences {
+	return &NotificationPreferences{
+		ID:          DefaultPreferencesID,
+		Timezone:    "UTC",
+		MinSeverity: SeverityInfo,
+	}
+}
+
+// Validate checks if the preferences are usable.
+func (p *NotificationPreferences) Validate() error {
+	if _, err := time.LoadLocation(p.Timezone); err != nil {
+		return fmt.Errorf("unknown timezone %q", p.Timezone)
+	}
+	if _, err := ParseSeverity(string(p.MinSeverity)); err != nil {
+		return err
+	}
+	return nil
+}
+
+// InQuietHours reports whether t falls inside any of the mute windows,
+// evaluated in the preferences timezone.
+func (p *NotificationPreferences) InQuietHours(t time.Time) (bool, error) {
+	if len(p.QuietHours) == 0 {
+		return false, nil
+	}
+	loc, err := time.LoadLocation(p.Timezone)
+	if err != nil {
+		return false, fmt.Errorf("unknown timezone %q", p.Timezone)
+	}
+	local := t.In(loc)
+	for _, w := range p.QuietHours {
+		if w.Contains(local) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// IsMuted reports whether the strategy has been muted.
+func (p *NotificationPreferences) IsMuted(strategyID string) bool {
+	for _, id := range p.MutedStrategies {
+		if id == strategyID {
+			return true
+		}
+	}
+	return false
+}
+
+// Mute adds the strategy to the muted list. Muting twice has no effect.
+func (p *NotificationPreferences) Mute(strategyID string) {
+	if !p.IsMuted(strategyID) {
+		p.MutedStrategies = append(p.MutedStrategies, strategyID)
+	}
+}
+
+// Unmute removes the strategy from the muted list.
+func (p *NotificationPreferences) Unmute(strategyID string) {
+	kept := p.MutedStrategies[:0]
+	for _, id := range p.MutedStrategies {
+		if id != strategyID {
+			kept = append(kept, id)
+		}
+	}
+	p.MutedStrategies = kept
+}
+
+// Allows reports whether a signal of the given severity passes the minimum severity.
+func (p *NotificationPreferences) Allows(severity Severity) bool {
+	return severity.AtLeast(p.MinSeverity)
+}
+
+// QueuedNotification marks a signal held back during quiet hours until the
+// next digest is delivered.
+type QueuedNotification struct {
+	SignalID string `gorm:"primaryKey"`
+	QueuedAt time.Time
+}
@@ -0,0 +1,212 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseSeverity(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   string
+		want    Severity
+		wantErr bool
+	}{
+		{name: "info", value: "info", want: SeverityInfo},
+		{name: "warning in upper case", value: "WARNING", want: SeverityWarning},
+		{name: "critical", value: "critical", want: SeverityCritical},
+		{name: "unknown", value: "urgent", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseSeverity(tt.value)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestSignalSeverity(t *testing.T) {
+	tests := []struct {
+		name          string
+		triggerPrice  int64
+		observedPrice int64
+		want          Severity
+	}{
+		{name: "small move is info", triggerPrice: 60000, observedPrice: 59900, want: SeverityInfo},
+		{name: "two percent is warning", triggerPrice: 60000, observedPrice: 58800, want: SeverityWarning},
+		{name: "five percent above is critical", triggerPrice: 70000, observedPrice: 73500, want: SeverityCritical},
+		{name: "missing trigger price is info", triggerPrice: 0, observedPrice: 100, want: SeverityInfo},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			signal := &Signal{
+				TriggerPrice:  decimal.NewFromInt(tt.triggerPrice),
+				ObservedPrice: decimal.NewFromInt(tt.observedPrice),
+			}
+			assert.Equal(t, tt.want, signal.Severity())
+		})
+	}
+}
+
+func TestParseMuteWindow(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   string
+		want    MuteWindow
+		wantErr bool
+	}{
+		{name: "same day window", value: "12:00-13:30", want: MuteWindow{Start: 720, End: 810}},
+		{name: "overnight window", value: "22:00-07:00", want: MuteWindow{Start: 1320, End: 420}},
+		{name: "missing end", value: "22:00", wantErr: true},
+		{name: "invalid clock", value: "25:00-07:00", wantErr: true},
+		{name: "empty window", value: "08:00-08:00", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseMuteWindow(tt.value)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.value, got.String())
+		})
+	}
+}
+
+func TestMuteWindowContains(t *testing.T) {
+	day := func(hour, minute int) time.Time {
+		return time.Date(2025, 11, 5, hour, minute, 0, 0, time.UTC)
+	}
+	overnight := MuteWindow{Start: 22 * 60, End: 7 * 60}
+	lunch := MuteWindow{Start: 12 * 60, End: 13 * 60}
+
+	tests := []struct {
+		name   string
+		window MuteWindow
+		at     time.Time
+		want   bool
+	}{
+		{name: "overnight before midnight", window: overnight, at: day(23, 0), want: true},
+		{name: "overnight after midnight", window: overnight, at: day(6, 59), want: true},
+		{name: "overnight end is exclusive", window: overnight, at: day(7, 0), want: false},
+		{name: "overnight during the day", window: overnight, at: day(15, 0), want: false},
+		{name: "same day start is inclusive", window: lunch, at: day(12, 0), want: true},
+		{name: "same day outside", window: lunch, at: day(13, 1), want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.window.Contains(tt.at))
+		})
+	}
+}
+
+func TestMuteWindowsScanValue(t *testing.T) {
+	windows := MuteWindows{{Start: 1320, End: 420}, {Start: 720, End: 780}}
+
+	value, err := windows.Value()
+	require.NoError(t, err)
+	assert.Equal(t, "22:00-07:00,12:00-13:00", value)
+
+	var scanned MuteWindows
+	require.NoError(t, scanned.Scan([]byte("22:00-07:00,12:00-13:00")))
+	assert.Equal(t, windows, scanned)
+
+	require.NoError(t, scanned.Scan(""))
+	assert.Empty(t, scanned)
+}
+
+func TestStringListScanValue(t *testing.T) {
+	list := StringList{"strategy-1", "strategy-2"}
+
+	value, err := list.Value()
+	require.NoError(t, err)
+	assert.Equal(t, "strategy-1,strategy-2", value)
+
+	var scanned StringList
+	require.NoError(t, scanned.Scan("strategy-1,strategy-2"))
+	assert.Equal(t, list, scanned)
+
+	assert.Error(t, scanned.Scan(42))
+}
+
+func TestNotificationPreferencesValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		prefs   *NotificationPreferences
+		wantErr bool
+	}{
+		{name: "defaults are valid", prefs: DefaultNotificationPreferences()},
+		{name: "named timezone", prefs: &NotificationPreferences{Timezone: "Asia/Taipei", MinSeverity: SeverityWarning}},
+		{name: "unknown timezone", prefs: &NotificationPreferences{Timezone: "Mars/Olympus", MinSeverity: SeverityInfo}, wantErr: true},
+		{name: "unknown severity", prefs: &NotificationPreferences{Timezone: "UTC", MinSeverity: "urgent"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.prefs.Validate()
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestNotificationPreferencesInQuietHours(t *testing.T) {
+	prefs := &NotificationPreferences{
+		Timezone:   "Asia/Taipei",
+		QuietHours: MuteWindows{{Start: 22 * 60, End: 7 * 60}},
+	}
+
+	// 15:00 UTC is 23:00 in Taipei.
+	quiet, err := prefs.InQuietHours(time.Date(2025, 11, 5, 15, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+	assert.True(t, quiet)
+
+	// 03:00 UTC is 11:00 in Taipei.
+	quiet, err = prefs.InQuietHours(time.Date(2025, 11, 5, 3, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+	assert.False(t, quiet)
+
+	prefs.Timezone = "Mars/Olympus"
+	_, err = prefs.InQuietHours(time.Now())
+	assert.Error(t, err)
+}
+
+func TestNotificationPreferencesMute(t *testing.T) {
+	prefs := DefaultNotificationPreferences()
+
+	prefs.Mute("strategy-1")
+	prefs.Mute("strategy-1")
+	prefs.Mute("strategy-2")
+	assert.Equal(t, StringList{"strategy-1", "strategy-2"}, prefs.MutedStrategies)
+	assert.True(t, prefs.IsMuted("strategy-1"))
+
+	prefs.Unmute("strategy-1")
+	assert.False(t, prefs.IsMuted("strategy-1"))
+	assert.True(t, prefs.IsMuted("strategy-2"))
+}
+
+func TestNotificationPreferencesAllows(t *testing.T) {
+	prefs := &NotificationPreferences{MinSeverity: SeverityWarning}
+
+	assert.False(t, prefs.Allows(SeverityInfo))
+	assert.True(t, prefs.Allows(SeverityWarning))
+	assert.True(t, prefs.Allows(SeverityCritical))
+}
@@ -0,0 +1,99 @@
+package domain
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/shopspring/decimal"
+)
+
+// Position is the holding of one symbol derived from its trades using the
+// weighted average cost method. Fees are added to the cost of buys and
+// deducted from the proceeds of sells.
+type Position struct {
+	Symbol      string
+	Quantity    decimal.Decimal // Amount currently held
+	AverageCost decimal.Decimal // Weighted average cost per unit of the amount held
+	RealizedPnL decimal.Decimal // Profit or loss locked in by sells
+}
+
+// NewPosition creates an empty position for a symbol.
+func NewPosition(symbol string) *Position {
+	return &Position{Symbol: symbol}
+}
+
+// Apply updates the position with a trade of the same symbol.
+// Returns ErrInsufficientHoldings if a sell exceeds the quantity held.
+func (p *Position) Apply(trade *Trade) error {
+	switch trade.Side {
+	case SideBuy:
+		cost := p.CostBasis().Add(trade.Notional()).Add(trade.Fee)
+		p.Quantity = p.Quantity.Add(trade.Quantity)
+		p.AverageCost = cost.Div(p.Quantity)
+	case SideSell:
+		if trade.Quantity.GreaterThan(p.Quantity) {
+			return fmt.Errorf("%w: selling %s %s but only %s held",
+				ErrInsufficientHoldings, trade.Quantity, p.Symbol, p.Quantity)
+		}
+		p.RealizedPnL = p.RealizedPnL.Add(trade.Quantity.Mul(trade.Price.Sub(p.AverageCost))).Sub(trade.Fee)
+		p.Quantity = p.Quantity.Sub(trade.Quantity)
+		if p.Quantity.IsZero() {
+			p.AverageCost = decimal.Zero
+		}
+	default:
+		return fmt.Errorf("unknown trade side %q", trade.Side)
+	}
+	return nil
+}
+
+// CostBasis returns the total cost of the quantity held.
+func (p *Position) CostBasis() decimal.Decimal {
+	return p.Quantity.Mul(p.AverageCost)
+}
+
+// MarketValue returns the value of the quantity held at the given price.
+func (p *Position) MarketValue(price decimal.Decimal) decimal.Decimal {
+	return p.Quantity.Mul(price)
+}
+
+// UnrealizedPnL returns the profit or loss of the quantity held at the given price.
+func (p *Position) UnrealizedPnL(price decimal.Decimal) decimal.Decimal {
+	return p.Quantity.Mul(price.Sub(p.AverageCost))
+}
+
+// IsOpen reports whether any quantity is still held.
+func (p *Position) IsOpen() bool {
+	return p.Quantity.IsPositive()
+}
+
+// BuildPositions replays trades in execution order and returns one position per
+// symbol, sorted by symbol. Returns ErrInsufficientHoldings if at any point a
+// sell exceeds the quantity held.
+func BuildPositions(trades []*Trade) ([]*Position, error) {
+	ordered := make([]*Trade, len(trades))
+	copy(ordered, trades)
+	sort.SliceStable(ordered, func(i, j int) bool {
+		return ordered[i].ExecutedAt.Before(ordered[j].ExecutedAt)
+	})
+
+	bySymbol := make(map[string]*Position)
+	for _, trade := range ordered {
+		position, ok := bySymbol[trade.Symbol]
+		if !ok {
+			position = NewPosition(trade.Symbol)
+			bySymbol[trade.Symbol] = position
+		}
+		if err := position.Apply(trade); err != nil {
+			return nil, err
+		}
+	}
+
+	positions := make([]*Position, 0, len(bySymbol))
+	for _, position := range bySymbol {
+		positions = append(positions, position)
+	}
+	sort.Slice(positions, func(i, j int) bool {
+		return positions[i].Symbol < positions[j].Symbol
+	})
+	return positions, nil
+}
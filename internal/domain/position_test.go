@@ -0,0 +1,137 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testTrade(symbol string, side Side, quantity, price, fee string, executedAt time.Time) *Trade {
+	return &Trade{
+		Symbol:     symbol,
+		Side:       side,
+		Quantity:   decimal.RequireFromString(quantity),
+		Price:      decimal.RequireFromString(price),
+		Fee:        decimal.RequireFromString(fee),
+		ExecutedAt: executedAt,
+	}
+}
+
+func TestPositionApply(t *testing.T) {
+	base := time.Date(2025, 11, 5, 10, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name            string
+		trades          []*Trade
+		wantQuantity    string
+		wantAverageCost string
+		wantRealizedPnL string
+		wantErr         error
+	}{
+		{
+			name: "buys average their cost including fees",
+			trades: []*Trade{
+				testTrade("BTC", SideBuy, "1", "60000", "10", base),
+				testTrade("BTC", SideBuy, "1", "50000", "10", base),
+			},
+			wantQuantity:    "2",
+			wantAverageCost: "55010",
+			wantRealizedPnL: "0",
+		},
+		{
+			name: "sell realizes profit against average cost",
+			trades: []*Trade{
+				testTrade("BTC", SideBuy, "2", "50000", "0", base),
+				testTrade("BTC", SideSell, "1", "60000", "20", base),
+			},
+			wantQuantity:    "1",
+			wantAverageCost: "50000",
+			wantRealizedPnL: "9980",
+		},
+		{
+			name: "selling everything closes the position",
+			trades: []*Trade{
+				testTrade("BTC", SideBuy, "0.3", "50000", "0", base),
+				testTrade("BTC", SideSell, "0.1", "40000", "0", base),
+				testTrade("BTC", SideSell, "0.2", "40000", "0", base),
+			},
+			wantQuantity:    "0",
+			wantAverageCost: "0",
+			wantRealizedPnL: "-3000",
+		},
+		{
+			name: "selling more than held is rejected",
+			trades: []*Trade{
+				testTrade("BTC", SideBuy, "1", "50000", "0", base),
+				testTrade("BTC", SideSell, "1.5", "60000", "0", base),
+			},
+			wantErr: ErrInsufficientHoldings,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			position := NewPosition("BTC")
+
+			var err error
+			for _, trade := range tt.trades {
+				if err = position.Apply(trade); err != nil {
+					break
+				}
+			}
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantQuantity, position.Quantity.String())
+			assert.Equal(t, tt.wantAverageCost, position.AverageCost.String())
+			assert.Equal(t, tt.wantRealizedPnL, position.RealizedPnL.String())
+		})
+	}
+}
+
+func TestPositionValuation(t *testing.T) {
+	position := &Position{Symbol: "BTC", Quantity: decimal.NewFromInt(2), AverageCost: decimal.NewFromInt(50000)}
+
+	assert.Equal(t, "100000", position.CostBasis().String())
+	assert.Equal(t, "120000", position.MarketValue(decimal.NewFromInt(60000)).String())
+	assert.Equal(t, "20000", position.UnrealizedPnL(decimal.NewFromInt(60000)).String())
+	assert.True(t, position.IsOpen())
+	assert.False(t, NewPosition("ETH").IsOpen())
+}
+
+func TestBuildPositions(t *testing.T) {
+	base := time.Date(2025, 11, 5, 10, 0, 0, 0, time.UTC)
+
+	t.Run("groups by symbol and replays in execution order", func(t *testing.T) {
+		trades := []*Trade{
+			testTrade("BTC", SideSell, "1", "70000", "0", base.Add(time.Hour)),
+			testTrade("ETH", SideBuy, "10", "3000", "0", base),
+			testTrade("BTC", SideBuy, "2", "60000", "0", base),
+		}
+
+		positions, err := BuildPositions(trades)
+		require.NoError(t, err)
+		require.Len(t, positions, 2)
+		assert.Equal(t, "BTC", positions[0].Symbol)
+		assert.Equal(t, "1", positions[0].Quantity.String())
+		assert.Equal(t, "10000", positions[0].RealizedPnL.String())
+		assert.Equal(t, "ETH", positions[1].Symbol)
+		assert.Equal(t, "10", positions[1].Quantity.String())
+	})
+
+	t.Run("sell before the matching buy is rejected", func(t *testing.T) {
+		trades := []*Trade{
+			testTrade("BTC", SideBuy, "1", "60000", "0", base.Add(time.Hour)),
+			testTrade("BTC", SideSell, "1", "70000", "0", base),
+		}
+
+		_, err := BuildPositions(trades)
+		assert.ErrorIs(t, err, ErrInsufficientHoldings)
+	})
+}
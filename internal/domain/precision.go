@@ -0,0 +1,46 @@
+package domain
+
+import (
+	"fmt"
+
+	"github.com/shopspring/decimal"
+)
+
+// Precision is the number of decimal places a symbol is quoted and traded in.
+type Precision struct {
+	Price    int32 // Decimal places of a price
+	Quantity int32 // Decimal places of a quantity
+}
+
+// DefaultPrecision bounds every price and quantity whatever the symbol. It is
+// the finest precision exchanges quote in, which is fine enough for
+// low-priced tokens. A registered market narrows it, see Market.Precision.
+var DefaultPrecision = Precision{Price: 8, Quantity: 8}
+
+// CheckPrice returns an error naming the field if price has too many decimal places.
+func (p Precision) CheckPrice(field string, price decimal.Decimal) error {
+	return checkPlaces(field, price, p.Price)
+}
+
+// CheckQuantity returns an error naming the field if quantity has too many decimal places.
+func (p Precision) CheckQuantity(field string, quantity decimal.Decimal) error {
+	return checkPlaces(field, quantity, p.Quantity)
+}
+
+// checkPlaces returns an error naming the field if value has more than places decimals.
+func checkPlaces(field string, value decimal.Decimal, places int32) error {
+	if !value.Equal(value.Truncate(places)) {
+		return fmt.Errorf("%s %s must have at most %d decimal places", field, value, places)
+	}
+	return nil
+}
+
+// placesOf returns the decimal places of a step such as a tick size, ignoring
+// trailing zeros.
+func placesOf(step decimal.Decimal) int32 {
+	var places int32
+	for !step.Equal(step.Truncate(places)) {
+		places++
+	}
+	return places
+}
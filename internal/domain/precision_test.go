@@ -0,0 +1,21 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPrecision_Check(t *testing.T) {
+	precision := Precision{Price: 2, Quantity: 0}
+
+	assert.NoError(t, precision.CheckPrice("price", decimal.RequireFromString("60000.25")))
+	assert.NoError(t, precision.CheckPrice("price", decimal.RequireFromString("60000.500")))
+	assert.EqualError(t, precision.CheckPrice("price", decimal.RequireFromString("60000.125")),
+		"price 60000.125 must have at most 2 decimal places")
+
+	assert.NoError(t, precision.CheckQuantity("quantity", decimal.NewFromInt(3)))
+	assert.EqualError(t, precision.CheckQuantity("quantity", decimal.RequireFromString("0.5")),
+		"quantity 0.5 must have at most 0 decimal places")
+}
@@ -0,0 +1,75 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// Side indicates the direction of a signal.
+type Side string
+
+const (
+	// SideBuy indicates a buy.
+	SideBuy Side = "BUY"
+
+	// SideSell indicates a sell.
+	SideSell Side = "SELL"
+)
+
+// Signal records a strategy trigger observed by the price monitor.
+type Signal struct {
+	ID             string          `gorm:"primaryKey"`
+	StrategyID     string          `gorm:"index"`
+	Symbol         string          // BTC, ETH, USDT, etc.
+	Side           Side            // BUY or SELL
+	TriggerPrice   decimal.Decimal `gorm:"type:text"` // Strategy bound that was crossed
+	ObservedPrice  decimal.Decimal `gorm:"type:text"` // Market price that crossed the bound
+	TriggeredAt    time.Time       `gorm:"index"`
+	Acknowledged   bool            // Whether the user has reviewed the signal
+	AcknowledgedAt *time.Time
+	CreatedAt      time.Time
+}
+
+// NewSignal creates an unacknowledged signal for a strategy trigger.
+// The trigger price is the strategy bound matching the side.
+func NewSignal(id string, strategy *Strategy, side Side, observedPrice decimal.Decimal, triggeredAt time.Time) *Signal {
+	triggerPrice := strategy.BuyLower
+	if side == SideSell {
+		triggerPrice = strategy.SellUpper
+	}
+	return &Signal{
+		ID:            id,
+		StrategyID:    strategy.ID,
+		Symbol:        strategy.Symbol,
+		Side:          side,
+		TriggerPrice:  triggerPrice,
+		ObservedPrice: observedPrice,
+		TriggeredAt:   triggeredAt,
+	}
+}
+
+// Acknowledge marks the signal as reviewed. Acknowledging twice keeps the first timestamp.
+func (s *Signal) Acknowledge(at time.Time) {
+	if s.Acknowledged {
+		return
+	}
+	s.Acknowledged = true
+	s.AcknowledgedAt = &at
+}
+
+// Severity ranks the signal by how far the observed price moved past the trigger price.
+func (s *Signal) Severity() Severity {
+	if !s.TriggerPrice.IsPositive() {
+		return SeverityInfo
+	}
+	deviation := s.ObservedPrice.Sub(s.TriggerPrice).Abs().Div(s.TriggerPrice)
+	switch {
+	case deviation.GreaterThanOrEqual(CriticalDeviation):
+		return SeverityCritical
+	case deviation.GreaterThanOrEqual(WarningDeviation):
+		return SeverityWarning
+	default:
+		return SeverityInfo
+	}
+}
@@ -0,0 +1,69 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewSignal(t *testing.T) {
+	strategy := &Strategy{
+		ID:        "strategy-1",
+		Symbol:    "BTC",
+		BuyLower:  decimal.NewFromInt(60000),
+		SellUpper: decimal.NewFromInt(70000),
+		IsActive:  true,
+	}
+	triggeredAt := time.Date(2025, 11, 5, 10, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name             string
+		side             Side
+		observedPrice    string
+		wantTriggerPrice string
+	}{
+		{
+			name:             "buy signal uses buy lower as trigger price",
+			side:             SideBuy,
+			observedPrice:    "59000",
+			wantTriggerPrice: "60000",
+		},
+		{
+			name:             "sell signal uses sell upper as trigger price",
+			side:             SideSell,
+			observedPrice:    "71000",
+			wantTriggerPrice: "70000",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			signal := NewSignal("signal-1", strategy, tt.side, decimal.RequireFromString(tt.observedPrice), triggeredAt)
+
+			assert.Equal(t, "signal-1", signal.ID)
+			assert.Equal(t, "strategy-1", signal.StrategyID)
+			assert.Equal(t, "BTC", signal.Symbol)
+			assert.Equal(t, tt.side, signal.Side)
+			assert.Equal(t, tt.wantTriggerPrice, signal.TriggerPrice.String())
+			assert.Equal(t, tt.observedPrice, signal.ObservedPrice.String())
+			assert.Equal(t, triggeredAt, signal.TriggeredAt)
+			assert.False(t, signal.Acknowledged)
+			assert.Nil(t, signal.AcknowledgedAt)
+		})
+	}
+}
+
+func TestSignalAcknowledge(t *testing.T) {
+	first := time.Date(2025, 11, 5, 10, 0, 0, 0, time.UTC)
+	second := first.Add(time.Hour)
+	signal := &Signal{ID: "signal-1"}
+
+	signal.Acknowledge(first)
+	assert.True(t, signal.Acknowledged)
+	assert.Equal(t, first, *signal.AcknowledgedAt)
+
+	signal.Acknowledge(second)
+	assert.Equal(t, first, *signal.AcknowledgedAt, "acknowledging twice should keep the first timestamp")
+}
@@ -3,36 +3,116 @@ package domain
 import (
 	"errors"
 	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// DefaultCooldown is the minimum time between two signals of a strategy
+// when no cooldown has been configured.
+const DefaultCooldown = 10 * time.Minute
+
+// PriceZone describes where a price sits relative to a strategy's bounds.
+type PriceZone string
+
+const (
+	// ZoneNeutral indicates a price between the buy and sell bounds.
+	ZoneNeutral PriceZone = ""
+
+	// ZoneBuy indicates a price at or below the buy lower bound.
+	ZoneBuy PriceZone = "BUY"
+
+	// ZoneSell indicates a price at or above the sell upper bound.
+	ZoneSell PriceZone = "SELL"
 )
 
 // Strategy represents a price range strategy for cryptocurrency trading.
 type Strategy struct {
-	ID        string  `gorm:"primaryKey"`
-	Symbol    string  // BTC, ETH, USDT, etc.
-	BuyLower  float64 // Minimum price to trigger buy signal
-	SellUpper float64 // Maximum price to trigger sell signal
-	IsActive  bool    // Whether the strategy is currently active
-	CreatedAt time.Time
-	UpdatedAt time.Time
+	ID           string          `gorm:"primaryKey"`
+	Symbol       string          `gorm:"index"`     // BTC, ETH, USDT, etc.
+	BuyLower     decimal.Decimal `gorm:"type:text"` // Minimum price to trigger buy signal
+	SellUpper    decimal.Decimal `gorm:"type:text"` // Maximum price to trigger sell signal
+	IsActive     bool            `gorm:"index"`     // Whether the strategy is currently active
+	Cooldown     *time.Duration  // Minimum time between two signals; nil uses DefaultCooldown
+	LastZone     PriceZone       // Zone observed on the previous evaluation
+	LastSignalAt *time.Time      // When the strategy last produced a signal
+	Version      int             `gorm:"not null;default:1"` // Incremented by every change, for optimistic concurrency
+	CreatedAt    time.Time       `gorm:"index"`
+	UpdatedAt    time.Time
+	DeletedAt    *time.Time `gorm:"index"` // When the strategy was soft deleted; nil if it was not
+}
+
+// IsDeleted reports whether the strategy has been soft deleted.
+func (s *Strategy) IsDeleted() bool {
+	return s.DeletedAt != nil
 }
 
 // Validate checks if the strategy has valid configuration.
 func (s *Strategy) Validate() error {
-	if s.BuyLower <= 0 {
+	if !s.BuyLower.IsPositive() {
 		return errors.New("buy lower bound must be positive")
 	}
-	if s.SellUpper <= s.BuyLower {
+	if s.SellUpper.LessThanOrEqual(s.BuyLower) {
 		return errors.New("sell upper bound must be greater than buy lower bound")
 	}
+	if err := DefaultPrecision.CheckPrice("buy lower bound", s.BuyLower); err != nil {
+		return err
+	}
+	if err := DefaultPrecision.CheckPrice("sell upper bound", s.SellUpper); err != nil {
+		return err
+	}
+	if s.Cooldown != nil && *s.Cooldown < 0 {
+		return errors.New("cooldown must not be negative")
+	}
 	return nil
 }
 
+// CooldownPeriod returns the configured cooldown, or DefaultCooldown when none is set.
+func (s *Strategy) CooldownPeriod() time.Duration {
+	if s.Cooldown == nil {
+		return DefaultCooldown
+	}
+	return *s.Cooldown
+}
+
 // ShouldBuy determines if the current price triggers a buy signal.
-func (s *Strategy) ShouldBuy(currentPrice float64) bool {
-	return s.IsActive && currentPrice <= s.BuyLower
+func (s *Strategy) ShouldBuy(currentPrice decimal.Decimal) bool {
+	return s.IsActive && currentPrice.LessThanOrEqual(s.BuyLower)
 }
 
 // ShouldSell determines if the current price triggers a sell signal.
-func (s *Strategy) ShouldSell(currentPrice float64) bool {
-	return s.IsActive && currentPrice >= s.SellUpper
+func (s *Strategy) ShouldSell(currentPrice decimal.Decimal) bool {
+	return s.IsActive && currentPrice.GreaterThanOrEqual(s.SellUpper)
+}
+
+// Zone returns the zone the current price falls into.
+// Inactive strategies are always neutral.
+func (s *Strategy) Zone(currentPrice decimal.Decimal) PriceZone {
+	switch {
+	case s.ShouldBuy(currentPrice):
+		return ZoneBuy
+	case s.ShouldSell(currentPrice):
+		return ZoneSell
+	default:
+		return ZoneNeutral
+	}
+}
+
+// Evaluate applies edge-triggered semantics to a new price observation.
+// It reports a signal only when the price crosses into the buy or sell zone
+// and the cooldown since the previous signal has elapsed. The trigger state
+// (LastZone, LastSignalAt) is updated so it can be persisted by the caller.
+func (s *Strategy) Evaluate(currentPrice decimal.Decimal, now time.Time) (Side, bool) {
+	zone := s.Zone(currentPrice)
+	entered := zone != ZoneNeutral && zone != s.LastZone
+	s.LastZone = zone
+
+	if !entered {
+		return "", false
+	}
+	if s.LastSignalAt != nil && now.Sub(*s.LastSignalAt) < s.CooldownPeriod() {
+		return "", false
+	}
+
+	s.LastSignalAt = &now
+	return Side(zone), true
 }
@@ -4,6 +4,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -11,43 +12,57 @@ func TestNewStrategy(t *testing.T) {
 	tests := []struct {
 		name      string
 		symbol    string
-		buyLower  float64
-		sellUpper float64
+		buyLower  string
+		sellUpper string
 		wantErr   bool
 	}{
 		{
 			name:      "should create valid strategy",
 			symbol:    "BTC",
-			buyLower:  60000,
-			sellUpper: 70000,
+			buyLower:  "60000",
+			sellUpper: "70000",
 			wantErr:   false,
 		},
 		{
 			name:      "should fail when buy lower is zero",
 			symbol:    "BTC",
-			buyLower:  0,
-			sellUpper: 70000,
+			buyLower:  "0",
+			sellUpper: "70000",
 			wantErr:   true,
 		},
 		{
 			name:      "should fail when buy lower is negative",
 			symbol:    "BTC",
-			buyLower:  -60000,
-			sellUpper: 70000,
+			buyLower:  "-60000",
+			sellUpper: "70000",
 			wantErr:   true,
 		},
 		{
 			name:      "should fail when sell upper <= buy lower",
 			symbol:    "BTC",
-			buyLower:  70000,
-			sellUpper: 70000,
+			buyLower:  "70000",
+			sellUpper: "70000",
 			wantErr:   true,
 		},
 		{
 			name:      "should fail when sell upper < buy lower",
 			symbol:    "BTC",
-			buyLower:  70000,
-			sellUpper: 60000,
+			buyLower:  "70000",
+			sellUpper: "60000",
+			wantErr:   true,
+		},
+		{
+			name:      "should accept low prices for fine grained symbols",
+			symbol:    "SHIB",
+			buyLower:  "0.00001234",
+			sellUpper: "0.00002",
+			wantErr:   false,
+		},
+		{
+			name:      "should fail when a bound is finer than the default precision",
+			symbol:    "SHIB",
+			buyLower:  "0.000012345",
+			sellUpper: "0.00002",
 			wantErr:   true,
 		},
 	}
@@ -57,8 +72,8 @@ func TestNewStrategy(t *testing.T) {
 			strategy := &Strategy{
 				ID:        "test-id",
 				Symbol:    tt.symbol,
-				BuyLower:  tt.buyLower,
-				SellUpper: tt.sellUpper,
+				BuyLower:  decimal.RequireFromString(tt.buyLower),
+				SellUpper: decimal.RequireFromString(tt.sellUpper),
 				IsActive:  true,
 				CreatedAt: time.Now(),
 			}
@@ -86,8 +101,8 @@ func TestStrategyValidate(t *testing.T) {
 			strategy: &Strategy{
 				ID:        "test-1",
 				Symbol:    "BTC",
-				BuyLower:  60000,
-				SellUpper: 70000,
+				BuyLower:  decimal.NewFromInt(60000),
+				SellUpper: decimal.NewFromInt(70000),
 				IsActive:  true,
 				CreatedAt: time.Now(),
 			},
@@ -98,8 +113,8 @@ func TestStrategyValidate(t *testing.T) {
 			strategy: &Strategy{
 				ID:        "test-2",
 				Symbol:    "BTC",
-				BuyLower:  0,
-				SellUpper: 70000,
+				BuyLower:  decimal.Zero,
+				SellUpper: decimal.NewFromInt(70000),
 				IsActive:  true,
 				CreatedAt: time.Now(),
 			},
@@ -110,9 +125,35 @@ func TestStrategyValidate(t *testing.T) {
 			strategy: &Strategy{
 				ID:        "test-3",
 				Symbol:    "BTC",
-				BuyLower:  70000,
-				SellUpper: 70000,
+				BuyLower:  decimal.NewFromInt(70000),
+				SellUpper: decimal.NewFromInt(70000),
+				IsActive:  true,
+				CreatedAt: time.Now(),
+			},
+			wantErr: true,
+		},
+		{
+			name: "valid strategy with zero cooldown",
+			strategy: &Strategy{
+				ID:        "test-4",
+				Symbol:    "BTC",
+				BuyLower:  decimal.NewFromInt(60000),
+				SellUpper: decimal.NewFromInt(70000),
+				IsActive:  true,
+				Cooldown:  durationPtr(0),
+				CreatedAt: time.Now(),
+			},
+			wantErr: false,
+		},
+		{
+			name: "invalid strategy - cooldown must not be negative",
+			strategy: &Strategy{
+				ID:        "test-5",
+				Symbol:    "BTC",
+				BuyLower:  decimal.NewFromInt(60000),
+				SellUpper: decimal.NewFromInt(70000),
 				IsActive:  true,
+				Cooldown:  durationPtr(-time.Minute),
 				CreatedAt: time.Now(),
 			},
 			wantErr: true,
@@ -136,7 +177,7 @@ func TestStrategyShouldBuy(t *testing.T) {
 	tests := []struct {
 		name         string
 		strategy     *Strategy
-		currentPrice float64
+		currentPrice string
 		expected     bool
 	}{
 		{
@@ -144,12 +185,12 @@ func TestStrategyShouldBuy(t *testing.T) {
 			strategy: &Strategy{
 				ID:        "test-1",
 				Symbol:    "BTC",
-				BuyLower:  60000,
-				SellUpper: 70000,
+				BuyLower:  decimal.NewFromInt(60000),
+				SellUpper: decimal.NewFromInt(70000),
 				IsActive:  true,
 				CreatedAt: time.Now(),
 			},
-			currentPrice: 59000,
+			currentPrice: "59000",
 			expected:     true,
 		},
 		{
@@ -157,12 +198,12 @@ func TestStrategyShouldBuy(t *testing.T) {
 			strategy: &Strategy{
 				ID:        "test-2",
 				Symbol:    "BTC",
-				BuyLower:  60000,
-				SellUpper: 70000,
+				BuyLower:  decimal.NewFromInt(60000),
+				SellUpper: decimal.NewFromInt(70000),
 				IsActive:  true,
 				CreatedAt: time.Now(),
 			},
-			currentPrice: 60000,
+			currentPrice: "60000",
 			expected:     true,
 		},
 		{
@@ -170,12 +211,12 @@ func TestStrategyShouldBuy(t *testing.T) {
 			strategy: &Strategy{
 				ID:        "test-3",
 				Symbol:    "BTC",
-				BuyLower:  60000,
-				SellUpper: 70000,
+				BuyLower:  decimal.NewFromInt(60000),
+				SellUpper: decimal.NewFromInt(70000),
 				IsActive:  true,
 				CreatedAt: time.Now(),
 			},
-			currentPrice: 61000,
+			currentPrice: "61000",
 			expected:     false,
 		},
 		{
@@ -183,19 +224,19 @@ func TestStrategyShouldBuy(t *testing.T) {
 			strategy: &Strategy{
 				ID:        "test-4",
 				Symbol:    "BTC",
-				BuyLower:  60000,
-				SellUpper: 70000,
+				BuyLower:  decimal.NewFromInt(60000),
+				SellUpper: decimal.NewFromInt(70000),
 				IsActive:  false,
 				CreatedAt: time.Now(),
 			},
-			currentPrice: 59000,
+			currentPrice: "59000",
 			expected:     false,
 		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			result := tt.strategy.ShouldBuy(tt.currentPrice)
+			result := tt.strategy.ShouldBuy(decimal.RequireFromString(tt.currentPrice))
 			assert.Equal(t, tt.expected, result)
 		})
 	}
@@ -205,7 +246,7 @@ func TestStrategyShouldSell(t *testing.T) {
 	tests := []struct {
 		name         string
 		strategy     *Strategy
-		currentPrice float64
+		currentPrice string
 		expected     bool
 	}{
 		{
@@ -213,12 +254,12 @@ func TestStrategyShouldSell(t *testing.T) {
 			strategy: &Strategy{
 				ID:        "test-1",
 				Symbol:    "BTC",
-				BuyLower:  60000,
-				SellUpper: 70000,
+				BuyLower:  decimal.NewFromInt(60000),
+				SellUpper: decimal.NewFromInt(70000),
 				IsActive:  true,
 				CreatedAt: time.Now(),
 			},
-			currentPrice: 71000,
+			currentPrice: "71000",
 			expected:     true,
 		},
 		{
@@ -226,12 +267,12 @@ func TestStrategyShouldSell(t *testing.T) {
 			strategy: &Strategy{
 				ID:        "test-2",
 				Symbol:    "BTC",
-				BuyLower:  60000,
-				SellUpper: 70000,
+				BuyLower:  decimal.NewFromInt(60000),
+				SellUpper: decimal.NewFromInt(70000),
 				IsActive:  true,
 				CreatedAt: time.Now(),
 			},
-			currentPrice: 70000,
+			currentPrice: "70000",
 			expected:     true,
 		},
 		{
@@ -239,12 +280,12 @@ func TestStrategyShouldSell(t *testing.T) {
 			strategy: &Strategy{
 				ID:        "test-3",
 				Symbol:    "BTC",
-				BuyLower:  60000,
-				SellUpper: 70000,
+				BuyLower:  decimal.NewFromInt(60000),
+				SellUpper: decimal.NewFromInt(70000),
 				IsActive:  true,
 				CreatedAt: time.Now(),
 			},
-			currentPrice: 69000,
+			currentPrice: "69000",
 			expected:     false,
 		},
 		{
@@ -252,20 +293,212 @@ func TestStrategyShouldSell(t *testing.T) {
 			strategy: &Strategy{
 				ID:        "test-4",
 				Symbol:    "BTC",
-				BuyLower:  60000,
-				SellUpper: 70000,
+				BuyLower:  decimal.NewFromInt(60000),
+				SellUpper: decimal.NewFromInt(70000),
 				IsActive:  false,
 				CreatedAt: time.Now(),
 			},
-			currentPrice: 71000,
+			currentPrice: "71000",
 			expected:     false,
 		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			result := tt.strategy.ShouldSell(tt.currentPrice)
+			result := tt.strategy.ShouldSell(decimal.RequireFromString(tt.currentPrice))
 			assert.Equal(t, tt.expected, result)
 		})
 	}
 }
+
+func durationPtr(d time.Duration) *time.Duration {
+	return &d
+}
+
+func timePtr(t time.Time) *time.Time {
+	return &t
+}
+
+func TestStrategyCooldownPeriod(t *testing.T) {
+	tests := []struct {
+		name     string
+		cooldown *time.Duration
+		expected time.Duration
+	}{
+		{
+			name:     "should use default cooldown when not configured",
+			cooldown: nil,
+			expected: DefaultCooldown,
+		},
+		{
+			name:     "should use configured cooldown",
+			cooldown: durationPtr(time.Hour),
+			expected: time.Hour,
+		},
+		{
+			name:     "should allow disabling the cooldown",
+			cooldown: durationPtr(0),
+			expected: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			strategy := &Strategy{Cooldown: tt.cooldown}
+			assert.Equal(t, tt.expected, strategy.CooldownPeriod())
+		})
+	}
+}
+
+func TestStrategyZone(t *testing.T) {
+	tests := []struct {
+		name         string
+		isActive     bool
+		currentPrice string
+		expected     PriceZone
+	}{
+		{name: "price at buy lower is in buy zone", isActive: true, currentPrice: "60000", expected: ZoneBuy},
+		{name: "price below buy lower is in buy zone", isActive: true, currentPrice: "59000", expected: ZoneBuy},
+		{name: "price between bounds is neutral", isActive: true, currentPrice: "65000", expected: ZoneNeutral},
+		{name: "price at sell upper is in sell zone", isActive: true, currentPrice: "70000", expected: ZoneSell},
+		{name: "inactive strategy is always neutral", isActive: false, currentPrice: "59000", expected: ZoneNeutral},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			strategy := &Strategy{
+				ID:        "test-1",
+				Symbol:    "BTC",
+				BuyLower:  decimal.NewFromInt(60000),
+				SellUpper: decimal.NewFromInt(70000),
+				IsActive:  tt.isActive,
+			}
+			assert.Equal(t, tt.expected, strategy.Zone(decimal.RequireFromString(tt.currentPrice)))
+		})
+	}
+}
+
+func TestStrategyEvaluate(t *testing.T) {
+	now := time.Date(2025, 11, 5, 10, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name             string
+		lastZone         PriceZone
+		lastSignalAt     *time.Time
+		cooldown         *time.Duration
+		currentPrice     string
+		wantSide         Side
+		wantSignal       bool
+		wantLastZone     PriceZone
+		wantLastSignalAt *time.Time
+	}{
+		{
+			name:             "should signal buy when price crosses into buy zone",
+			lastZone:         ZoneNeutral,
+			currentPrice:     "59000",
+			wantSide:         SideBuy,
+			wantSignal:       true,
+			wantLastZone:     ZoneBuy,
+			wantLastSignalAt: &now,
+		},
+		{
+			name:             "should signal sell when price crosses into sell zone",
+			lastZone:         ZoneNeutral,
+			currentPrice:     "71000",
+			wantSide:         SideSell,
+			wantSignal:       true,
+			wantLastZone:     ZoneSell,
+			wantLastSignalAt: &now,
+		},
+		{
+			name:             "should not signal while price stays in buy zone",
+			lastZone:         ZoneBuy,
+			lastSignalAt:     timePtr(now.Add(-time.Hour)),
+			currentPrice:     "58000",
+			wantSignal:       false,
+			wantLastZone:     ZoneBuy,
+			wantLastSignalAt: timePtr(now.Add(-time.Hour)),
+		},
+		{
+			name:             "should reset zone when price returns between bounds",
+			lastZone:         ZoneBuy,
+			lastSignalAt:     timePtr(now.Add(-time.Hour)),
+			currentPrice:     "65000",
+			wantSignal:       false,
+			wantLastZone:     ZoneNeutral,
+			wantLastSignalAt: timePtr(now.Add(-time.Hour)),
+		},
+		{
+			name:             "should suppress re-entry within default cooldown",
+			lastZone:         ZoneNeutral,
+			lastSignalAt:     timePtr(now.Add(-5 * time.Minute)),
+			currentPrice:     "59000",
+			wantSignal:       false,
+			wantLastZone:     ZoneBuy,
+			wantLastSignalAt: timePtr(now.Add(-5 * time.Minute)),
+		},
+		{
+			name:             "should signal re-entry after default cooldown",
+			lastZone:         ZoneNeutral,
+			lastSignalAt:     timePtr(now.Add(-DefaultCooldown)),
+			currentPrice:     "59000",
+			wantSide:         SideBuy,
+			wantSignal:       true,
+			wantLastZone:     ZoneBuy,
+			wantLastSignalAt: &now,
+		},
+		{
+			name:             "should honour a configured cooldown",
+			lastZone:         ZoneNeutral,
+			lastSignalAt:     timePtr(now.Add(-30 * time.Minute)),
+			cooldown:         durationPtr(time.Hour),
+			currentPrice:     "71000",
+			wantSignal:       false,
+			wantLastZone:     ZoneSell,
+			wantLastSignalAt: timePtr(now.Add(-30 * time.Minute)),
+		},
+		{
+			name:             "should signal immediately when cooldown is disabled",
+			lastZone:         ZoneNeutral,
+			lastSignalAt:     timePtr(now.Add(-time.Second)),
+			cooldown:         durationPtr(0),
+			currentPrice:     "59000",
+			wantSide:         SideBuy,
+			wantSignal:       true,
+			wantLastZone:     ZoneBuy,
+			wantLastSignalAt: &now,
+		},
+		{
+			name:             "should signal when price jumps from buy zone to sell zone",
+			lastZone:         ZoneBuy,
+			lastSignalAt:     timePtr(now.Add(-time.Hour)),
+			currentPrice:     "71000",
+			wantSide:         SideSell,
+			wantSignal:       true,
+			wantLastZone:     ZoneSell,
+			wantLastSignalAt: &now,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			strategy := &Strategy{
+				ID:           "test-1",
+				Symbol:       "BTC",
+				BuyLower:     decimal.NewFromInt(60000),
+				SellUpper:    decimal.NewFromInt(70000),
+				IsActive:     true,
+				Cooldown:     tt.cooldown,
+				LastZone:     tt.lastZone,
+				LastSignalAt: tt.lastSignalAt,
+			}
+
+			side, signal := strategy.Evaluate(decimal.RequireFromString(tt.currentPrice), now)
+
+			assert.Equal(t, tt.wantSignal, signal)
+			assert.Equal(t, tt.wantSide, side)
+			assert.Equal(t, tt.wantLastZone, strategy.LastZone)
+			assert.Equal(t, tt.wantLastSignalAt, strategy.LastSignalAt)
+		})
+	}
+}
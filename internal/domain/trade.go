@@ -0,0 +1,55 @@
+package domain
+
+import (
+	"errors"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// Trade records an order executed manually on an exchange.
+type Trade struct {
+	ID         string          `gorm:"primaryKey"`
+	Symbol     string          `gorm:"index"` // BTC, ETH, USDT, etc.
+	Side       Side            // BUY or SELL
+	Quantity   decimal.Decimal `gorm:"type:text"` // Amount of the asset bought or sold
+	Price      decimal.Decimal `gorm:"type:text"` // Price per unit
+	Fee        decimal.Decimal `gorm:"type:text"` // Fee paid, in the quote currency
+	StrategyID *string         `gorm:"index"`     // Strategy the trade was made for, if any
+	ExecutedAt time.Time       `gorm:"index"`     // When the order was filled
+	CreatedAt  time.Time
+}
+
+// Validate checks if the trade has valid values.
+func (t *Trade) Validate() error {
+	if t.Symbol == "" {
+		return errors.New("symbol must not be empty")
+	}
+	if t.Side != SideBuy && t.Side != SideSell {
+		return errors.New("side must be BUY or SELL")
+	}
+	if !t.Quantity.IsPositive() {
+		return errors.New("quantity must be positive")
+	}
+	if !t.Price.IsPositive() {
+		return errors.New("price must be positive")
+	}
+	if t.Fee.IsNegative() {
+		return errors.New("fee must not be negative")
+	}
+	if err := DefaultPrecision.CheckQuantity("quantity", t.Quantity); err != nil {
+		return err
+	}
+	if err := DefaultPrecision.CheckPrice("price", t.Price); err != nil {
+		return err
+	}
+	if t.ExecutedAt.IsZero() {
+		return errors.New("execution time must be set")
+	}
+	return nil
+}
+
+// Notional returns the traded value before fees.
+func (t *Trade) Notional() decimal.Decimal {
+	return t.Quantity.Mul(t.Price)
+}
@@ -0,0 +1,60 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTradeValidate(t *testing.T) {
+	executedAt := time.Date(2025, 11, 5, 10, 0, 0, 0, time.UTC)
+	valid := func() *Trade {
+		return &Trade{
+			ID:         "trade-1",
+			Symbol:     "BTC",
+			Side:       SideBuy,
+			Quantity:   decimal.RequireFromString("0.5"),
+			Price:      decimal.NewFromInt(60000),
+			Fee:        decimal.RequireFromString("12.5"),
+			ExecutedAt: executedAt,
+		}
+	}
+
+	tests := []struct {
+		name    string
+		modify  func(t *Trade)
+		wantErr bool
+	}{
+		{name: "valid buy", modify: func(t *Trade) {}},
+		{name: "valid sell without fee", modify: func(t *Trade) { t.Side = SideSell; t.Fee = decimal.Zero }},
+		{name: "empty symbol", modify: func(t *Trade) { t.Symbol = "" }, wantErr: true},
+		{name: "unknown side", modify: func(t *Trade) { t.Side = "HOLD" }, wantErr: true},
+		{name: "zero quantity", modify: func(t *Trade) { t.Quantity = decimal.Zero }, wantErr: true},
+		{name: "negative price", modify: func(t *Trade) { t.Price = decimal.NewFromInt(-1) }, wantErr: true},
+		{name: "negative fee", modify: func(t *Trade) { t.Fee = decimal.RequireFromString("-0.1") }, wantErr: true},
+		{name: "quantity finer than the default precision", modify: func(t *Trade) { t.Quantity = decimal.RequireFromString("0.123456789") }, wantErr: true},
+		{name: "price finer than the default precision", modify: func(t *Trade) { t.Price = decimal.RequireFromString("0.000000001") }, wantErr: true},
+		{name: "missing execution time", modify: func(t *Trade) { t.ExecutedAt = time.Time{} }, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			trade := valid()
+			tt.modify(trade)
+
+			err := trade.Validate()
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestTradeNotional(t *testing.T) {
+	trade := &Trade{Quantity: decimal.RequireFromString("0.5"), Price: decimal.NewFromInt(60000), Fee: decimal.NewFromInt(10)}
+	assert.Equal(t, "30000", trade.Notional().String())
+}
@@ -0,0 +1,112 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"transaction/internal/config"
+	"transaction/pkg/logger"
+)
+
+var (
+	showConfigCmd     *cobra.Command
+	initConfigCmd     *cobra.Command
+	validateConfigCmd *cobra.Command
+)
+
+// NewConfigCommand creates the root config command with subcommands. flags
+// holds the global configuration flags parsed by the root command.
+func NewConfigCommand(flags *config.Flags, log logger.Logger) *cobra.Command {
+	rootCmd := &cobra.Command{
+		Use:   "config",
+		Short: "Manage configuration",
+		Long:  "Commands for inspecting and creating the configuration file",
+	}
+
+	// Show command
+	showConfigCmd = &cobra.Command{
+		Use:   "show",
+		Short: "Show the effective configuration",
+		Long:  "Display the configuration after applying the file, environment variables and flags. The password of the database connection string is masked",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cfg, path, err := config.Load(flags, os.Getenv)
+			if cfg == nil {
+				log.Error("Failed to load configuration", "error", err.Error())
+				return err
+			}
+
+			data, marshalErr := cfg.Redacted().Marshal()
+			if marshalErr != nil {
+				return marshalErr
+			}
+
+			if _, statErr := os.Stat(path); statErr != nil {
+				fmt.Printf("# Configuration file: %s (not found, using defaults)\n", path)
+			} else {
+				fmt.Printf("# Configuration file: %s\n", path)
+			}
+			fmt.Print(string(data))
+
+			if err != nil {
+				return fmt.Errorf("configuration is invalid: %w", err)
+			}
+			return nil
+		},
+	}
+
+	// Init command
+	initConfigCmd = &cobra.Command{
+		Use:   "init",
+		Short: "Create a configuration file",
+		Long:  "Write the default configuration to the configuration file so it can be edited",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			force, _ := cmd.Flags().GetBool("force")
+			path, _ := config.Path(flags, os.Getenv)
+
+			if err := config.Default().WriteFile(path, force); err != nil {
+				log.Error("Failed to write configuration", "error", err.Error())
+				if errors.Is(err, config.ErrExists) {
+					return fmt.Errorf("%w (use --force to replace it)", err)
+				}
+				return err
+			}
+
+			fmt.Printf("Wrote configuration to %s\n", path)
+			return nil
+		},
+	}
+
+	initConfigCmd.Flags().Bool("force", false, "Replace an existing configuration file")
+
+	// Validate command
+	validateConfigCmd = &cobra.Command{
+		Use:   "validate",
+		Short: "Validate the configuration",
+		Long:  "Check the configuration file, environment variables and flags and report every problem",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			_, path, err := config.Load(flags, os.Getenv)
+			if err != nil {
+				fmt.Printf("Configuration %s is invalid:\n", path)
+				for _, problem := range strings.Split(err.Error(), "\n") {
+					fmt.Printf("  - %s\n", strings.TrimSpace(problem))
+				}
+				return fmt.Errorf("configuration is invalid")
+			}
+
+			fmt.Printf("Configuration %s is valid\n", path)
+			return nil
+		},
+	}
+
+	// Add subcommands to root command
+	rootCmd.AddCommand(
+		showConfigCmd,
+		initConfigCmd,
+		validateConfigCmd,
+	)
+
+	return rootCmd
+}
@@ -0,0 +1,134 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"transaction/internal/usecase/schema"
+	"transaction/pkg/logger"
+)
+
+var (
+	migrateUpCmd     *cobra.Command
+	migrateDownCmd   *cobra.Command
+	migrateStatusCmd *cobra.Command
+)
+
+// NewDBCommand creates the root db command with subcommands
+func NewDBCommand(svc *schema.SchemaService, log logger.Logger) *cobra.Command {
+	rootCmd := &cobra.Command{
+		Use:   "db",
+		Short: "Manage the database",
+		Long:  "Commands for managing the database schema",
+	}
+
+	migrateCmd := &cobra.Command{
+		Use:   "migrate",
+		Short: "Apply or roll back schema migrations",
+		Long:  "Apply, roll back or inspect the numbered schema migrations",
+	}
+
+	// Up command
+	migrateUpCmd = &cobra.Command{
+		Use:   "up",
+		Short: "Apply pending migrations",
+		Long:  "Apply every pending migration in version order",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			applied, err := svc.MigrateUp(cmd.Context())
+			if err != nil {
+				log.Error("Failed to apply migrations", "error", err.Error())
+				return err
+			}
+
+			if len(applied) == 0 {
+				fmt.Println("Database is up to date")
+				return nil
+			}
+			for _, m := range applied {
+				fmt.Printf("Applied %04d_%s\n", m.Version, m.Name)
+			}
+			return nil
+		},
+	}
+
+	// Down command
+	migrateDownCmd = &cobra.Command{
+		Use:   "down",
+		Short: "Roll back migrations",
+		Long:  "Roll back the latest applied migrations, newest first. The tables they created are dropped with their data",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			steps, _ := cmd.Flags().GetInt("steps")
+			yes, _ := cmd.Flags().GetBool("yes")
+
+			if steps < 1 {
+				return fmt.Errorf("--steps must be at least 1")
+			}
+
+			if !yes {
+				question := fmt.Sprintf("Roll back %d migration(s)? Data in the affected tables will be lost", steps)
+				if !confirm(cmd, question) {
+					fmt.Println("Aborted")
+					return nil
+				}
+			}
+
+			rolledBack, err := svc.MigrateDown(cmd.Context(), steps)
+			if err != nil {
+				log.Error("Failed to roll back migrations", "error", err.Error())
+				return err
+			}
+
+			if len(rolledBack) == 0 {
+				fmt.Println("No applied migrations to roll back")
+				return nil
+			}
+			for _, m := range rolledBack {
+				fmt.Printf("Rolled back %04d_%s\n", m.Version, m.Name)
+			}
+			return nil
+		},
+	}
+
+	migrateDownCmd.Flags().Int("steps", 1, "Number of migrations to roll back")
+	migrateDownCmd.Flags().BoolP("yes", "y", false, "Roll back without asking for confirmation")
+
+	// Status command
+	migrateStatusCmd = &cobra.Command{
+		Use:   "status",
+		Short: "Show migration status",
+		Long:  "List every migration and when it was applied",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			statuses, err := svc.Status(cmd.Context())
+			if err != nil {
+				log.Error("Failed to read migration status", "error", err.Error())
+				return err
+			}
+
+			fmt.Println("Migrations:")
+			fmt.Println(strings.Repeat("-", 80))
+			fmt.Printf("%-8s %-40s %s\n", "Version", "Name", "Applied At")
+			fmt.Println(strings.Repeat("-", 80))
+			for _, m := range statuses {
+				applied := "pending"
+				if m.AppliedAt != nil {
+					applied = m.AppliedAt.Local().Format(time.RFC3339)
+				}
+				fmt.Printf("%-8s %-40s %s\n", fmt.Sprintf("%04d", m.Version), m.Name, applied)
+			}
+			fmt.Println(strings.Repeat("-", 80))
+			return nil
+		},
+	}
+
+	// Add subcommands to migrate command
+	migrateCmd.AddCommand(
+		migrateUpCmd,
+		migrateDownCmd,
+		migrateStatusCmd,
+	)
+	rootCmd.AddCommand(migrateCmd)
+
+	return rootCmd
+}
@@ -0,0 +1,58 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/spf13/cobra"
+	"transaction/internal/usecase/monitor"
+	"transaction/pkg/logger"
+)
+
+var (
+	runMonitorCmd *cobra.Command
+)
+
+// NewMonitorCommand creates the root monitor command with subcommands
+func NewMonitorCommand(priceMonitor *monitor.PriceMonitor, log logger.Logger) *cobra.Command {
+	rootCmd := &cobra.Command{
+		Use:   "monitor",
+		Short: "Monitor market prices",
+		Long:  "Commands for watching market prices against active strategies",
+	}
+
+	// Run command
+	runMonitorCmd = &cobra.Command{
+		Use:   "run",
+		Short: "Run the price monitor",
+		Long:  "Continuously evaluate active strategies against market prices until interrupted",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if priceMonitor == nil {
+				return fmt.Errorf("price monitor is not configured")
+			}
+
+			ctx, stop := signal.NotifyContext(cmd.Context(), os.Interrupt, syscall.SIGTERM)
+			defer stop()
+
+			err := priceMonitor.Run(ctx)
+			if err != nil && !errors.Is(err, context.Canceled) {
+				log.Error("Price monitor stopped unexpectedly", "error", err.Error())
+				return err
+			}
+
+			log.Info("Price monitor stopped")
+			return nil
+		},
+	}
+
+	// Add subcommands to root command
+	rootCmd.AddCommand(
+		runMonitorCmd,
+	)
+
+	return rootCmd
+}
@@ -0,0 +1,127 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"transaction/internal/usecase/notification"
+	"transaction/pkg/logger"
+)
+
+var (
+	prefsCmd    *cobra.Command
+	getPrefsCmd *cobra.Command
+	setPrefsCmd *cobra.Command
+)
+
+// NewNotifyCommand creates the root notify command with subcommands
+func NewNotifyCommand(svc *notification.NotificationService, log logger.Logger) *cobra.Command {
+	rootCmd := &cobra.Command{
+		Use:   "notify",
+		Short: "Manage notifications",
+		Long:  "Commands for controlling how triggered signals are notified",
+	}
+
+	prefsCmd = &cobra.Command{
+		Use:   "prefs",
+		Short: "Manage notification preferences",
+		Long:  "Commands for viewing and changing quiet hours, muted strategies and the minimum severity",
+	}
+
+	// Get command
+	getPrefsCmd = &cobra.Command{
+		Use:   "get",
+		Short: "Show notification preferences",
+		Long:  "Display the current notification preferences",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			result, err := svc.GetPreferences(cmd.Context())
+			if err != nil {
+				log.Error("Failed to get notification preferences", "error", err.Error())
+				return err
+			}
+
+			printPreferences(result)
+			return nil
+		},
+	}
+
+	// Set command
+	setPrefsCmd = &cobra.Command{
+		Use:   "set",
+		Short: "Change notification preferences",
+		Long:  "Change notification preferences; only the given flags are updated",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			req := &notification.UpdatePreferencesRequest{}
+
+			if cmd.Flags().Changed("timezone") {
+				timezone, _ := cmd.Flags().GetString("timezone")
+				req.Timezone = &timezone
+			}
+			if cmd.Flags().Changed("quiet") {
+				req.QuietHours, _ = cmd.Flags().GetStringSlice("quiet")
+			}
+			if clear, _ := cmd.Flags().GetBool("clear-quiet"); clear {
+				req.QuietHours = []string{}
+			}
+			req.MuteStrategies, _ = cmd.Flags().GetStringSlice("mute")
+			req.UnmuteStrategies, _ = cmd.Flags().GetStringSlice("unmute")
+			if cmd.Flags().Changed("min-severity") {
+				severity, _ := cmd.Flags().GetString("min-severity")
+				req.MinSeverity = &severity
+			}
+
+			if req.Timezone == nil && req.QuietHours == nil && req.MinSeverity == nil &&
+				len(req.MuteStrategies) == 0 && len(req.UnmuteStrategies) == 0 {
+				return fmt.Errorf("at least one of --timezone, --quiet, --clear-quiet, --mute, --unmute or --min-severity is required")
+			}
+
+			result, err := svc.UpdatePreferences(cmd.Context(), req)
+			if err != nil {
+				log.Error("Failed to update notification preferences", "error", err.Error())
+				return err
+			}
+
+			fmt.Println("Notification preferences updated")
+			printPreferences(result)
+			return nil
+		},
+	}
+
+	setPrefsCmd.Flags().String("timezone", "", "IANA timezone of the quiet hours (e.g. Asia/Taipei)")
+	setPrefsCmd.Flags().StringSlice("quiet", nil, "Quiet hours as HH:MM-HH:MM; repeat or comma separate for several windows")
+	setPrefsCmd.Flags().Bool("clear-quiet", false, "Remove all quiet hours")
+	setPrefsCmd.Flags().StringSlice("mute", nil, "Strategy IDs whose signals should not be notified")
+	setPrefsCmd.Flags().StringSlice("unmute", nil, "Strategy IDs to notify again")
+	setPrefsCmd.Flags().String("min-severity", "", "Minimum severity to notify: info, warning or critical")
+
+	prefsCmd.AddCommand(
+		getPrefsCmd,
+		setPrefsCmd,
+	)
+
+	// Add subcommands to root command
+	rootCmd.AddCommand(
+		prefsCmd,
+	)
+
+	return rootCmd
+}
+
+// printPreferences prints the notification preferences.
+func printPreferences(p *notification.PreferencesResponse) {
+	quietHours := "none"
+	if len(p.QuietHours) > 0 {
+		quietHours = strings.Join(p.QuietHours, ", ")
+	}
+	muted := "none"
+	if len(p.MutedStrategies) > 0 {
+		muted = strings.Join(p.MutedStrategies, ", ")
+	}
+
+	fmt.Println("Notification Preferences:")
+	fmt.Printf("  Timezone: %s\n", p.Timezone)
+	fmt.Printf("  Quiet Hours: %s\n", quietHours)
+	fmt.Printf("  Muted Strategies: %s\n", muted)
+	fmt.Printf("  Minimum Severity: %s\n", p.MinSeverity)
+}
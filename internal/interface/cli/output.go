@@ -0,0 +1,246 @@
+package cli
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+	"transaction/internal/usecase/strategy"
+)
+
+// Output formats accepted by --output.
+const (
+	outputTable = "table"
+	outputJSON  = "json"
+	outputYAML  = "yaml"
+	outputCSV   = "csv"
+)
+
+// machineOutput is the annotation of the commands writing their result in
+// every output format. The others only write tables and reject --output.
+const machineOutput = "machine-output"
+
+// supportsOutput returns the annotations marking a command as writing every
+// output format.
+func supportsOutput() map[string]string {
+	return map[string]string{machineOutput: "true"}
+}
+
+// bindOutputFlag adds the global --output flag to the root command and checks
+// it before any command runs.
+func bindOutputFlag(rootCmd *cobra.Command) {
+	rootCmd.PersistentFlags().StringP("output", "o", outputTable, "Output format: table, json, yaml or csv")
+	rootCmd.PersistentPreRunE = func(cmd *cobra.Command, args []string) error {
+		format := outputFormat(cmd)
+		switch format {
+		case outputTable, outputJSON, outputYAML, outputCSV:
+		default:
+			if raw, _ := cmd.Flags().GetString("output"); looksLikePath(raw) && cmd.Flags().Lookup("file") != nil {
+				return fmt.Errorf("invalid output format %q: --output now selects the format, use --file to write to a file", raw)
+			}
+			return fmt.Errorf("invalid output format %q: use table, json, yaml or csv", format)
+		}
+		if format != outputTable && cmd.Annotations[machineOutput] == "" {
+			return fmt.Errorf("%s only supports --output table", cmd.CommandPath())
+		}
+		return nil
+	}
+}
+
+// looksLikePath reports whether an --output value is a file name, as given to
+// the --output flag of trade history before it was renamed --file.
+func looksLikePath(value string) bool {
+	return strings.ContainsAny(value, `/\`) || filepath.Ext(value) != ""
+}
+
+// outputFormat returns the --output format of cmd.
+func outputFormat(cmd *cobra.Command) string {
+	format, _ := cmd.Flags().GetString("output")
+	return strings.ToLower(strings.TrimSpace(format))
+}
+
+// writeResult writes the result of a command to its output in the --output
+// format, or in its human readable form written by table.
+func writeResult(cmd *cobra.Command, data interface{}, columns []string, rows [][]string, table func(w io.Writer) error) error {
+	format := outputFormat(cmd)
+	if format == outputTable {
+		return table(cmd.OutOrStdout())
+	}
+	return encode(cmd.OutOrStdout(), format, data, columns, rows)
+}
+
+// encode writes a result in a machine readable format: data is encoded as
+// JSON or YAML, rows under a header of columns as CSV.
+func encode(w io.Writer, format string, data interface{}, columns []string, rows [][]string) error {
+	switch format {
+	case outputJSON:
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(data)
+	case outputYAML:
+		enc := yaml.NewEncoder(w)
+		enc.SetIndent(2)
+		if err := enc.Encode(data); err != nil {
+			return err
+		}
+		return enc.Close()
+	case outputCSV:
+		cw := csv.NewWriter(w)
+		if err := cw.Write(columns); err != nil {
+			return err
+		}
+		return cw.WriteAll(rows)
+	default:
+		return fmt.Errorf("cannot encode as %q", format)
+	}
+}
+
+// strategyRecord is a strategy in the machine readable output formats. Prices
+// are strings so no precision is lost.
+type strategyRecord struct {
+	ID        string     `json:"id" yaml:"id"`
+	Symbol    string     `json:"symbol" yaml:"symbol"`
+	BuyLower  string     `json:"buy_lower" yaml:"buy_lower"`
+	SellUpper string     `json:"sell_upper" yaml:"sell_upper"`
+	Cooldown  string     `json:"cooldown" yaml:"cooldown"`
+	IsActive  bool       `json:"is_active" yaml:"is_active"`
+	Version   int        `json:"version" yaml:"version"`
+	CreatedAt time.Time  `json:"created_at" yaml:"created_at"`
+	DeletedAt *time.Time `json:"deleted_at,omitempty" yaml:"deleted_at,omitempty"`
+}
+
+// strategyColumns is the CSV header of strategyRecord.row.
+var strategyColumns = []string{"id", "symbol", "buy_lower", "sell_upper", "cooldown", "is_active", "version", "created_at", "deleted_at"}
+
+// toStrategyRecord converts a StrategyResponse for output.
+func toStrategyRecord(s *strategy.StrategyResponse) strategyRecord {
+	r := strategyRecord{
+		ID:        s.ID,
+		Symbol:    s.Symbol,
+		BuyLower:  s.BuyLower.String(),
+		SellUpper: s.SellUpper.String(),
+		Cooldown:  s.Cooldown.String(),
+		IsActive:  s.IsActive,
+		Version:   s.Version,
+		CreatedAt: s.CreatedAt.UTC(),
+	}
+	if s.DeletedAt != nil {
+		deletedAt := s.DeletedAt.UTC()
+		r.DeletedAt = &deletedAt
+	}
+	return r
+}
+
+// row returns the CSV fields of the record.
+func (r strategyRecord) row() []string {
+	deletedAt := ""
+	if r.DeletedAt != nil {
+		deletedAt = r.DeletedAt.Format(time.RFC3339)
+	}
+	return []string{
+		r.ID,
+		r.Symbol,
+		r.BuyLower,
+		r.SellUpper,
+		r.Cooldown,
+		strconv.FormatBool(r.IsActive),
+		strconv.Itoa(r.Version),
+		r.CreatedAt.Format(time.RFC3339),
+		deletedAt,
+	}
+}
+
+// writeStrategy writes one strategy in the --output format; table writes the
+// human readable form of the command.
+func writeStrategy(cmd *cobra.Command, s *strategy.StrategyResponse, table func(w io.Writer) error) error {
+	r := toStrategyRecord(s)
+	return writeResult(cmd, r, strategyColumns, [][]string{r.row()}, table)
+}
+
+// writeStrategies writes a list of strategies in the --output format; table
+// writes the human readable form of the command.
+func writeStrategies(cmd *cobra.Command, results []*strategy.StrategyResponse, table func(w io.Writer) error) error {
+	records, rows := toStrategyRecords(results)
+	return writeResult(cmd, records, strategyColumns, rows, table)
+}
+
+// toStrategyRecords converts a list of strategies for output, along with their
+// CSV rows.
+func toStrategyRecords(results []*strategy.StrategyResponse) ([]strategyRecord, [][]string) {
+	records := make([]strategyRecord, len(results))
+	rows := make([][]string, len(results))
+	for i, s := range results {
+		records[i] = toStrategyRecord(s)
+		rows[i] = records[i].row()
+	}
+	return records, rows
+}
+
+// deleteRecord is the result of strategy delete in the machine readable
+// output formats.
+type deleteRecord struct {
+	ID      string `json:"id" yaml:"id"`
+	Deleted bool   `json:"deleted" yaml:"deleted"`
+}
+
+// purgeRecord is the result of strategy purge in the machine readable output
+// formats.
+type purgeRecord struct {
+	Purged int64 `json:"purged" yaml:"purged"`
+}
+
+// exportRecord is the result of strategy export in the machine readable output
+// formats.
+type exportRecord struct {
+	File     string `json:"file" yaml:"file"`
+	Exported int    `json:"exported" yaml:"exported"`
+}
+
+// auditRecord is a change of a strategy in the machine readable output formats.
+type auditRecord struct {
+	Action    string         `json:"action" yaml:"action"`
+	Actor     string         `json:"actor" yaml:"actor"`
+	CreatedAt time.Time      `json:"created_at" yaml:"created_at"`
+	Changes   []changeRecord `json:"changes" yaml:"changes"`
+}
+
+// changeRecord is a field changed by a strategy change.
+type changeRecord struct {
+	Field string `json:"field" yaml:"field"`
+	From  string `json:"from" yaml:"from"`
+	To    string `json:"to" yaml:"to"`
+}
+
+// auditColumns is the CSV header of the rows of toAuditRecords.
+var auditColumns = []string{"created_at", "action", "actor", "field", "from", "to"}
+
+// toAuditRecords converts a strategy history for output. Its CSV form has one
+// row per changed field.
+func toAuditRecords(entries []*strategy.AuditEntryResponse) ([]auditRecord, [][]string) {
+	records := make([]auditRecord, len(entries))
+	var rows [][]string
+	for i, entry := range entries {
+		createdAt := entry.CreatedAt.UTC()
+		records[i] = auditRecord{
+			Action:    entry.Action,
+			Actor:     entry.Actor,
+			CreatedAt: createdAt,
+			Changes:   make([]changeRecord, len(entry.Changes)),
+		}
+		for j, change := range entry.Changes {
+			records[i].Changes[j] = changeRecord{Field: change.Field, From: change.From, To: change.To}
+			rows = append(rows, []string{createdAt.Format(time.RFC3339), entry.Action, entry.Actor, change.Field, change.From, change.To})
+		}
+		if len(entry.Changes) == 0 {
+			rows = append(rows, []string{createdAt.Format(time.RFC3339), entry.Action, entry.Actor, "", "", ""})
+		}
+	}
+	return records, rows
+}
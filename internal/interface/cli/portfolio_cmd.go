@@ -0,0 +1,73 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"transaction/internal/usecase/portfolio"
+	"transaction/pkg/logger"
+)
+
+var (
+	showPortfolioCmd *cobra.Command
+)
+
+// NewPortfolioCommand creates the root portfolio command with subcommands
+func NewPortfolioCommand(svc *portfolio.PortfolioService, log logger.Logger) *cobra.Command {
+	rootCmd := &cobra.Command{
+		Use:   "portfolio",
+		Short: "View holdings",
+		Long:  "Commands for viewing positions and profit and loss derived from recorded trades",
+	}
+
+	// Show command
+	showPortfolioCmd = &cobra.Command{
+		Use:   "show",
+		Short: "Show current positions",
+		Long:  "Display quantity, average cost, realized and unrealized PnL per symbol with a portfolio total",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			result, err := svc.GetPortfolio(cmd.Context())
+			if err != nil {
+				log.Error("Failed to build portfolio", "error", err.Error())
+				return err
+			}
+
+			if len(result.Positions) == 0 {
+				fmt.Println("No trades recorded")
+				return nil
+			}
+
+			fmt.Println("Portfolio:")
+			fmt.Println(strings.Repeat("-", 120))
+			fmt.Printf("%-10s %14s %14s %14s %14s %16s %16s %16s\n",
+				"Symbol", "Quantity", "Avg Cost", "Price", "Cost Basis", "Market Value", "Realized PnL", "Unrealized PnL")
+			fmt.Println(strings.Repeat("-", 120))
+			for _, p := range result.Positions {
+				price, marketValue, unrealized := "n/a", "n/a", "n/a"
+				if p.PriceAvailable {
+					price = p.MarketPrice.String()
+					marketValue = p.MarketValue.StringFixed(2)
+					unrealized = p.UnrealizedPnL.StringFixed(2)
+				} else if p.Quantity.IsZero() {
+					price, marketValue, unrealized = "-", "0.00", "0.00"
+				}
+				fmt.Printf("%-10s %14s %14s %14s %14s %16s %16s %16s\n",
+					p.Symbol, p.Quantity, p.AverageCost.StringFixed(2), price, p.CostBasis.StringFixed(2),
+					marketValue, p.RealizedPnL.StringFixed(2), unrealized)
+			}
+			fmt.Println(strings.Repeat("-", 120))
+			fmt.Printf("%-10s %14s %14s %14s %14s %16s %16s %16s\n",
+				"TOTAL", "", "", "", result.TotalCostBasis.StringFixed(2), result.TotalMarketValue.StringFixed(2),
+				result.TotalRealizedPnL.StringFixed(2), result.TotalUnrealizedPnL.StringFixed(2))
+			return nil
+		},
+	}
+
+	// Add subcommands to root command
+	rootCmd.AddCommand(
+		showPortfolioCmd,
+	)
+
+	return rootCmd
+}
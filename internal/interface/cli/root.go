@@ -1,32 +1,128 @@
 package cli
 
 import (
+	"context"
+
 	"github.com/spf13/cobra"
+	"transaction/internal/config"
+	"transaction/internal/usecase/feed"
+	"transaction/internal/usecase/market"
+	"transaction/internal/usecase/monitor"
+	"transaction/internal/usecase/notification"
+	"transaction/internal/usecase/portfolio"
+	"transaction/internal/usecase/schema"
+	"transaction/internal/usecase/signal"
 	"transaction/internal/usecase/strategy"
+	"transaction/internal/usecase/trade"
 	"transaction/pkg/logger"
 )
 
-// RootCommand is the root CLI command
+// RootCommand is the root CLI command. Services are nil when the command
+// being run does not need them, such as config.
 type RootCommand struct {
-	StrategyService *strategy.StrategyService
-	Logger          logger.Logger
+	StrategyService     *strategy.StrategyService
+	MarketService       *market.MarketService
+	SignalService       *signal.SignalService
+	NotificationService *notification.NotificationService
+	TradeService        *trade.TradeService
+	PortfolioService    *portfolio.PortfolioService
+	PriceMonitor        *monitor.PriceMonitor
+	LiveFeed            *feed.FeedService
+	SchemaService       *schema.SchemaService
+	Logger              logger.Logger
+}
+
+// Execute runs the CLI application. Cancelling ctx aborts the running command
+// and any database query or request it has in flight.
+func (r *RootCommand) Execute(ctx context.Context, args []string) error {
+	rootCmd := r.newCommand()
+	rootCmd.SetArgs(args)
+	return rootCmd.ExecuteContext(ctx)
+}
+
+// NeedsServices reports whether args run a command that uses the services, so
+// the database is only opened for those. Help, shell completion, config and
+// command lines that fail to parse need none.
+func (r *RootCommand) NeedsServices(args []string) bool {
+	rootCmd := r.newCommand()
+	rootCmd.InitDefaultHelpCmd()
+	rootCmd.InitDefaultCompletionCmd(args...)
+
+	cmd, flags, err := rootCmd.Find(args)
+	if err != nil || !cmd.Runnable() {
+		return false
+	}
+	top := cmd
+	for top.HasParent() && top.Parent().HasParent() {
+		top = top.Parent()
+	}
+	switch top.Name() {
+	case "help", "completion", "config":
+		return false
+	}
+
+	cmd.InitDefaultHelpFlag()
+	if err := cmd.ParseFlags(flags); err != nil {
+		return false
+	}
+	help, _ := cmd.Flags().GetBool("help")
+	return !help
 }
 
-// Execute runs the CLI application
-func (r *RootCommand) Execute(args []string) error {
+// newCommand builds the command tree on the services of r.
+func (r *RootCommand) newCommand() *cobra.Command {
 	rootCmd := &cobra.Command{
 		Use:   "strategy-cli",
 		Short: "Trading Strategy Management CLI",
 		Long:  "A command-line tool for managing cryptocurrency trading strategies",
 	}
 
+	// Global configuration flags, applied by main before the services are built
+	flags := &config.Flags{}
+	config.BindFlags(rootCmd.PersistentFlags(), flags)
+
+	// Global output format of the commands printing results
+	bindOutputFlag(rootCmd)
+
 	// Add strategy command
 	strategyCmd := NewStrategyCommand(r.StrategyService, r.Logger)
 	rootCmd.AddCommand(strategyCmd)
 
-	// Set args
-	rootCmd.SetArgs(args)
+	// Add symbols command
+	symbolsCmd := NewSymbolsCommand(r.MarketService, r.Logger)
+	rootCmd.AddCommand(symbolsCmd)
+
+	// Add signals command
+	signalsCmd := NewSignalsCommand(r.SignalService, r.Logger)
+	rootCmd.AddCommand(signalsCmd)
+
+	// Add trade command
+	tradeCmd := NewTradeCommand(r.TradeService, r.Logger)
+	rootCmd.AddCommand(tradeCmd)
+
+	// Add portfolio command
+	portfolioCmd := NewPortfolioCommand(r.PortfolioService, r.Logger)
+	rootCmd.AddCommand(portfolioCmd)
+
+	// Add notify command
+	notifyCmd := NewNotifyCommand(r.NotificationService, r.Logger)
+	rootCmd.AddCommand(notifyCmd)
+
+	// Add monitor command
+	monitorCmd := NewMonitorCommand(r.PriceMonitor, r.Logger)
+	rootCmd.AddCommand(monitorCmd)
+
+	// Add serve command
+	serveCmd := NewServeCommand(r.StrategyService, r.SignalService, r.PriceMonitor, r.LiveFeed, r.Logger)
+	rootCmd.AddCommand(serveCmd)
+
+	// Add db command
+	dbCmd := NewDBCommand(r.SchemaService, r.Logger)
+	rootCmd.AddCommand(dbCmd)
+
+	// Add config command
+	configCmd := NewConfigCommand(flags, r.Logger)
+	rootCmd.AddCommand(configCmd)
 
-	// Execute
-	return rootCmd.Execute()
+	return rootCmd
 }
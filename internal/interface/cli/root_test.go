@@ -0,0 +1,37 @@
+package cli
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"transaction/internal/testutil"
+)
+
+func TestNeedsServices(t *testing.T) {
+	tests := []struct {
+		args string
+		want bool
+	}{
+		{args: "strategy list", want: true},
+		{args: "--db other.db trade history -s BTC", want: true},
+		{args: "db migrate up", want: true},
+		{args: "", want: false},
+		{args: "--help", want: false},
+		{args: "help strategy", want: false},
+		{args: "strategy", want: false},
+		{args: "strategy list --help", want: false},
+		{args: "strategy list -h", want: false},
+		{args: "stratgy list", want: false},
+		{args: "strategy list --bogus", want: false},
+		{args: "completion bash", want: false},
+		{args: "config show", want: false},
+	}
+
+	root := &RootCommand{Logger: testutil.NewMockLogger()}
+	for _, tt := range tests {
+		t.Run(tt.args, func(t *testing.T) {
+			assert.Equal(t, tt.want, root.NeedsServices(strings.Fields(tt.args)))
+		})
+	}
+}
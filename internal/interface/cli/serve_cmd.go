@@ -0,0 +1,96 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/spf13/cobra"
+	grpcapi "transaction/internal/interface/grpc"
+	httpapi "transaction/internal/interface/http"
+	"transaction/internal/usecase/feed"
+	"transaction/internal/usecase/monitor"
+	signalusecase "transaction/internal/usecase/signal"
+	"transaction/internal/usecase/strategy"
+	"transaction/pkg/logger"
+)
+
+// NewServeCommand creates the serve command running the REST and gRPC APIs,
+// and optionally the price monitor feeding the live stream
+func NewServeCommand(svc *strategy.StrategyService, signalSvc *signalusecase.SignalService, priceMonitor *monitor.PriceMonitor, liveFeed *feed.FeedService, log logger.Logger) *cobra.Command {
+	serveCmd := &cobra.Command{
+		Use:   "serve",
+		Short: "Serve the REST and gRPC APIs",
+		Long:  "Expose strategy management over a JSON REST API under /api/v1 and, when --grpc-addr is set, over gRPC until interrupted. With --monitor the price monitor runs alongside and its prices and signals are streamed live at /api/v1/stream",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			addr, _ := cmd.Flags().GetString("addr")
+			grpcAddr, _ := cmd.Flags().GetString("grpc-addr")
+			runMonitor, _ := cmd.Flags().GetBool("monitor")
+
+			if svc == nil || signalSvc == nil {
+				return fmt.Errorf("services are not configured")
+			}
+			if runMonitor && (priceMonitor == nil || liveFeed == nil) {
+				return fmt.Errorf("price monitor is not configured")
+			}
+			if addr == "" && grpcAddr == "" {
+				return fmt.Errorf("at least one of --addr or --grpc-addr is required")
+			}
+
+			ctx, stop := signal.NotifyContext(cmd.Context(), os.Interrupt, syscall.SIGTERM)
+			defer stop()
+
+			// Run every enabled server; the first to fail stops the others
+			var servers []func(context.Context) error
+			var live *feed.FeedService
+			if runMonitor {
+				live = liveFeed
+				servers = append(servers, priceMonitor.Run)
+			}
+			if addr != "" {
+				servers = append(servers, func(ctx context.Context) error {
+					return httpapi.NewServer(svc, live, log).ListenAndServe(ctx, addr)
+				})
+			}
+			if grpcAddr != "" {
+				servers = append(servers, func(ctx context.Context) error {
+					return grpcapi.NewServer(ctx, svc, signalSvc, live, log).ListenAndServe(ctx, grpcAddr)
+				})
+			}
+
+			ctx, cancel := context.WithCancel(ctx)
+			defer cancel()
+			errCh := make(chan error, len(servers))
+			for _, serve := range servers {
+				go func(serve func(context.Context) error) {
+					err := serve(ctx)
+					cancel()
+					errCh <- err
+				}(serve)
+			}
+
+			var err error
+			for range servers {
+				if serveErr := <-errCh; serveErr != nil && !errors.Is(serveErr, context.Canceled) && err == nil {
+					err = serveErr
+				}
+			}
+			if err != nil {
+				log.Error("API server stopped unexpectedly", "error", err.Error())
+				return err
+			}
+
+			log.Info("API servers stopped")
+			return nil
+		},
+	}
+
+	serveCmd.Flags().String("addr", ":8080", "Address of the REST API, empty to disable it")
+	serveCmd.Flags().String("grpc-addr", "", "Address of the gRPC API (e.g. :9090), disabled when empty")
+	serveCmd.Flags().Bool("monitor", false, "Run the price monitor in the server and stream its prices and signals")
+
+	return serveCmd
+}
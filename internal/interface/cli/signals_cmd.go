@@ -0,0 +1,116 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"transaction/internal/usecase/signal"
+	"transaction/pkg/logger"
+)
+
+var (
+	listSignalsCmd *cobra.Command
+	ackSignalCmd   *cobra.Command
+)
+
+// NewSignalsCommand creates the root signals command with subcommands
+func NewSignalsCommand(svc *signal.SignalService, log logger.Logger) *cobra.Command {
+	rootCmd := &cobra.Command{
+		Use:   "signals",
+		Short: "Review triggered signals",
+		Long:  "Commands for auditing the buy and sell signals recorded by the price monitor",
+	}
+
+	// List command
+	listSignalsCmd = &cobra.Command{
+		Use:   "list",
+		Short: "List recorded signals",
+		Long:  "Display recorded signals, most recent first",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			strategyID, _ := cmd.Flags().GetString("strategy")
+			sinceRaw, _ := cmd.Flags().GetString("since")
+
+			req := &signal.ListSignalsRequest{StrategyID: strategyID}
+			if sinceRaw != "" {
+				since, err := parseSince(sinceRaw, time.Now())
+				if err != nil {
+					return err
+				}
+				req.Since = since
+			}
+
+			results, err := svc.ListSignals(cmd.Context(), req)
+			if err != nil {
+				log.Error("Failed to list signals", "error", err.Error())
+				return err
+			}
+
+			if len(results) == 0 {
+				fmt.Println("No signals found")
+				return nil
+			}
+
+			fmt.Println("Signals:")
+			fmt.Println(strings.Repeat("-", 100))
+			for _, s := range results {
+				status := "New"
+				if s.Acknowledged {
+					status = "Acknowledged"
+				}
+				fmt.Printf("ID: %s, Time: %s, Strategy: %s, Symbol: %s, Side: %s, Trigger: %s, Observed: %s, Status: %s\n",
+					s.ID, s.TriggeredAt.Local().Format(time.RFC3339), s.StrategyID, s.Symbol, s.Side,
+					s.TriggerPrice, s.ObservedPrice, status)
+			}
+			fmt.Println(strings.Repeat("-", 100))
+			return nil
+		},
+	}
+
+	listSignalsCmd.Flags().String("strategy", "", "Only show signals of this strategy ID")
+	listSignalsCmd.Flags().String("since", "", "Only show signals since a time (RFC3339, YYYY-MM-DD or a duration such as 24h)")
+
+	// Acknowledge command
+	ackSignalCmd = &cobra.Command{
+		Use:   "ack <signal-id>",
+		Short: "Acknowledge a signal",
+		Long:  "Mark a signal as reviewed",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			id := args[0]
+
+			_, err := svc.AcknowledgeSignal(cmd.Context(), id)
+			if err != nil {
+				log.Error("Failed to acknowledge signal", "error", err.Error())
+				return err
+			}
+
+			fmt.Printf("Signal %s acknowledged\n", id)
+			return nil
+		},
+	}
+
+	// Add subcommands to root command
+	rootCmd.AddCommand(
+		listSignalsCmd,
+		ackSignalCmd,
+	)
+
+	return rootCmd
+}
+
+// parseSince parses an absolute time (RFC3339 or YYYY-MM-DD in local time)
+// or a duration relative to now such as 24h or 90m.
+func parseSince(value string, now time.Time) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, nil
+	}
+	if t, err := time.ParseInLocation("2006-01-02", value, time.Local); err == nil {
+		return t, nil
+	}
+	if d, err := time.ParseDuration(value); err == nil && d >= 0 {
+		return now.Add(-d), nil
+	}
+	return time.Time{}, fmt.Errorf("invalid since value %q: use RFC3339, YYYY-MM-DD or a duration such as 24h", value)
+}
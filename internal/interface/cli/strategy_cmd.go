@@ -1,22 +1,32 @@
 package cli
 
 import (
+	"bufio"
 	"fmt"
+	"io"
 	"strconv"
 	"strings"
+	"time"
 
+	"github.com/shopspring/decimal"
 	"github.com/spf13/cobra"
+	"transaction/internal/domain"
 	"transaction/internal/usecase/strategy"
 	"transaction/pkg/logger"
 )
 
 var (
-	createStrategyCmd *cobra.Command
-	listStrategiesCmd *cobra.Command
-	getStrategyCmd    *cobra.Command
-	updateStrategyCmd *cobra.Command
-	deleteStrategyCmd *cobra.Command
-	toggleStrategyCmd *cobra.Command
+	createStrategyCmd  *cobra.Command
+	listStrategiesCmd  *cobra.Command
+	getStrategyCmd     *cobra.Command
+	updateStrategyCmd  *cobra.Command
+	deleteStrategyCmd  *cobra.Command
+	toggleStrategyCmd  *cobra.Command
+	historyStrategyCmd *cobra.Command
+	restoreStrategyCmd *cobra.Command
+	purgeStrategiesCmd *cobra.Command
+	exportStrategyCmd  *cobra.Command
+	importStrategyCmd  *cobra.Command
 )
 
 // NewStrategyCommand creates the root strategy command with subcommands
@@ -29,205 +39,345 @@ func NewStrategyCommand(svc *strategy.StrategyService, log logger.Logger) *cobra
 
 	// Create command
 	createStrategyCmd = &cobra.Command{
-		Use:   "create",
-		Short: "Create a new strategy",
-		Long:  "Create a new trading strategy with buy and sell price limits",
+		Use:         "create",
+		Short:       "Create a new strategy",
+		Long:        "Create a new trading strategy with buy and sell price limits",
+		Annotations: supportsOutput(),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			symbol, _ := cmd.Flags().GetString("symbol")
-			buyLower, _ := cmd.Flags().GetFloat64("buy-lower")
-			sellUpper, _ := cmd.Flags().GetFloat64("sell-upper")
+			buyLowerRaw, _ := cmd.Flags().GetString("buy-lower")
+			sellUpperRaw, _ := cmd.Flags().GetString("sell-upper")
 
 			if symbol == "" {
 				return fmt.Errorf("symbol is required")
 			}
 
+			buyLower, err := parseDecimal("buy-lower", buyLowerRaw)
+			if err != nil {
+				return err
+			}
+			sellUpper, err := parseDecimal("sell-upper", sellUpperRaw)
+			if err != nil {
+				return err
+			}
+
 			req := &strategy.CreateStrategyRequest{
 				Symbol:    symbol,
 				BuyLower:  buyLower,
 				SellUpper: sellUpper,
 			}
 
-			result, err := svc.CreateStrategy(req)
+			if cmd.Flags().Changed("cooldown") {
+				cooldown, _ := cmd.Flags().GetDuration("cooldown")
+				req.Cooldown = &cooldown
+			}
+
+			result, err := svc.CreateStrategy(cmd.Context(), req)
 			if err != nil {
 				log.Error("Failed to create strategy", "error", err.Error())
 				return err
 			}
 
 			log.Info("Strategy created successfully", "id", result.ID)
-			fmt.Printf("Created strategy: ID=%s, Symbol=%s, BuyLower=%.2f, SellUpper=%.2f, Active=%v\n",
-				result.ID, result.Symbol, result.BuyLower, result.SellUpper, result.IsActive)
-			return nil
+			return writeStrategy(cmd, result, func(w io.Writer) error {
+				_, err := fmt.Fprintf(w, "Created strategy: ID=%s, Symbol=%s, BuyLower=%s, SellUpper=%s, Cooldown=%s, Active=%v\n",
+					result.ID, result.Symbol, result.BuyLower, result.SellUpper, result.Cooldown, result.IsActive)
+				return err
+			})
 		},
 	}
 
-	createStrategyCmd.Flags().StringP("symbol", "s", "", "Symbol (e.g., BTC/USD)")
-	createStrategyCmd.Flags().Float64P("buy-lower", "b", 0, "Buy lower limit")
-	createStrategyCmd.Flags().Float64P("sell-upper", "u", 0, "Sell upper limit")
+	createStrategyCmd.Flags().StringP("symbol", "s", "", "Symbol (e.g., BTC/USDT)")
+	createStrategyCmd.Flags().StringP("buy-lower", "b", "", "Buy lower limit")
+	createStrategyCmd.Flags().StringP("sell-upper", "u", "", "Sell upper limit")
+	createStrategyCmd.Flags().Duration("cooldown", domain.DefaultCooldown, "Minimum time between two signals")
 	_ = createStrategyCmd.MarkFlagRequired("symbol")
 	_ = createStrategyCmd.MarkFlagRequired("buy-lower")
 	_ = createStrategyCmd.MarkFlagRequired("sell-upper")
 
 	// List command
 	listStrategiesCmd = &cobra.Command{
-		Use:   "list",
-		Short: "List all strategies",
-		Long:  "Display all existing trading strategies",
+		Use:         "list",
+		Short:       "List strategies",
+		Long:        "Display trading strategies with optional filters, sorting and pagination",
+		Annotations: supportsOutput(),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			log.Info("Listing all strategies")
+			symbol, _ := cmd.Flags().GetString("symbol")
+			sortBy, _ := cmd.Flags().GetString("sort")
+			descending, _ := cmd.Flags().GetBool("desc")
+			page, _ := cmd.Flags().GetInt("page")
+			pageSize, _ := cmd.Flags().GetInt("limit")
+			includeDeleted, _ := cmd.Flags().GetBool("include-deleted")
+
+			req := &strategy.ListStrategiesRequest{
+				Symbol:         symbol,
+				SortBy:         sortBy,
+				Descending:     descending,
+				Page:           page,
+				PageSize:       pageSize,
+				IncludeDeleted: includeDeleted,
+			}
+			if cmd.Flags().Changed("active") {
+				active, _ := cmd.Flags().GetBool("active")
+				req.Active = &active
+			}
 
-			results, err := svc.ListStrategies()
+			results, err := svc.ListStrategies(cmd.Context(), req)
 			if err != nil {
 				log.Error("Failed to list strategies", "error", err.Error())
 				return err
 			}
 
-			if len(results) == 0 {
-				fmt.Println("No strategies found")
-				return nil
-			}
+			return writeStrategies(cmd, results, func(w io.Writer) error {
+				if len(results) == 0 {
+					_, err := fmt.Fprintln(w, "No strategies found")
+					return err
+				}
 
-			fmt.Println("Strategies:")
-			fmt.Println(strings.Repeat("-", 100))
-			for _, s := range results {
-				status := "Active"
-				if !s.IsActive {
-					status = "Inactive"
+				fmt.Fprintln(w, "Strategies:")
+				fmt.Fprintln(w, strings.Repeat("-", 100))
+				for _, s := range results {
+					status := "Active"
+					if !s.IsActive {
+						status = "Inactive"
+					}
+					if s.DeletedAt != nil {
+						status = "Deleted " + s.DeletedAt.Local().Format(time.RFC3339)
+					}
+					fmt.Fprintf(w, "ID: %s, Symbol: %s, BuyLower: %s, SellUpper: %s, Cooldown: %s, Status: %s\n",
+						s.ID, s.Symbol, s.BuyLower, s.SellUpper, s.Cooldown, status)
 				}
-				fmt.Printf("ID: %s, Symbol: %s, BuyLower: %.2f, SellUpper: %.2f, Status: %s\n",
-					s.ID, s.Symbol, s.BuyLower, s.SellUpper, status)
-			}
-			fmt.Println(strings.Repeat("-", 100))
-			return nil
+				_, err := fmt.Fprintln(w, strings.Repeat("-", 100))
+				return err
+			})
 		},
 	}
 
+	listStrategiesCmd.Flags().StringP("symbol", "s", "", "Only list strategies of this symbol")
+	listStrategiesCmd.Flags().Bool("active", false, "Only list active strategies; --active=false lists inactive ones")
+	listStrategiesCmd.Flags().String("sort", "created_at", "Sort by created_at, symbol, buy_lower or sell_upper")
+	listStrategiesCmd.Flags().Bool("desc", false, "Sort in descending order")
+	listStrategiesCmd.Flags().Int("limit", 0, "Strategies per page (default all)")
+	listStrategiesCmd.Flags().Int("page", 1, "Page number, used with --limit")
+	listStrategiesCmd.Flags().Bool("include-deleted", false, "Also list deleted strategies that have not been purged")
+
 	// Get command
 	getStrategyCmd = &cobra.Command{
-		Use:   "get <strategy-id>",
-		Short: "Get strategy details",
-		Long:  "Display details of a specific strategy",
-		Args:  cobra.ExactArgs(1),
+		Use:         "get <strategy-id>",
+		Short:       "Get strategy details",
+		Long:        "Display details of a specific strategy",
+		Args:        cobra.ExactArgs(1),
+		Annotations: supportsOutput(),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			id := args[0]
 			log.Info("Fetching strategy", "id", id)
 
-			result, err := svc.GetStrategy(id)
+			result, err := svc.GetStrategy(cmd.Context(), id)
 			if err != nil {
 				log.Error("Failed to get strategy", "error", err.Error())
 				return err
 			}
 
-			status := "Active"
-			if !result.IsActive {
-				status = "Inactive"
-			}
-			fmt.Printf("Strategy Details:\n")
-			fmt.Printf("  ID: %s\n", result.ID)
-			fmt.Printf("  Symbol: %s\n", result.Symbol)
-			fmt.Printf("  Buy Lower: %.2f\n", result.BuyLower)
-			fmt.Printf("  Sell Upper: %.2f\n", result.SellUpper)
-			fmt.Printf("  Status: %s\n", status)
-			return nil
+			return writeStrategy(cmd, result, func(w io.Writer) error {
+				status := "Active"
+				if !result.IsActive {
+					status = "Inactive"
+				}
+				fmt.Fprintf(w, "Strategy Details:\n")
+				fmt.Fprintf(w, "  ID: %s\n", result.ID)
+				fmt.Fprintf(w, "  Symbol: %s\n", result.Symbol)
+				fmt.Fprintf(w, "  Buy Lower: %s\n", result.BuyLower)
+				fmt.Fprintf(w, "  Sell Upper: %s\n", result.SellUpper)
+				fmt.Fprintf(w, "  Cooldown: %s\n", result.Cooldown)
+				fmt.Fprintf(w, "  Status: %s\n", status)
+				_, err := fmt.Fprintf(w, "  Version: %d\n", result.Version)
+				return err
+			})
 		},
 	}
 
 	// Update command
 	updateStrategyCmd = &cobra.Command{
-		Use:   "update <strategy-id>",
-		Short: "Update strategy",
-		Long:  "Update an existing trading strategy",
-		Args:  cobra.ExactArgs(1),
+		Use:         "update <strategy-id>",
+		Short:       "Update strategy",
+		Long:        "Update an existing trading strategy",
+		Args:        cobra.ExactArgs(1),
+		Annotations: supportsOutput(),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			id := args[0]
-			buyLower, _ := cmd.Flags().GetString("buy-lower")
-			sellUpper, _ := cmd.Flags().GetString("sell-upper")
+			req := &strategy.UpdateStrategyRequest{ID: id}
 
-			if buyLower == "" && sellUpper == "" {
-				return fmt.Errorf("at least one of --buy-lower or --sell-upper is required")
+			// Only the flags that were given are sent; every other field keeps its current value
+			if cmd.Flags().Changed("symbol") {
+				symbol, _ := cmd.Flags().GetString("symbol")
+				req.Symbol = &symbol
 			}
 
-			// Fetch current strategy to get symbol
-			current, err := svc.GetStrategy(id)
-			if err != nil {
-				log.Error("Strategy not found", "id", id)
-				return err
+			if cmd.Flags().Changed("buy-lower") {
+				buyLower, _ := cmd.Flags().GetString("buy-lower")
+				val, err := parseDecimal("buy-lower", buyLower)
+				if err != nil {
+					return err
+				}
+				req.BuyLower = &val
 			}
 
-			buyLowerVal := current.BuyLower
-			sellUpperVal := current.SellUpper
-
-			if buyLower != "" {
-				val, err := strconv.ParseFloat(buyLower, 64)
+			if cmd.Flags().Changed("sell-upper") {
+				sellUpper, _ := cmd.Flags().GetString("sell-upper")
+				val, err := parseDecimal("sell-upper", sellUpper)
 				if err != nil {
-					return fmt.Errorf("invalid buy-lower value: %v", err)
+					return err
 				}
-				buyLowerVal = val
+				req.SellUpper = &val
 			}
 
-			if sellUpper != "" {
-				val, err := strconv.ParseFloat(sellUpper, 64)
+			if cmd.Flags().Changed("cooldown") {
+				cooldown, _ := cmd.Flags().GetString("cooldown")
+				val, err := time.ParseDuration(cooldown)
 				if err != nil {
-					return fmt.Errorf("invalid sell-upper value: %v", err)
+					return fmt.Errorf("invalid cooldown value: %v", err)
 				}
-				sellUpperVal = val
+				req.Cooldown = &val
 			}
 
-			req := &strategy.UpdateStrategyRequest{
-				ID:        id,
-				Symbol:    current.Symbol,
-				BuyLower:  buyLowerVal,
-				SellUpper: sellUpperVal,
+			if req.Symbol == nil && req.BuyLower == nil && req.SellUpper == nil && req.Cooldown == nil {
+				return fmt.Errorf("at least one of --symbol, --buy-lower, --sell-upper or --cooldown is required")
 			}
 
-			result, err := svc.UpdateStrategy(req)
+			if cmd.Flags().Changed("if-version") {
+				version, _ := cmd.Flags().GetInt("if-version")
+				req.ExpectedVersion = &version
+			}
+
+			result, err := svc.UpdateStrategy(cmd.Context(), req)
 			if err != nil {
 				log.Error("Failed to update strategy", "error", err.Error())
 				return err
 			}
 
 			log.Info("Strategy updated successfully", "id", id)
-			fmt.Printf("Updated strategy: ID=%s, BuyLower=%.2f, SellUpper=%.2f\n",
-				result.ID, result.BuyLower, result.SellUpper)
-			return nil
+			return writeStrategy(cmd, result, func(w io.Writer) error {
+				_, err := fmt.Fprintf(w, "Updated strategy: ID=%s, Symbol=%s, BuyLower=%s, SellUpper=%s, Cooldown=%s, Version=%d\n",
+					result.ID, result.Symbol, result.BuyLower, result.SellUpper, result.Cooldown, result.Version)
+				return err
+			})
 		},
 	}
 
+	updateStrategyCmd.Flags().StringP("symbol", "s", "", "Move the strategy to another symbol")
 	updateStrategyCmd.Flags().StringP("buy-lower", "b", "", "Buy lower limit")
 	updateStrategyCmd.Flags().StringP("sell-upper", "u", "", "Sell upper limit")
+	updateStrategyCmd.Flags().String("cooldown", "", "Minimum time between two signals (e.g. 10m, 1h)")
+	updateStrategyCmd.Flags().Int("if-version", 0, "Only update if the strategy is still at this version")
 
 	// Delete command
 	deleteStrategyCmd = &cobra.Command{
-		Use:   "delete <strategy-id>",
-		Short: "Delete strategy",
-		Long:  "Delete an existing trading strategy",
-		Args:  cobra.ExactArgs(1),
+		Use:         "delete <strategy-id>",
+		Short:       "Delete strategy",
+		Long:        "Delete an existing trading strategy. It can be restored until it is purged",
+		Args:        cobra.ExactArgs(1),
+		Annotations: supportsOutput(),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			id := args[0]
+			yes, _ := cmd.Flags().GetBool("yes")
+
+			if !yes {
+				current, err := svc.GetStrategy(cmd.Context(), id)
+				if err != nil {
+					log.Error("Strategy not found", "id", id)
+					return err
+				}
+				question := fmt.Sprintf("Delete strategy %s (%s, BuyLower=%s, SellUpper=%s)?",
+					current.ID, current.Symbol, current.BuyLower, current.SellUpper)
+				if !confirm(cmd, question) {
+					fmt.Fprintln(cmd.ErrOrStderr(), "Aborted")
+					return nil
+				}
+			}
+
 			log.Info("Deleting strategy", "id", id)
 
-			err := svc.DeleteStrategy(id)
+			err := svc.DeleteStrategy(cmd.Context(), id)
 			if err != nil {
 				log.Error("Failed to delete strategy", "error", err.Error())
 				return err
 			}
 
 			log.Info("Strategy deleted successfully", "id", id)
-			fmt.Printf("Strategy %s deleted\n", id)
-			return nil
+			deleted := deleteRecord{ID: id, Deleted: true}
+			return writeResult(cmd, deleted, []string{"id", "deleted"}, [][]string{{id, "true"}}, func(w io.Writer) error {
+				_, err := fmt.Fprintf(w, "Strategy %s deleted\n", id)
+				return err
+			})
+		},
+	}
+
+	deleteStrategyCmd.Flags().BoolP("yes", "y", false, "Delete without asking for confirmation")
+
+	// Restore command
+	restoreStrategyCmd = &cobra.Command{
+		Use:         "restore <strategy-id>",
+		Short:       "Restore a deleted strategy",
+		Long:        "Bring back a deleted strategy that has not been purged yet",
+		Args:        cobra.ExactArgs(1),
+		Annotations: supportsOutput(),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			result, err := svc.RestoreStrategy(cmd.Context(), args[0])
+			if err != nil {
+				log.Error("Failed to restore strategy", "error", err.Error())
+				return err
+			}
+
+			log.Info("Strategy restored successfully", "id", result.ID)
+			return writeStrategy(cmd, result, func(w io.Writer) error {
+				_, err := fmt.Fprintf(w, "Strategy %s restored\n", result.ID)
+				return err
+			})
+		},
+	}
+
+	// Purge command
+	purgeStrategiesCmd = &cobra.Command{
+		Use:         "purge",
+		Short:       "Permanently remove deleted strategies",
+		Long:        "Permanently remove strategies deleted longer ago than --older-than. Their change history is kept",
+		Annotations: supportsOutput(),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			olderThanRaw, _ := cmd.Flags().GetString("older-than")
+
+			olderThan, err := parseAge(olderThanRaw)
+			if err != nil {
+				return err
+			}
+
+			purged, err := svc.PurgeStrategies(cmd.Context(), olderThan)
+			if err != nil {
+				log.Error("Failed to purge strategies", "error", err.Error())
+				return err
+			}
+
+			count := strconv.FormatInt(purged, 10)
+			return writeResult(cmd, purgeRecord{Purged: purged}, []string{"purged"}, [][]string{{count}}, func(w io.Writer) error {
+				_, err := fmt.Fprintf(w, "Purged %d deleted strategies\n", purged)
+				return err
+			})
 		},
 	}
 
+	purgeStrategiesCmd.Flags().String("older-than", "30d", "Only purge strategies deleted longer ago than this (e.g. 30d, 12h)")
+
 	// Toggle command
 	toggleStrategyCmd = &cobra.Command{
-		Use:   "toggle <strategy-id>",
-		Short: "Toggle strategy status",
-		Long:  "Enable or disable a trading strategy",
-		Args:  cobra.ExactArgs(1),
+		Use:         "toggle <strategy-id>",
+		Short:       "Toggle strategy status",
+		Long:        "Enable or disable a trading strategy",
+		Args:        cobra.ExactArgs(1),
+		Annotations: supportsOutput(),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			id := args[0]
 			log.Info("Toggling strategy status", "id", id)
 
-			result, err := svc.ToggleStrategy(id)
+			result, err := svc.ToggleStrategy(cmd.Context(), id)
 			if err != nil {
 				log.Error("Failed to toggle strategy", "error", err.Error())
 				return err
@@ -238,11 +388,138 @@ func NewStrategyCommand(svc *strategy.StrategyService, log logger.Logger) *cobra
 				status = "Inactive"
 			}
 			log.Info("Strategy status toggled", "id", id, "status", status)
-			fmt.Printf("Strategy %s is now %s\n", id, status)
+			return writeStrategy(cmd, result, func(w io.Writer) error {
+				_, err := fmt.Fprintf(w, "Strategy %s is now %s\n", id, status)
+				return err
+			})
+		},
+	}
+
+	// History command
+	historyStrategyCmd = &cobra.Command{
+		Use:         "history <strategy-id>",
+		Short:       "Show strategy change history",
+		Long:        "Display who changed a strategy, when, and which fields changed, oldest change first",
+		Args:        cobra.ExactArgs(1),
+		Annotations: supportsOutput(),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			results, err := svc.GetStrategyHistory(cmd.Context(), args[0])
+			if err != nil {
+				log.Error("Failed to get strategy history", "error", err.Error())
+				return err
+			}
+
+			records, rows := toAuditRecords(results)
+			return writeResult(cmd, records, auditColumns, rows, func(w io.Writer) error {
+				fmt.Fprintf(w, "History of strategy %s:\n", args[0])
+				fmt.Fprintln(w, strings.Repeat("-", 100))
+				for _, entry := range results {
+					fmt.Fprintf(w, "%s  %-7s by %s\n", entry.CreatedAt.Local().Format(time.RFC3339), entry.Action, entry.Actor)
+					for _, change := range entry.Changes {
+						switch {
+						case change.From == "":
+							fmt.Fprintf(w, "    %s: %s\n", change.Field, change.To)
+						case change.To == "":
+							fmt.Fprintf(w, "    %s: %s (removed)\n", change.Field, change.From)
+						default:
+							fmt.Fprintf(w, "    %s: %s -> %s\n", change.Field, change.From, change.To)
+						}
+					}
+				}
+				_, err := fmt.Fprintln(w, strings.Repeat("-", 100))
+				return err
+			})
+		},
+	}
+
+	// Export command
+	exportStrategyCmd = &cobra.Command{
+		Use:         "export",
+		Short:       "Export strategies to a file",
+		Long:        "Write strategies to a YAML, JSON or CSV file, chosen by its extension, that strategy import can read back",
+		Annotations: supportsOutput(),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			file, _ := cmd.Flags().GetString("file")
+			symbol, _ := cmd.Flags().GetString("symbol")
+
+			if _, err := fileFormat(file); err != nil {
+				return err
+			}
+
+			results, err := svc.ListStrategies(cmd.Context(), &strategy.ListStrategiesRequest{Symbol: symbol})
+			if err != nil {
+				log.Error("Failed to list strategies", "error", err.Error())
+				return err
+			}
+			if err := writeStrategyFile(file, results); err != nil {
+				log.Error("Failed to export strategies", "error", err.Error())
+				return err
+			}
+
+			log.Info("Strategies exported successfully", "file", file, "count", len(results))
+			exported := exportRecord{File: file, Exported: len(results)}
+			return writeResult(cmd, exported, []string{"file", "exported"}, [][]string{{file, strconv.Itoa(len(results))}}, func(w io.Writer) error {
+				_, err := fmt.Fprintf(w, "Exported %d strategies to %s\n", len(results), file)
+				return err
+			})
+		},
+	}
+
+	exportStrategyCmd.Flags().StringP("file", "f", "", "File to write: .yaml, .yml, .json or .csv")
+	exportStrategyCmd.Flags().StringP("symbol", "s", "", "Only export strategies of this symbol")
+	_ = exportStrategyCmd.MarkFlagRequired("file")
+
+	// Import command
+	importStrategyCmd = &cobra.Command{
+		Use:   "import",
+		Short: "Import strategies from a file",
+		Long: "Create strategies from a YAML, JSON or CSV file, chosen by its extension. Every row is validated first " +
+			"and the file is imported in a single transaction: if any row is invalid, nothing is imported. " +
+			"A row updating a strategy with a version is rejected if the strategy has changed since that version",
+		Annotations: supportsOutput(),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			file, _ := cmd.Flags().GetString("file")
+			dryRun, _ := cmd.Flags().GetBool("dry-run")
+			upsert, _ := cmd.Flags().GetBool("upsert")
+
+			rows, rowErrs, err := readStrategyFile(file)
+			if err != nil {
+				return err
+			}
+
+			// Rows that could not be read are reported along with the others,
+			// which are then only checked
+			req := &strategy.ImportStrategiesRequest{Rows: rows, DryRun: dryRun || len(rowErrs) > 0, Upsert: upsert}
+			result, err := svc.ImportStrategies(cmd.Context(), req)
+			if err != nil {
+				log.Error("Failed to import strategies", "error", err.Error())
+				return err
+			}
+			rowErrs = append(rowErrs, result.Errors...)
+
+			records, reportRows := toImportReport(result.Results, rowErrs)
+			err = writeResult(cmd, records, importReportColumns, reportRows, func(w io.Writer) error {
+				return writeImportTable(w, records, dryRun)
+			})
+			if err != nil {
+				return err
+			}
+
+			if len(rowErrs) > 0 {
+				return fmt.Errorf("%d of %d rows are invalid: nothing was imported", len(rowErrs), len(records))
+			}
+			if !dryRun {
+				log.Info("Strategies imported successfully", "file", file, "rows", len(records))
+			}
 			return nil
 		},
 	}
 
+	importStrategyCmd.Flags().StringP("file", "f", "", "File to read: .yaml, .yml, .json or .csv")
+	importStrategyCmd.Flags().Bool("dry-run", false, "Validate the file and report what would change without saving anything")
+	importStrategyCmd.Flags().Bool("upsert", false, "Update the strategies whose id already exists instead of rejecting their row")
+	_ = importStrategyCmd.MarkFlagRequired("file")
+
 	// Add subcommands to root command
 	rootCmd.AddCommand(
 		createStrategyCmd,
@@ -251,7 +528,80 @@ func NewStrategyCommand(svc *strategy.StrategyService, log logger.Logger) *cobra
 		updateStrategyCmd,
 		deleteStrategyCmd,
 		toggleStrategyCmd,
+		historyStrategyCmd,
+		restoreStrategyCmd,
+		purgeStrategiesCmd,
+		exportStrategyCmd,
+		importStrategyCmd,
 	)
 
 	return rootCmd
 }
+
+// writeImportTable writes the outcome of every row of an import as a table
+// followed by a summary.
+func writeImportTable(w io.Writer, records []importReportRecord, dryRun bool) error {
+	if len(records) == 0 {
+		_, err := fmt.Fprintln(w, "No strategies found in the file")
+		return err
+	}
+
+	counts := make(map[string]int)
+	fmt.Fprintf(w, "%-5s %-10s %-36s %-12s %s\n", "ROW", "RESULT", "ID", "SYMBOL", "ERROR")
+	fmt.Fprintln(w, strings.Repeat("-", 100))
+	for _, r := range records {
+		counts[r.Result]++
+		fmt.Fprintf(w, "%-5d %-10s %-36s %-12s %s\n", r.Row, r.Result, r.ID, r.Symbol, r.Error)
+	}
+	fmt.Fprintln(w, strings.Repeat("-", 100))
+
+	switch {
+	case counts[importInvalid] > 0:
+		_, err := fmt.Fprintf(w, "%d invalid rows: nothing was imported\n", counts[importInvalid])
+		return err
+	case dryRun:
+		_, err := fmt.Fprintf(w, "Dry run: would create %d, update %d, leave %d unchanged\n",
+			counts[strategy.ImportCreated], counts[strategy.ImportUpdated], counts[strategy.ImportUnchanged])
+		return err
+	default:
+		_, err := fmt.Fprintf(w, "Created %d, updated %d, left %d unchanged\n",
+			counts[strategy.ImportCreated], counts[strategy.ImportUpdated], counts[strategy.ImportUnchanged])
+		return err
+	}
+}
+
+// confirm asks a yes/no question on the command input and reports whether the
+// user answered yes. Anything else, including end of input, counts as no. The
+// question goes to stderr so it does not mix with the command output.
+func confirm(cmd *cobra.Command, question string) bool {
+	fmt.Fprintf(cmd.ErrOrStderr(), "%s [y/N]: ", question)
+	answer, _ := bufio.NewReader(cmd.InOrStdin()).ReadString('\n')
+	switch strings.ToLower(strings.TrimSpace(answer)) {
+	case "y", "yes":
+		return true
+	default:
+		return false
+	}
+}
+
+// parseAge parses a non-negative age written as a Go duration (e.g. 12h) or
+// as a whole number of days (e.g. 30d).
+func parseAge(value string) (time.Duration, error) {
+	if days, ok := strings.CutSuffix(value, "d"); ok {
+		if n, err := strconv.Atoi(days); err == nil && n >= 0 {
+			return time.Duration(n) * 24 * time.Hour, nil
+		}
+	} else if d, err := time.ParseDuration(value); err == nil && d >= 0 {
+		return d, nil
+	}
+	return 0, fmt.Errorf("invalid age %q: use days such as 30d or a duration such as 12h", value)
+}
+
+// parseDecimal parses a decimal flag value exactly, naming the flag on error.
+func parseDecimal(flag, value string) (decimal.Decimal, error) {
+	d, err := decimal.NewFromString(strings.TrimSpace(value))
+	if err != nil {
+		return decimal.Decimal{}, fmt.Errorf("invalid %s value %q: must be a decimal number", flag, value)
+	}
+	return d, nil
+}
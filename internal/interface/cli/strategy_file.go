@@ -0,0 +1,335 @@
+package cli
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+	"transaction/internal/domain"
+	"transaction/internal/usecase/strategy"
+)
+
+// importFields are the fields of an imported strategy. The others written by
+// strategy export, listed in ignoredImportFields, are accepted and ignored so
+// an exported file can be imported as is.
+var (
+	importFields        = []string{"id", "symbol", "buy_lower", "sell_upper", "cooldown", "is_active", "version"}
+	ignoredImportFields = []string{"created_at", "deleted_at"}
+)
+
+// fileFormat returns the format of a strategy file from its extension.
+func fileFormat(path string) (string, error) {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml":
+		return outputYAML, nil
+	case ".json":
+		return outputJSON, nil
+	case ".csv":
+		return outputCSV, nil
+	default:
+		return "", fmt.Errorf("cannot tell the format of %s: use a .yaml, .yml, .json or .csv file", path)
+	}
+}
+
+// writeStrategyFile writes strategies to path in the format of its extension.
+func writeStrategyFile(path string, results []*strategy.StrategyResponse) error {
+	format, err := fileFormat(path)
+	if err != nil {
+		return err
+	}
+
+	f, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("failed to create export file: %v", err)
+	}
+	records, rows := toStrategyRecords(results)
+	if err := encode(f, format, records, strategyColumns, rows); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// readStrategyFile reads the strategies of an import file in the format of its
+// extension. A row that cannot be read is reported as a row error so the
+// others are still checked; a file that cannot be read at all is an error.
+//
+// Rows of a YAML or JSON file are numbered from 1 in the order of the list,
+// and rows of a CSV file by their line, the header being line 1.
+func readStrategyFile(path string) ([]*strategy.ImportStrategyRow, []*strategy.ImportRowError, error) {
+	format, err := fileFormat(path)
+	if err != nil {
+		return nil, nil, err
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to read import file: %v", err)
+	}
+
+	switch format {
+	case outputYAML:
+		return readStrategyYAML(data)
+	case outputJSON:
+		return readStrategyJSON(data)
+	default:
+		return readStrategyCSV(data)
+	}
+}
+
+// cell is a scalar field of an imported strategy, kept as written so that a
+// malformed value is reported by the validation of its row.
+type cell string
+
+// UnmarshalJSON accepts strings, numbers, booleans and null.
+func (c *cell) UnmarshalJSON(data []byte) error {
+	switch {
+	case bytes.Equal(data, []byte("null")):
+		*c = ""
+	case len(data) > 0 && data[0] == '"':
+		var s string
+		if err := json.Unmarshal(data, &s); err != nil {
+			return err
+		}
+		*c = cell(s)
+	case len(data) > 0 && (data[0] == '{' || data[0] == '['):
+		return errors.New("expected a single value")
+	default:
+		*c = cell(data)
+	}
+	return nil
+}
+
+// UnmarshalYAML accepts any scalar.
+func (c *cell) UnmarshalYAML(value *yaml.Node) error {
+	if value.Kind != yaml.ScalarNode {
+		return fmt.Errorf("line %d: expected a single value", value.Line)
+	}
+	if value.Tag == "!!null" {
+		*c = ""
+		return nil
+	}
+	*c = cell(value.Value)
+	return nil
+}
+
+// importRecord is a strategy of a YAML or JSON import file.
+type importRecord struct {
+	ID        cell `json:"id" yaml:"id"`
+	Symbol    cell `json:"symbol" yaml:"symbol"`
+	BuyLower  cell `json:"buy_lower" yaml:"buy_lower"`
+	SellUpper cell `json:"sell_upper" yaml:"sell_upper"`
+	Cooldown  cell `json:"cooldown" yaml:"cooldown"`
+	IsActive  cell `json:"is_active" yaml:"is_active"`
+	Version   cell `json:"version" yaml:"version"`
+	CreatedAt cell `json:"created_at" yaml:"created_at"`
+	DeletedAt cell `json:"deleted_at" yaml:"deleted_at"`
+}
+
+// toRow converts the record to the row numbered row.
+func (r *importRecord) toRow(row int) *strategy.ImportStrategyRow {
+	return &strategy.ImportStrategyRow{
+		Row:       row,
+		ID:        string(r.ID),
+		Symbol:    string(r.Symbol),
+		BuyLower:  string(r.BuyLower),
+		SellUpper: string(r.SellUpper),
+		Cooldown:  string(r.Cooldown),
+		Active:    string(r.IsActive),
+		Version:   string(r.Version),
+	}
+}
+
+// readStrategyYAML reads a YAML list of strategies.
+func readStrategyYAML(data []byte) ([]*strategy.ImportStrategyRow, []*strategy.ImportRowError, error) {
+	var items []yaml.Node
+	if err := yaml.Unmarshal(data, &items); err != nil {
+		return nil, nil, fmt.Errorf("import file is not a YAML list of strategies: %v", err)
+	}
+
+	var rows []*strategy.ImportStrategyRow
+	var rowErrs []*strategy.ImportRowError
+	for i := range items {
+		item := &items[i]
+		var record importRecord
+		err := checkYAMLFields(item)
+		if err == nil {
+			err = item.Decode(&record)
+		}
+		if err != nil {
+			rowErrs = append(rowErrs, &strategy.ImportRowError{Row: i + 1, Err: domain.Invalid(err)})
+			continue
+		}
+		rows = append(rows, record.toRow(i+1))
+	}
+	return rows, rowErrs, nil
+}
+
+// checkYAMLFields rejects a strategy that is not a mapping or has unknown
+// fields, which yaml.Node.Decode would otherwise ignore.
+func checkYAMLFields(item *yaml.Node) error {
+	if item.Kind != yaml.MappingNode {
+		return fmt.Errorf("line %d: expected a strategy with fields %s", item.Line, strings.Join(importFields, ", "))
+	}
+	for i := 0; i < len(item.Content); i += 2 {
+		key := item.Content[i]
+		if !isImportField(key.Value) {
+			return fmt.Errorf("line %d: unknown field %q", key.Line, key.Value)
+		}
+	}
+	return nil
+}
+
+// readStrategyJSON reads a JSON array of strategies.
+func readStrategyJSON(data []byte) ([]*strategy.ImportStrategyRow, []*strategy.ImportRowError, error) {
+	var items []json.RawMessage
+	if err := json.Unmarshal(data, &items); err != nil {
+		return nil, nil, fmt.Errorf("import file is not a JSON array of strategies: %v", err)
+	}
+
+	var rows []*strategy.ImportStrategyRow
+	var rowErrs []*strategy.ImportRowError
+	for i, item := range items {
+		var record importRecord
+		dec := json.NewDecoder(bytes.NewReader(item))
+		dec.DisallowUnknownFields()
+		if err := dec.Decode(&record); err != nil {
+			rowErrs = append(rowErrs, &strategy.ImportRowError{Row: i + 1, Err: domain.Invalid(err)})
+			continue
+		}
+		rows = append(rows, record.toRow(i+1))
+	}
+	return rows, rowErrs, nil
+}
+
+// readStrategyCSV reads strategies from CSV with a header naming the columns.
+// Column names are case insensitive and may come in any order.
+func readStrategyCSV(data []byte) ([]*strategy.ImportStrategyRow, []*strategy.ImportRowError, error) {
+	r := csv.NewReader(bytes.NewReader(data))
+	r.FieldsPerRecord = -1
+	r.TrimLeadingSpace = true
+
+	header, err := r.Read()
+	if errors.Is(err, io.EOF) {
+		return nil, nil, fmt.Errorf("import file is empty: expected a header with columns %s", strings.Join(importFields, ", "))
+	}
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to read import file: %v", err)
+	}
+
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if !isImportField(name) {
+			return nil, nil, fmt.Errorf("unknown column %q: use %s", name, strings.Join(importFields, ", "))
+		}
+		if _, ok := columns[name]; ok {
+			return nil, nil, fmt.Errorf("column %q appears twice", name)
+		}
+		columns[name] = i
+	}
+	for _, name := range []string{"symbol", "buy_lower", "sell_upper"} {
+		if _, ok := columns[name]; !ok {
+			return nil, nil, fmt.Errorf("missing column %q", name)
+		}
+	}
+
+	var rows []*strategy.ImportStrategyRow
+	var rowErrs []*strategy.ImportRowError
+	for {
+		fields, err := r.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to read import file: %v", err)
+		}
+		line, _ := r.FieldPos(0)
+		if len(fields) != len(header) {
+			err := fmt.Errorf("has %d fields but the header has %d", len(fields), len(header))
+			rowErrs = append(rowErrs, &strategy.ImportRowError{Row: line, Err: domain.Invalid(err)})
+			continue
+		}
+
+		get := func(name string) string {
+			if i, ok := columns[name]; ok {
+				return fields[i]
+			}
+			return ""
+		}
+		rows = append(rows, &strategy.ImportStrategyRow{
+			Row:       line,
+			ID:        get("id"),
+			Symbol:    get("symbol"),
+			BuyLower:  get("buy_lower"),
+			SellUpper: get("sell_upper"),
+			Cooldown:  get("cooldown"),
+			Active:    get("is_active"),
+			Version:   get("version"),
+		})
+	}
+	return rows, rowErrs, nil
+}
+
+// isImportField reports whether name is a field of an import file.
+func isImportField(name string) bool {
+	for _, field := range importFields {
+		if name == field {
+			return true
+		}
+	}
+	for _, field := range ignoredImportFields {
+		if name == field {
+			return true
+		}
+	}
+	return false
+}
+
+// importReportRecord is the outcome of a row of strategy import in the
+// machine readable output formats.
+type importReportRecord struct {
+	Row    int    `json:"row" yaml:"row"`
+	Result string `json:"result" yaml:"result"`
+	ID     string `json:"id,omitempty" yaml:"id,omitempty"`
+	Symbol string `json:"symbol,omitempty" yaml:"symbol,omitempty"`
+	Error  string `json:"error,omitempty" yaml:"error,omitempty"`
+}
+
+// importInvalid is the result of a row that cannot be imported.
+const importInvalid = "INVALID"
+
+// importReportColumns is the CSV header of the rows of toImportReport.
+var importReportColumns = []string{"row", "result", "id", "symbol", "error"}
+
+// toImportReport lists the outcome of every row of an import in the order of
+// the file, along with their CSV rows.
+func toImportReport(results []*strategy.ImportRowResult, rowErrs []*strategy.ImportRowError) ([]importReportRecord, [][]string) {
+	records := make([]importReportRecord, 0, len(results)+len(rowErrs))
+	for _, result := range results {
+		records = append(records, importReportRecord{
+			Row:    result.Row,
+			Result: result.Action,
+			ID:     result.Strategy.ID,
+			Symbol: result.Strategy.Symbol,
+		})
+	}
+	for _, rowErr := range rowErrs {
+		records = append(records, importReportRecord{Row: rowErr.Row, Result: importInvalid, Error: rowErr.Err.Error()})
+	}
+	sort.SliceStable(records, func(i, j int) bool { return records[i].Row < records[j].Row })
+
+	rows := make([][]string, len(records))
+	for i, r := range records {
+		rows[i] = []string{strconv.Itoa(r.Row), r.Result, r.ID, r.Symbol, r.Error}
+	}
+	return records, rows
+}
@@ -0,0 +1,153 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"transaction/internal/usecase/market"
+	"transaction/pkg/logger"
+)
+
+var (
+	listSymbolsCmd   *cobra.Command
+	addSymbolCmd     *cobra.Command
+	disableSymbolCmd *cobra.Command
+	enableSymbolCmd  *cobra.Command
+)
+
+// NewSymbolsCommand creates the root symbols command with subcommands
+func NewSymbolsCommand(svc *market.MarketService, log logger.Logger) *cobra.Command {
+	rootCmd := &cobra.Command{
+		Use:   "symbols",
+		Short: "Manage tradable symbols",
+		Long:  "Commands for managing the registry of trading pairs strategies may be created for",
+	}
+
+	// List command
+	listSymbolsCmd = &cobra.Command{
+		Use:   "list",
+		Short: "List registered symbols",
+		Long:  "Display every registered trading pair with its tick size, lot size and minimum notional",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			results, err := svc.ListMarkets(cmd.Context())
+			if err != nil {
+				log.Error("Failed to list symbols", "error", err.Error())
+				return err
+			}
+
+			if len(results) == 0 {
+				fmt.Println("No symbols registered")
+				return nil
+			}
+
+			fmt.Println("Symbols:")
+			fmt.Println(strings.Repeat("-", 80))
+			fmt.Printf("%-12s %14s %14s %14s %10s\n", "Symbol", "Tick Size", "Lot Size", "Min Notional", "Status")
+			fmt.Println(strings.Repeat("-", 80))
+			for _, m := range results {
+				status := "Enabled"
+				if !m.Enabled {
+					status = "Disabled"
+				}
+				fmt.Printf("%-12s %14s %14s %14s %10s\n", m.Symbol, m.TickSize, m.LotSize, m.MinNotional, status)
+			}
+			fmt.Println(strings.Repeat("-", 80))
+			return nil
+		},
+	}
+
+	// Add command
+	addSymbolCmd = &cobra.Command{
+		Use:   "add <base/quote>",
+		Short: "Register a symbol",
+		Long:  "Register a new trading pair with its trading rules",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			tickSizeRaw, _ := cmd.Flags().GetString("tick-size")
+			lotSizeRaw, _ := cmd.Flags().GetString("lot-size")
+			minNotionalRaw, _ := cmd.Flags().GetString("min-notional")
+
+			tickSize, err := parseDecimal("tick-size", tickSizeRaw)
+			if err != nil {
+				return err
+			}
+			lotSize, err := parseDecimal("lot-size", lotSizeRaw)
+			if err != nil {
+				return err
+			}
+			minNotional, err := parseDecimal("min-notional", minNotionalRaw)
+			if err != nil {
+				return err
+			}
+
+			result, err := svc.AddMarket(cmd.Context(), &market.AddMarketRequest{
+				Symbol:      args[0],
+				TickSize:    tickSize,
+				LotSize:     lotSize,
+				MinNotional: minNotional,
+			})
+			if err != nil {
+				log.Error("Failed to add symbol", "error", err.Error())
+				return err
+			}
+
+			log.Info("Symbol added successfully", "symbol", result.Symbol)
+			fmt.Printf("Added symbol: %s, TickSize=%s, LotSize=%s, MinNotional=%s\n",
+				result.Symbol, result.TickSize, result.LotSize, result.MinNotional)
+			return nil
+		},
+	}
+
+	addSymbolCmd.Flags().String("tick-size", "", "Smallest price increment (e.g. 0.01)")
+	addSymbolCmd.Flags().String("lot-size", "", "Smallest quantity increment (e.g. 0.0001)")
+	addSymbolCmd.Flags().String("min-notional", "0", "Smallest order value in the quote asset")
+	_ = addSymbolCmd.MarkFlagRequired("tick-size")
+	_ = addSymbolCmd.MarkFlagRequired("lot-size")
+
+	// Disable command
+	disableSymbolCmd = &cobra.Command{
+		Use:   "disable <symbol>",
+		Short: "Disable a symbol",
+		Long:  "Stop strategies from being created for or moved to a symbol; existing strategies keep running",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			result, err := svc.DisableMarket(cmd.Context(), args[0])
+			if err != nil {
+				log.Error("Failed to disable symbol", "error", err.Error())
+				return err
+			}
+
+			fmt.Printf("Symbol %s disabled\n", result.Symbol)
+			return nil
+		},
+	}
+
+	// Enable command
+	enableSymbolCmd = &cobra.Command{
+		Use:   "enable <symbol>",
+		Short: "Enable a symbol",
+		Long:  "Allow strategies to use a previously disabled symbol again",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			result, err := svc.EnableMarket(cmd.Context(), args[0])
+			if err != nil {
+				log.Error("Failed to enable symbol", "error", err.Error())
+				return err
+			}
+
+			fmt.Printf("Symbol %s enabled\n", result.Symbol)
+			return nil
+		},
+	}
+
+	// Add subcommands to root command
+	rootCmd.AddCommand(
+		listSymbolsCmd,
+		addSymbolCmd,
+		disableSymbolCmd,
+		enableSymbolCmd,
+	)
+
+	return rootCmd
+}
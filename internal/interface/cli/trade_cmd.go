@@ -0,0 +1,334 @@
+package cli
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+	"transaction/internal/domain"
+	"transaction/internal/usecase/trade"
+	"transaction/pkg/logger"
+)
+
+var (
+	buyTradeCmd     *cobra.Command
+	sellTradeCmd    *cobra.Command
+	historyTradeCmd *cobra.Command
+)
+
+// NewTradeCommand creates the root trade command with subcommands
+func NewTradeCommand(svc *trade.TradeService, log logger.Logger) *cobra.Command {
+	rootCmd := &cobra.Command{
+		Use:   "trade",
+		Short: "Record manual trades",
+		Long:  "Commands for recording orders executed manually on an exchange",
+	}
+
+	// Buy command
+	buyTradeCmd = newRecordTradeCommand(svc, log, domain.SideBuy)
+
+	// Sell command
+	sellTradeCmd = newRecordTradeCommand(svc, log, domain.SideSell)
+
+	// History command
+	historyTradeCmd = &cobra.Command{
+		Use:   "history",
+		Short: "List recorded trades",
+		Long: "Display or export recorded trades with optional filters, sorting and pagination.\n\n" +
+			"--output selects the format and --file writes the trades to a file, e.g. --output csv --file trades.csv",
+		Annotations: supportsOutput(),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			symbol, _ := cmd.Flags().GetString("symbol")
+			side, _ := cmd.Flags().GetString("side")
+			strategyID, _ := cmd.Flags().GetString("strategy")
+			fromRaw, _ := cmd.Flags().GetString("from")
+			toRaw, _ := cmd.Flags().GetString("to")
+			sortBy, _ := cmd.Flags().GetString("sort")
+			descending, _ := cmd.Flags().GetBool("desc")
+			page, _ := cmd.Flags().GetInt("page")
+			pageSize, _ := cmd.Flags().GetInt("limit")
+			file, _ := cmd.Flags().GetString("file")
+
+			format := outputFormat(cmd)
+			if cmd.Flags().Changed("format") {
+				format, _ = cmd.Flags().GetString("format")
+			}
+			write, ok := tradeWriters[strings.ToLower(format)]
+			if !ok {
+				return fmt.Errorf("invalid format %q: use table, json, yaml or csv", format)
+			}
+
+			req := &trade.ListTradesRequest{
+				Symbol:     symbol,
+				Side:       side,
+				StrategyID: strategyID,
+				SortBy:     sortBy,
+				Descending: descending,
+				Page:       page,
+				PageSize:   pageSize,
+			}
+			now := time.Now()
+			if fromRaw != "" {
+				from, err := parseSince(fromRaw, now)
+				if err != nil {
+					return err
+				}
+				req.From = from
+			}
+			if toRaw != "" {
+				to, err := parseUntil(toRaw)
+				if err != nil {
+					return err
+				}
+				req.To = to
+			}
+
+			results, err := svc.ListTrades(cmd.Context(), req)
+			if err != nil {
+				log.Error("Failed to list trades", "error", err.Error())
+				return err
+			}
+
+			if file == "" {
+				return write(cmd.OutOrStdout(), results)
+			}
+
+			f, err := os.Create(file)
+			if err != nil {
+				return fmt.Errorf("failed to create output file: %v", err)
+			}
+			if err := write(f, results); err != nil {
+				f.Close()
+				return err
+			}
+			if err := f.Close(); err != nil {
+				return err
+			}
+			fmt.Fprintf(cmd.ErrOrStderr(), "Wrote %d trades to %s\n", len(results), file)
+			return nil
+		},
+	}
+
+	historyTradeCmd.Flags().StringP("symbol", "s", "", "Only show trades of this symbol")
+	historyTradeCmd.Flags().String("side", "", "Only show BUY or SELL trades")
+	historyTradeCmd.Flags().String("strategy", "", "Only show trades of this strategy ID")
+	historyTradeCmd.Flags().String("from", "", "Only show trades since a time (RFC3339, YYYY-MM-DD or a duration such as 720h)")
+	historyTradeCmd.Flags().String("to", "", "Only show trades before a time (RFC3339, or YYYY-MM-DD to include that whole day)")
+	historyTradeCmd.Flags().String("sort", "executed_at", "Sort by executed_at, symbol, quantity or price")
+	historyTradeCmd.Flags().Bool("desc", false, "Sort in descending order")
+	historyTradeCmd.Flags().Int("limit", 0, "Trades per page (default all)")
+	historyTradeCmd.Flags().Int("page", 1, "Page number, used with --limit")
+	historyTradeCmd.Flags().String("format", "", "Output format: table, json, yaml or csv")
+	_ = historyTradeCmd.Flags().MarkDeprecated("format", "use --output instead")
+	historyTradeCmd.Flags().String("file", "", "Write to this file instead of stdout")
+
+	// Add subcommands to root command
+	rootCmd.AddCommand(
+		buyTradeCmd,
+		sellTradeCmd,
+		historyTradeCmd,
+	)
+
+	return rootCmd
+}
+
+// newRecordTradeCommand creates the buy or sell subcommand.
+func newRecordTradeCommand(svc *trade.TradeService, log logger.Logger, side domain.Side) *cobra.Command {
+	verb := "buy"
+	if side == domain.SideSell {
+		verb = "sell"
+	}
+
+	cmd := &cobra.Command{
+		Use:   verb,
+		Short: fmt.Sprintf("Record a %s trade", verb),
+		Long:  fmt.Sprintf("Record a %s order that was executed on an exchange", verb),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			symbol, _ := cmd.Flags().GetString("symbol")
+			quantityRaw, _ := cmd.Flags().GetString("qty")
+			priceRaw, _ := cmd.Flags().GetString("price")
+			feeRaw, _ := cmd.Flags().GetString("fee")
+			strategyID, _ := cmd.Flags().GetString("strategy")
+			executedAtRaw, _ := cmd.Flags().GetString("time")
+
+			if symbol == "" {
+				return fmt.Errorf("symbol is required")
+			}
+
+			quantity, err := parseDecimal("qty", quantityRaw)
+			if err != nil {
+				return err
+			}
+			price, err := parseDecimal("price", priceRaw)
+			if err != nil {
+				return err
+			}
+			fee, err := parseDecimal("fee", feeRaw)
+			if err != nil {
+				return err
+			}
+
+			req := &trade.RecordTradeRequest{
+				Symbol:     symbol,
+				Side:       string(side),
+				Quantity:   quantity,
+				Price:      price,
+				Fee:        fee,
+				StrategyID: strategyID,
+			}
+
+			if executedAtRaw != "" {
+				executedAt, err := time.Parse(time.RFC3339, executedAtRaw)
+				if err != nil {
+					return fmt.Errorf("invalid time value: %v", err)
+				}
+				req.ExecutedAt = executedAt
+			}
+
+			result, err := svc.RecordTrade(cmd.Context(), req)
+			if err != nil {
+				log.Error("Failed to record trade", "error", err.Error())
+				return err
+			}
+
+			log.Info("Trade recorded successfully", "id", result.ID)
+			fmt.Printf("Recorded trade: ID=%s, Side=%s, Symbol=%s, Quantity=%s, Price=%s, Fee=%s, Time=%s\n",
+				result.ID, result.Side, result.Symbol, result.Quantity, result.Price, result.Fee,
+				result.ExecutedAt.Local().Format(time.RFC3339))
+			return nil
+		},
+	}
+
+	cmd.Flags().StringP("symbol", "s", "", "Symbol (e.g., BTC)")
+	cmd.Flags().StringP("qty", "q", "", "Quantity traded")
+	cmd.Flags().StringP("price", "p", "", "Price per unit")
+	cmd.Flags().StringP("fee", "f", "0", "Fee paid in the quote currency")
+	cmd.Flags().String("strategy", "", "ID of the strategy the trade was made for")
+	cmd.Flags().String("time", "", "Execution time in RFC3339 (default now)")
+	_ = cmd.MarkFlagRequired("symbol")
+	_ = cmd.MarkFlagRequired("qty")
+	_ = cmd.MarkFlagRequired("price")
+
+	return cmd
+}
+
+// parseUntil parses the exclusive end of a time range. A date without a time
+// (YYYY-MM-DD, local time) includes that whole day.
+func parseUntil(value string) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, nil
+	}
+	if t, err := time.ParseInLocation("2006-01-02", value, time.Local); err == nil {
+		return t.AddDate(0, 0, 1), nil
+	}
+	return time.Time{}, fmt.Errorf("invalid to value %q: use RFC3339 or YYYY-MM-DD", value)
+}
+
+// tradeWriters renders trade history in each supported format.
+var tradeWriters = map[string]func(w io.Writer, trades []*trade.TradeResponse) error{
+	outputTable: writeTradesTable,
+	outputCSV:   writeTradesCSV,
+	outputJSON:  writeTradesJSON,
+	outputYAML:  writeTradesYAML,
+}
+
+// writeTradesTable writes trades as a human readable table.
+func writeTradesTable(w io.Writer, trades []*trade.TradeResponse) error {
+	if len(trades) == 0 {
+		_, err := fmt.Fprintln(w, "No trades found")
+		return err
+	}
+
+	fmt.Fprintln(w, "Trades:")
+	fmt.Fprintln(w, strings.Repeat("-", 100))
+	for _, t := range trades {
+		strategyID := t.StrategyID
+		if strategyID == "" {
+			strategyID = "-"
+		}
+		fmt.Fprintf(w, "ID: %s, Time: %s, Symbol: %s, Side: %s, Quantity: %s, Price: %s, Fee: %s, Strategy: %s\n",
+			t.ID, t.ExecutedAt.Local().Format(time.RFC3339), t.Symbol, t.Side, t.Quantity, t.Price, t.Fee, strategyID)
+	}
+	_, err := fmt.Fprintln(w, strings.Repeat("-", 100))
+	return err
+}
+
+// writeTradesCSV writes trades as CSV with a header row.
+func writeTradesCSV(w io.Writer, trades []*trade.TradeResponse) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write([]string{"id", "executed_at", "symbol", "side", "quantity", "price", "fee", "notional", "strategy_id"}); err != nil {
+		return err
+	}
+	for _, t := range trades {
+		record := []string{
+			t.ID,
+			t.ExecutedAt.UTC().Format(time.RFC3339),
+			t.Symbol,
+			t.Side,
+			t.Quantity.String(),
+			t.Price.String(),
+			t.Fee.String(),
+			t.Quantity.Mul(t.Price).String(),
+			t.StrategyID,
+		}
+		if err := cw.Write(record); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// tradeRecord is the JSON and YAML representation of a trade.
+type tradeRecord struct {
+	ID         string          `json:"id" yaml:"id"`
+	ExecutedAt time.Time       `json:"executed_at" yaml:"executed_at"`
+	Symbol     string          `json:"symbol" yaml:"symbol"`
+	Side       string          `json:"side" yaml:"side"`
+	Quantity   decimal.Decimal `json:"quantity" yaml:"quantity"`
+	Price      decimal.Decimal `json:"price" yaml:"price"`
+	Fee        decimal.Decimal `json:"fee" yaml:"fee"`
+	StrategyID string          `json:"strategy_id,omitempty" yaml:"strategy_id,omitempty"`
+}
+
+// writeTradesJSON writes trades as an indented JSON array.
+func writeTradesJSON(w io.Writer, trades []*trade.TradeResponse) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(toTradeRecords(trades))
+}
+
+// writeTradesYAML writes trades as a YAML list.
+func writeTradesYAML(w io.Writer, trades []*trade.TradeResponse) error {
+	enc := yaml.NewEncoder(w)
+	enc.SetIndent(2)
+	if err := enc.Encode(toTradeRecords(trades)); err != nil {
+		return err
+	}
+	return enc.Close()
+}
+
+// toTradeRecords converts trades for output.
+func toTradeRecords(trades []*trade.TradeResponse) []tradeRecord {
+	items := make([]tradeRecord, len(trades))
+	for i, t := range trades {
+		items[i] = tradeRecord{
+			ID:         t.ID,
+			ExecutedAt: t.ExecutedAt.UTC(),
+			Symbol:     t.Symbol,
+			Side:       t.Side,
+			Quantity:   t.Quantity,
+			Price:      t.Price,
+			Fee:        t.Fee,
+			StrategyID: t.StrategyID,
+		}
+	}
+	return items
+}
@@ -0,0 +1,171 @@
+// Package grpc exposes the use cases as the strategy.v1.StrategyService gRPC
+// service. The protobuf definition and the code generated from it live in
+// strategypb.
+package grpc
+
+import (
+	"context"
+	"errors"
+	"net"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"transaction/internal/domain"
+	"transaction/internal/interface/grpc/strategypb"
+	"transaction/internal/usecase/feed"
+	"transaction/internal/usecase/signal"
+	"transaction/internal/usecase/strategy"
+	"transaction/pkg/logger"
+)
+
+//go:generate protoc --go_out=. --go_opt=paths=source_relative --go-grpc_out=. --go-grpc_opt=paths=source_relative strategypb/strategy.proto
+
+// shutdownTimeout is how long in-flight calls may take to finish once the
+// server is asked to stop. Open WatchSignals streams are cut off after it.
+const shutdownTimeout = 10 * time.Second
+
+// Server serves the gRPC API.
+type Server struct {
+	server *grpc.Server
+	logger logger.Logger
+}
+
+// NewServer creates a new instance of Server. Calls are attributed in the
+// audit log to the actor of ctx, the user running the server. The live feed is
+// optional and only given when the price monitor runs in the same process.
+func NewServer(ctx context.Context, strategies *strategy.StrategyService, signals *signal.SignalService, live *feed.FeedService, logger logger.Logger) *Server {
+	actor := domain.ActorFromContext(ctx)
+	server := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(unaryInterceptor(actor, logger)),
+		grpc.ChainStreamInterceptor(streamInterceptor(actor, logger)),
+	)
+	strategypb.RegisterStrategyServiceServer(server, NewStrategyServer(strategies, signals, live, logger))
+	return &Server{server: server, logger: logger}
+}
+
+// Serve accepts connections on lis until Stop is called.
+func (s *Server) Serve(lis net.Listener) error {
+	return s.server.Serve(lis)
+}
+
+// Stop waits for in-flight calls to finish, for at most shutdownTimeout, and
+// then closes every connection.
+func (s *Server) Stop() {
+	done := make(chan struct{})
+	go func() {
+		s.server.GracefulStop()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(shutdownTimeout):
+		s.server.Stop()
+	}
+}
+
+// ListenAndServe serves the API on addr until ctx is cancelled.
+func (s *Server) ListenAndServe(ctx context.Context, addr string) error {
+	lis, err := net.Listen("tcp", addr)
+	if err != nil {
+		return err
+	}
+
+	errCh := make(chan error, 1)
+	go func() {
+		s.logger.Info("gRPC API listening", "addr", lis.Addr().String())
+		errCh <- s.Serve(lis)
+	}()
+
+	select {
+	case err := <-errCh:
+		return err
+	case <-ctx.Done():
+	}
+
+	s.Stop()
+	if err := <-errCh; err != nil && !errors.Is(err, grpc.ErrServerStopped) {
+		return err
+	}
+	return ctx.Err()
+}
+
+// toStatus maps an error returned by a service to a gRPC status. Errors not
+// caused by the request are logged and reported without their message, which
+// may reveal internals.
+func toStatus(err error, log logger.Logger) error {
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+
+	var validationErr *domain.ValidationError
+	switch {
+	case errors.Is(err, domain.ErrStrategyNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, domain.ErrConcurrentModification):
+		return status.Error(codes.Aborted, err.Error())
+	case errors.Is(err, domain.ErrSlowConsumer):
+		return status.Error(codes.ResourceExhausted, err.Error())
+	case errors.As(err, &validationErr):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, context.Canceled):
+		return status.Error(codes.Canceled, err.Error())
+	case errors.Is(err, context.DeadlineExceeded):
+		return status.Error(codes.DeadlineExceeded, err.Error())
+	default:
+		log.Error("Call failed", "error", err.Error())
+		return status.Error(codes.Internal, "internal server error")
+	}
+}
+
+// unaryInterceptor attributes a call to actor, logs it with its status and
+// duration, and turns a panicking handler into an internal error.
+func unaryInterceptor(actor string, log logger.Logger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
+		start := time.Now()
+		defer func() {
+			if r := recover(); r != nil {
+				log.Error("Call handler panicked", "method", info.FullMethod, "panic", r)
+				err = status.Error(codes.Internal, "internal server error")
+			}
+			logCall(log, info.FullMethod, err, start)
+		}()
+		return handler(domain.WithActor(ctx, actor), req)
+	}
+}
+
+// streamInterceptor is unaryInterceptor for streaming calls.
+func streamInterceptor(actor string, log logger.Logger) grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) (err error) {
+		start := time.Now()
+		defer func() {
+			if r := recover(); r != nil {
+				log.Error("Call handler panicked", "method", info.FullMethod, "panic", r)
+				err = status.Error(codes.Internal, "internal server error")
+			}
+			logCall(log, info.FullMethod, err, start)
+		}()
+		return handler(srv, &actorStream{ServerStream: stream, ctx: domain.WithActor(stream.Context(), actor)})
+	}
+}
+
+// logCall logs a finished call with its status code and duration.
+func logCall(log logger.Logger, method string, err error, start time.Time) {
+	log.Info("gRPC call",
+		"method", method,
+		"code", status.Code(err).String(),
+		"duration", time.Since(start).Round(time.Microsecond),
+	)
+}
+
+// actorStream is a ServerStream whose context carries the actor.
+type actorStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *actorStream) Context() context.Context {
+	return s.ctx
+}
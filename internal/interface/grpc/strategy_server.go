@@ -0,0 +1,287 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"transaction/internal/interface/grpc/strategypb"
+	"transaction/internal/usecase/feed"
+	"transaction/internal/usecase/signal"
+	"transaction/internal/usecase/strategy"
+	"transaction/pkg/logger"
+)
+
+// StrategyServer implements strategypb.StrategyServiceServer on top of the
+// strategy and signal services.
+type StrategyServer struct {
+	strategypb.UnimplementedStrategyServiceServer
+
+	strategies *strategy.StrategyService
+	signals    *signal.SignalService
+	live       *feed.FeedService
+	logger     logger.Logger
+}
+
+// NewStrategyServer creates a new instance of StrategyServer. The live feed is
+// optional and only given when the price monitor runs in the same process.
+func NewStrategyServer(strategies *strategy.StrategyService, signals *signal.SignalService, live *feed.FeedService, logger logger.Logger) *StrategyServer {
+	return &StrategyServer{
+		strategies: strategies,
+		signals:    signals,
+		live:       live,
+		logger:     logger,
+	}
+}
+
+// CreateStrategy creates an active strategy.
+func (s *StrategyServer) CreateStrategy(ctx context.Context, in *strategypb.CreateStrategyRequest) (*strategypb.Strategy, error) {
+	if in.GetSymbol() == "" {
+		return nil, status.Error(codes.InvalidArgument, "symbol is required")
+	}
+	buyLower, err := parsePrice("buy_lower", in.GetBuyLower())
+	if err != nil {
+		return nil, err
+	}
+	sellUpper, err := parsePrice("sell_upper", in.GetSellUpper())
+	if err != nil {
+		return nil, err
+	}
+	cooldown, err := parseCooldown(in.GetCooldown())
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := s.strategies.CreateStrategy(ctx, &strategy.CreateStrategyRequest{
+		Symbol:    in.GetSymbol(),
+		BuyLower:  buyLower,
+		SellUpper: sellUpper,
+		Cooldown:  cooldown,
+	})
+	if err != nil {
+		return nil, toStatus(err, s.logger)
+	}
+	return toProto(result), nil
+}
+
+// GetStrategy retrieves a strategy by ID.
+func (s *StrategyServer) GetStrategy(ctx context.Context, in *strategypb.GetStrategyRequest) (*strategypb.Strategy, error) {
+	result, err := s.strategies.GetStrategy(ctx, in.GetId())
+	if err != nil {
+		return nil, toStatus(err, s.logger)
+	}
+	return toProto(result), nil
+}
+
+// ListStrategies retrieves the strategies matching the filters.
+func (s *StrategyServer) ListStrategies(ctx context.Context, in *strategypb.ListStrategiesRequest) (*strategypb.ListStrategiesResponse, error) {
+	req := &strategy.ListStrategiesRequest{
+		Symbol:         in.GetSymbol(),
+		Active:         in.Active,
+		SortBy:         in.GetSortBy(),
+		Descending:     in.GetDescending(),
+		Page:           int(in.GetPage()),
+		PageSize:       int(in.GetPageSize()),
+		IncludeDeleted: in.GetIncludeDeleted(),
+	}
+	if in.CreatedAfter != nil {
+		req.CreatedAfter = in.CreatedAfter.AsTime()
+	}
+	if in.CreatedBefore != nil {
+		req.CreatedBefore = in.CreatedBefore.AsTime()
+	}
+
+	results, err := s.strategies.ListStrategies(ctx, req)
+	if err != nil {
+		return nil, toStatus(err, s.logger)
+	}
+
+	strategies := make([]*strategypb.Strategy, len(results))
+	for i, result := range results {
+		strategies[i] = toProto(result)
+	}
+	return &strategypb.ListStrategiesResponse{Strategies: strategies}, nil
+}
+
+// UpdateStrategy changes the fields that are set and keeps the others.
+func (s *StrategyServer) UpdateStrategy(ctx context.Context, in *strategypb.UpdateStrategyRequest) (*strategypb.Strategy, error) {
+	req := &strategy.UpdateStrategyRequest{ID: in.GetId(), Symbol: in.Symbol}
+	if in.BuyLower != nil {
+		buyLower, err := parsePrice("buy_lower", in.GetBuyLower())
+		if err != nil {
+			return nil, err
+		}
+		req.BuyLower = &buyLower
+	}
+	if in.SellUpper != nil {
+		sellUpper, err := parsePrice("sell_upper", in.GetSellUpper())
+		if err != nil {
+			return nil, err
+		}
+		req.SellUpper = &sellUpper
+	}
+	cooldown, err := parseCooldown(in.GetCooldown())
+	if err != nil {
+		return nil, err
+	}
+	req.Cooldown = cooldown
+	if in.ExpectedVersion != nil {
+		version := int(in.GetExpectedVersion())
+		req.ExpectedVersion = &version
+	}
+
+	result, err := s.strategies.UpdateStrategy(ctx, req)
+	if err != nil {
+		return nil, toStatus(err, s.logger)
+	}
+	return toProto(result), nil
+}
+
+// DeleteStrategy soft deletes a strategy.
+func (s *StrategyServer) DeleteStrategy(ctx context.Context, in *strategypb.DeleteStrategyRequest) (*strategypb.DeleteStrategyResponse, error) {
+	if err := s.strategies.DeleteStrategy(ctx, in.GetId()); err != nil {
+		return nil, toStatus(err, s.logger)
+	}
+	return &strategypb.DeleteStrategyResponse{}, nil
+}
+
+// ToggleStrategy switches a strategy between active and inactive.
+func (s *StrategyServer) ToggleStrategy(ctx context.Context, in *strategypb.ToggleStrategyRequest) (*strategypb.Strategy, error) {
+	result, err := s.strategies.ToggleStrategy(ctx, in.GetId())
+	if err != nil {
+		return nil, toStatus(err, s.logger)
+	}
+	return toProto(result), nil
+}
+
+// WatchSignals streams the signals recorded from now on until the client
+// cancels the call.
+//
+// When the price monitor runs in the server, signals are pushed from its live
+// feed as they trigger. Otherwise signals are recorded by a monitor in another
+// process, such as monitor run, which shares only the database with the
+// server, so the signal service polls the database for them.
+func (s *StrategyServer) WatchSignals(in *strategypb.WatchSignalsRequest, stream strategypb.StrategyService_WatchSignalsServer) error {
+	if s.live != nil {
+		return s.watchLiveSignals(in, stream)
+	}
+
+	req := &signal.WatchSignalsRequest{StrategyID: in.GetStrategyId()}
+	err := s.signals.WatchSignals(stream.Context(), req, func(sig *signal.SignalResponse) error {
+		return stream.Send(toSignalProto(sig))
+	})
+	if err != nil {
+		return toStatus(err, s.logger)
+	}
+	return nil
+}
+
+// watchLiveSignals streams the signals of the live feed. A client too slow to
+// read them is disconnected with ResourceExhausted.
+func (s *StrategyServer) watchLiveSignals(in *strategypb.WatchSignalsRequest, stream strategypb.StrategyService_WatchSignalsServer) error {
+	sub, err := s.live.Subscribe(&feed.SubscribeRequest{})
+	if err != nil {
+		return toStatus(err, s.logger)
+	}
+	defer s.live.Unsubscribe(sub)
+
+	for {
+		select {
+		case event, ok := <-sub.Events():
+			if !ok {
+				if err := sub.Err(); err != nil {
+					return toStatus(err, s.logger)
+				}
+				return nil
+			}
+			if event.Type != feed.EventSignal {
+				continue
+			}
+			if id := in.GetStrategyId(); id != "" && event.Signal.StrategyID != id {
+				continue
+			}
+			if err := stream.Send(toLiveSignalProto(event.Signal)); err != nil {
+				return err
+			}
+		case <-stream.Context().Done():
+			return toStatus(stream.Context().Err(), s.logger)
+		}
+	}
+}
+
+// parsePrice parses a decimal price field of a request.
+func parsePrice(field, raw string) (decimal.Decimal, error) {
+	if raw == "" {
+		return decimal.Decimal{}, status.Errorf(codes.InvalidArgument, "%s is required", field)
+	}
+	value, err := decimal.NewFromString(raw)
+	if err != nil {
+		return decimal.Decimal{}, status.Errorf(codes.InvalidArgument, "invalid %s value %q: must be a decimal number", field, raw)
+	}
+	return value, nil
+}
+
+// parseCooldown converts an optional cooldown, which is nil when not set.
+func parseCooldown(d *durationpb.Duration) (*time.Duration, error) {
+	if d == nil {
+		return nil, nil
+	}
+	if err := d.CheckValid(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("invalid cooldown: %v", err))
+	}
+	cooldown := d.AsDuration()
+	return &cooldown, nil
+}
+
+// toProto converts a StrategyResponse to its protobuf message.
+func toProto(s *strategy.StrategyResponse) *strategypb.Strategy {
+	msg := &strategypb.Strategy{
+		Id:        s.ID,
+		Symbol:    s.Symbol,
+		BuyLower:  s.BuyLower.String(),
+		SellUpper: s.SellUpper.String(),
+		Cooldown:  durationpb.New(s.Cooldown),
+		IsActive:  s.IsActive,
+		Version:   int64(s.Version),
+		CreatedAt: timestamppb.New(s.CreatedAt),
+	}
+	if s.DeletedAt != nil {
+		msg.DeletedAt = timestamppb.New(*s.DeletedAt)
+	}
+	return msg
+}
+
+// toLiveSignalProto converts a signal of the live feed to its protobuf message.
+func toLiveSignalProto(s *feed.SignalResponse) *strategypb.Signal {
+	return toSignalProto(&signal.SignalResponse{
+		ID:            s.ID,
+		StrategyID:    s.StrategyID,
+		Symbol:        s.Symbol,
+		Side:          s.Side,
+		TriggerPrice:  s.TriggerPrice,
+		ObservedPrice: s.ObservedPrice,
+		TriggeredAt:   s.TriggeredAt,
+	})
+}
+
+// toSignalProto converts a SignalResponse to its protobuf message.
+func toSignalProto(s *signal.SignalResponse) *strategypb.Signal {
+	side := strategypb.Side_SIDE_UNSPECIFIED
+	if value, ok := strategypb.Side_value["SIDE_"+s.Side]; ok {
+		side = strategypb.Side(value)
+	}
+	return &strategypb.Signal{
+		Id:            s.ID,
+		StrategyId:    s.StrategyID,
+		Symbol:        s.Symbol,
+		Side:          side,
+		TriggerPrice:  s.TriggerPrice.String(),
+		ObservedPrice: s.ObservedPrice.String(),
+		TriggeredAt:   timestamppb.New(s.TriggeredAt),
+	}
+}
@@ -0,0 +1,349 @@
+package grpc
+
+import (
+	"context"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"transaction/internal/adapter/repository"
+	"transaction/internal/adapter/repository/memory"
+	"transaction/internal/domain"
+	"transaction/internal/interface/grpc/strategypb"
+	"transaction/internal/testutil"
+	"transaction/internal/usecase/feed"
+	"transaction/internal/usecase/signal"
+	"transaction/internal/usecase/strategy"
+)
+
+// testServer is a Server listening on an in-process connection.
+type testServer struct {
+	client  strategypb.StrategyServiceClient
+	signals *testutil.MockSignalRepository
+
+	mu     sync.Mutex
+	audits []*domain.StrategyAudit
+}
+
+// lastActor returns the actor recorded for the last change.
+func (s *testServer) lastActor() string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.audits[len(s.audits)-1].Actor
+}
+
+// setupServer starts a server run by alice, backed by an empty in-memory
+// strategy repository, and returns a client connected to it.
+func setupServer(t *testing.T) *testServer {
+	t.Helper()
+	return startServer(t, nil)
+}
+
+// setupLiveServer is setupServer for a server running the price monitor,
+// which publishes to the returned live feed.
+func setupLiveServer(t *testing.T) (*testServer, *feed.FeedService) {
+	t.Helper()
+	mockLogger := testutil.NewMockLogger()
+	live := feed.NewFeedService(mockLogger)
+	return startServer(t, live), live
+}
+
+// startServer starts a server with an optional live feed.
+func startServer(t *testing.T, live *feed.FeedService) *testServer {
+	t.Helper()
+	ts := &testServer{signals: new(testutil.MockSignalRepository)}
+
+	mockLogger := testutil.NewMockLogger()
+
+	audits := new(testutil.MockAuditRepository)
+	audits.On("Append", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		ts.mu.Lock()
+		defer ts.mu.Unlock()
+		ts.audits = append(ts.audits, args.Get(1).(*domain.StrategyAudit))
+	}).Return(nil)
+
+	strategies := strategy.NewStrategyService(memory.NewStrategyRepository(), testutil.NewMockMarkets(), audits, testutil.PassthroughTransactor{}, mockLogger)
+	signals := signal.NewSignalService(ts.signals, mockLogger)
+	server := NewServer(domain.WithActor(context.Background(), "alice"), strategies, signals, live, mockLogger)
+
+	lis := bufconn.Listen(1 << 20)
+	go func() { _ = server.Serve(lis) }()
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufconn",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+
+	ts.client = strategypb.NewStrategyServiceClient(conn)
+	return ts
+}
+
+// create creates a BTC/USDT strategy and returns it.
+func (s *testServer) create(t *testing.T) *strategypb.Strategy {
+	t.Helper()
+	created, err := s.client.CreateStrategy(context.Background(), &strategypb.CreateStrategyRequest{
+		Symbol:    "btc",
+		BuyLower:  "30000",
+		SellUpper: "50000.5",
+		Cooldown:  durationpb.New(15 * time.Minute),
+	})
+	require.NoError(t, err)
+	return created
+}
+
+func TestCreateStrategy_Success(t *testing.T) {
+	ts := setupServer(t)
+
+	created := ts.create(t)
+
+	assert.NotEmpty(t, created.GetId())
+	assert.Equal(t, "BTC/USDT", created.GetSymbol())
+	assert.Equal(t, "30000", created.GetBuyLower())
+	assert.Equal(t, "50000.5", created.GetSellUpper())
+	assert.Equal(t, 15*time.Minute, created.GetCooldown().AsDuration())
+	assert.True(t, created.GetIsActive())
+	assert.Equal(t, int64(1), created.GetVersion())
+	assert.NotNil(t, created.GetCreatedAt())
+	assert.Nil(t, created.GetDeletedAt())
+	assert.Equal(t, "alice", ts.lastActor())
+}
+
+func TestCreateStrategy_InvalidArgument(t *testing.T) {
+	ts := setupServer(t)
+
+	tests := []struct {
+		name string
+		req  *strategypb.CreateStrategyRequest
+	}{
+		{"missing symbol", &strategypb.CreateStrategyRequest{BuyLower: "1", SellUpper: "2"}},
+		{"missing buy lower", &strategypb.CreateStrategyRequest{Symbol: "BTC", SellUpper: "2"}},
+		{"malformed price", &strategypb.CreateStrategyRequest{Symbol: "BTC", BuyLower: "cheap", SellUpper: "2"}},
+		{"inverted range", &strategypb.CreateStrategyRequest{Symbol: "BTC", BuyLower: "50000", SellUpper: "30000"}},
+		{"off tick size", &strategypb.CreateStrategyRequest{Symbol: "BTC", BuyLower: "30000.001", SellUpper: "50000"}},
+		{"unknown symbol", &strategypb.CreateStrategyRequest{Symbol: "DOGE", BuyLower: "1", SellUpper: "2"}},
+		{"negative cooldown", &strategypb.CreateStrategyRequest{Symbol: "BTC", BuyLower: "1", SellUpper: "2", Cooldown: durationpb.New(-time.Minute)}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ts.client.CreateStrategy(context.Background(), tt.req)
+
+			assert.Equal(t, codes.InvalidArgument, status.Code(err), err)
+		})
+	}
+}
+
+func TestGetStrategy(t *testing.T) {
+	ts := setupServer(t)
+	created := ts.create(t)
+
+	fetched, err := ts.client.GetStrategy(context.Background(), &strategypb.GetStrategyRequest{Id: created.GetId()})
+
+	require.NoError(t, err)
+	assert.True(t, proto.Equal(created, fetched))
+}
+
+func TestStrategyNotFound(t *testing.T) {
+	ts := setupServer(t)
+	ctx := context.Background()
+
+	_, err := ts.client.GetStrategy(ctx, &strategypb.GetStrategyRequest{Id: "missing"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	_, err = ts.client.UpdateStrategy(ctx, &strategypb.UpdateStrategyRequest{Id: "missing", BuyLower: proto.String("1")})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	_, err = ts.client.DeleteStrategy(ctx, &strategypb.DeleteStrategyRequest{Id: "missing"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	_, err = ts.client.ToggleStrategy(ctx, &strategypb.ToggleStrategyRequest{Id: "missing"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestUpdateStrategy(t *testing.T) {
+	ts := setupServer(t)
+	created := ts.create(t)
+	ctx := context.Background()
+
+	updated, err := ts.client.UpdateStrategy(ctx, &strategypb.UpdateStrategyRequest{
+		Id:              created.GetId(),
+		SellUpper:       proto.String("60000"),
+		ExpectedVersion: proto.Int64(1),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "30000", updated.GetBuyLower())
+	assert.Equal(t, "60000", updated.GetSellUpper())
+	assert.Equal(t, int64(2), updated.GetVersion())
+
+	// A writer still holding version 1 is turned away
+	_, err = ts.client.UpdateStrategy(ctx, &strategypb.UpdateStrategyRequest{
+		Id:              created.GetId(),
+		BuyLower:        proto.String("31000"),
+		ExpectedVersion: proto.Int64(1),
+	})
+	assert.Equal(t, codes.Aborted, status.Code(err))
+
+	_, err = ts.client.UpdateStrategy(ctx, &strategypb.UpdateStrategyRequest{Id: created.GetId()})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestDeleteStrategy(t *testing.T) {
+	ts := setupServer(t)
+	created := ts.create(t)
+	ctx := context.Background()
+
+	_, err := ts.client.DeleteStrategy(ctx, &strategypb.DeleteStrategyRequest{Id: created.GetId()})
+	require.NoError(t, err)
+
+	_, err = ts.client.GetStrategy(ctx, &strategypb.GetStrategyRequest{Id: created.GetId()})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	listed, err := ts.client.ListStrategies(ctx, &strategypb.ListStrategiesRequest{IncludeDeleted: true})
+	require.NoError(t, err)
+	require.Len(t, listed.GetStrategies(), 1)
+	assert.NotNil(t, listed.GetStrategies()[0].GetDeletedAt())
+}
+
+func TestToggleStrategy(t *testing.T) {
+	ts := setupServer(t)
+	created := ts.create(t)
+
+	toggled, err := ts.client.ToggleStrategy(context.Background(), &strategypb.ToggleStrategyRequest{Id: created.GetId()})
+
+	require.NoError(t, err)
+	assert.False(t, toggled.GetIsActive())
+	assert.Equal(t, int64(2), toggled.GetVersion())
+}
+
+func TestListStrategies(t *testing.T) {
+	ts := setupServer(t)
+	first := ts.create(t)
+	second := ts.create(t)
+	ctx := context.Background()
+	_, err := ts.client.ToggleStrategy(ctx, &strategypb.ToggleStrategyRequest{Id: second.GetId()})
+	require.NoError(t, err)
+
+	all, err := ts.client.ListStrategies(ctx, &strategypb.ListStrategiesRequest{})
+	require.NoError(t, err)
+	assert.Len(t, all.GetStrategies(), 2)
+
+	active, err := ts.client.ListStrategies(ctx, &strategypb.ListStrategiesRequest{Symbol: "btc", Active: proto.Bool(true), Page: 1, PageSize: 10})
+	require.NoError(t, err)
+	require.Len(t, active.GetStrategies(), 1)
+	assert.Equal(t, first.GetId(), active.GetStrategies()[0].GetId())
+
+	old, err := ts.client.ListStrategies(ctx, &strategypb.ListStrategiesRequest{CreatedBefore: timestamppb.New(time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC))})
+	require.NoError(t, err)
+	assert.Empty(t, old.GetStrategies())
+
+	_, err = ts.client.ListStrategies(ctx, &strategypb.ListStrategiesRequest{SortBy: "price"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestWatchSignals(t *testing.T) {
+	ts := setupServer(t)
+
+	recorded := &domain.Signal{
+		ID:            "signal-1",
+		StrategyID:    "strategy-1",
+		Symbol:        "BTC/USDT",
+		Side:          domain.SideBuy,
+		TriggerPrice:  decimal.NewFromInt(30000),
+		ObservedPrice: decimal.RequireFromString("29999.5"),
+		TriggeredAt:   time.Now().Add(time.Second),
+	}
+	filter := mock.MatchedBy(func(f repository.SignalFilter) bool { return f.StrategyID == "strategy-1" })
+	ts.signals.On("Find", mock.Anything, filter).Return([]*domain.Signal{recorded}, nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	stream, err := ts.client.WatchSignals(ctx, &strategypb.WatchSignalsRequest{StrategyId: "strategy-1"})
+	require.NoError(t, err)
+
+	received, err := stream.Recv()
+	require.NoError(t, err)
+	assert.Equal(t, "signal-1", received.GetId())
+	assert.Equal(t, "strategy-1", received.GetStrategyId())
+	assert.Equal(t, strategypb.Side_SIDE_BUY, received.GetSide())
+	assert.Equal(t, "30000", received.GetTriggerPrice())
+	assert.Equal(t, "29999.5", received.GetObservedPrice())
+	assert.True(t, recorded.TriggeredAt.Equal(received.GetTriggeredAt().AsTime()))
+
+	// The stream stays open until the client cancels it
+	cancel()
+	_, err = stream.Recv()
+	assert.Equal(t, codes.Canceled, status.Code(err))
+}
+
+func TestWatchSignals_LiveFeed(t *testing.T) {
+	ts, live := setupLiveServer(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	stream, err := ts.client.WatchSignals(ctx, &strategypb.WatchSignalsRequest{StrategyId: "strategy-1"})
+	require.NoError(t, err)
+
+	// The subscription starts with the call, so publish until it is received
+	triggeredAt := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		for {
+			live.PublishPrice("BTC/USDT", decimal.NewFromInt(29999), triggeredAt)
+			live.PublishSignal(&domain.Signal{ID: "signal-0", StrategyID: "strategy-2", Symbol: "BTC/USDT", Side: domain.SideSell,
+				TriggerPrice: decimal.NewFromInt(29000), ObservedPrice: decimal.NewFromInt(29999), TriggeredAt: triggeredAt})
+			live.PublishSignal(&domain.Signal{ID: "signal-1", StrategyID: "strategy-1", Symbol: "BTC/USDT", Side: domain.SideBuy,
+				TriggerPrice: decimal.NewFromInt(30000), ObservedPrice: decimal.NewFromInt(29999), TriggeredAt: triggeredAt})
+			select {
+			case <-done:
+				return
+			case <-time.After(10 * time.Millisecond):
+			}
+		}
+	}()
+
+	received, err := stream.Recv()
+	require.NoError(t, err)
+	assert.Equal(t, "signal-1", received.GetId())
+	assert.Equal(t, "strategy-1", received.GetStrategyId())
+	assert.Equal(t, strategypb.Side_SIDE_BUY, received.GetSide())
+	assert.Equal(t, "29999", received.GetObservedPrice())
+	assert.True(t, triggeredAt.Equal(received.GetTriggeredAt().AsTime()))
+
+	// The database is not polled when the live feed is available
+	ts.signals.AssertNotCalled(t, "Find", mock.Anything, mock.Anything)
+
+	cancel()
+	for err == nil {
+		_, err = stream.Recv()
+	}
+	assert.Equal(t, codes.Canceled, status.Code(err))
+}
+
+func TestWatchSignals_RepositoryError(t *testing.T) {
+	ts := setupServer(t)
+	ts.signals.On("Find", mock.Anything, mock.Anything).Return(nil, assert.AnError)
+
+	stream, err := ts.client.WatchSignals(context.Background(), &strategypb.WatchSignalsRequest{})
+	require.NoError(t, err)
+
+	_, err = stream.Recv()
+	assert.Equal(t, codes.Internal, status.Code(err))
+	assert.Equal(t, "internal server error", status.Convert(err).Message())
+}
@@ -0,0 +1,78 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"transaction/internal/domain"
+	"transaction/pkg/logger"
+)
+
+// Error codes reported in ErrorDetail.Code.
+const (
+	// General errors
+	ErrCodeInternalError    = "INTERNAL_ERROR"
+	ErrCodeValidationFailed = "VALIDATION_FAILED"
+	ErrCodeNotFound         = "NOT_FOUND"
+	ErrCodeMethodNotAllowed = "METHOD_NOT_ALLOWED"
+
+	// Strategy errors
+	ErrCodeInvalidStrategy        = "INVALID_STRATEGY"
+	ErrCodeStrategyNotFound       = "STRATEGY_NOT_FOUND"
+	ErrCodeConcurrentModification = "CONCURRENT_MODIFICATION"
+
+	// Live feed errors
+	ErrCodeFeedUnavailable = "FEED_UNAVAILABLE"
+	ErrCodeSlowConsumer    = "SLOW_CONSUMER"
+)
+
+// Response is the envelope of every response body.
+type Response struct {
+	Success bool         `json:"success"`
+	Data    interface{}  `json:"data,omitempty"`
+	Error   *ErrorDetail `json:"error,omitempty"`
+}
+
+// ErrorDetail describes why a request failed.
+type ErrorDetail struct {
+	Code    string      `json:"code"`
+	Message string      `json:"message"`
+	Details interface{} `json:"details,omitempty"`
+}
+
+// respond writes data in a successful response.
+func respond(c *gin.Context, status int, data interface{}) {
+	c.JSON(status, Response{Success: true, Data: data})
+}
+
+// fail writes an error response.
+func fail(c *gin.Context, status int, code, message string, details interface{}) {
+	c.AbortWithStatusJSON(status, Response{
+		Success: false,
+		Error:   &ErrorDetail{Code: code, Message: message, Details: details},
+	})
+}
+
+// invalidField rejects a request whose field could not be read.
+func invalidField(c *gin.Context, field, message string) {
+	fail(c, http.StatusBadRequest, ErrCodeValidationFailed, message, map[string]string{"field": field})
+}
+
+// failWith maps an error returned by a service to its status and error code.
+// Errors not caused by the request are logged and reported without their
+// message, which may reveal internals.
+func failWith(c *gin.Context, err error, log logger.Logger) {
+	var validationErr *domain.ValidationError
+	switch {
+	case errors.Is(err, domain.ErrStrategyNotFound):
+		fail(c, http.StatusNotFound, ErrCodeStrategyNotFound, err.Error(), nil)
+	case errors.Is(err, domain.ErrConcurrentModification):
+		fail(c, http.StatusConflict, ErrCodeConcurrentModification, err.Error(), nil)
+	case errors.As(err, &validationErr):
+		fail(c, http.StatusBadRequest, ErrCodeInvalidStrategy, err.Error(), nil)
+	default:
+		log.Error("Request failed", "method", c.Request.Method, "path", c.Request.URL.Path, "error", err.Error())
+		fail(c, http.StatusInternalServerError, ErrCodeInternalError, "internal server error", nil)
+	}
+}
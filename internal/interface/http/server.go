@@ -0,0 +1,119 @@
+// Package http exposes the use cases over a versioned JSON REST API.
+package http
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"transaction/internal/usecase/feed"
+	"transaction/internal/usecase/strategy"
+	"transaction/pkg/logger"
+)
+
+// shutdownTimeout is how long in-flight requests may take to finish once the
+// server is asked to stop.
+const shutdownTimeout = 10 * time.Second
+
+// Server serves the REST API under /api/v1.
+type Server struct {
+	engine  *gin.Engine
+	streams *StreamHandler
+	logger  logger.Logger
+}
+
+// NewServer creates a new instance of Server routing requests to the services.
+// The live feed is optional and only served when the price monitor runs in
+// the same process.
+func NewServer(strategies *strategy.StrategyService, live *feed.FeedService, logger logger.Logger) *Server {
+	gin.SetMode(gin.ReleaseMode)
+
+	engine := gin.New()
+	engine.HandleMethodNotAllowed = true
+	engine.Use(logRequests(logger), recoverPanics(logger))
+	engine.NoRoute(func(c *gin.Context) {
+		fail(c, http.StatusNotFound, ErrCodeNotFound, "no route for "+c.Request.Method+" "+c.Request.URL.Path, nil)
+	})
+	engine.NoMethod(func(c *gin.Context) {
+		fail(c, http.StatusMethodNotAllowed, ErrCodeMethodNotAllowed, "method "+c.Request.Method+" is not allowed on "+c.Request.URL.Path, nil)
+	})
+
+	v1 := engine.Group("/api/v1")
+	NewStrategyHandler(strategies, logger).register(v1)
+	streams := NewStreamHandler(live, logger)
+	streams.register(v1)
+
+	return &Server{engine: engine, streams: streams, logger: logger}
+}
+
+// Handler returns the http.Handler serving the API.
+func (s *Server) Handler() http.Handler {
+	return s.engine
+}
+
+// ListenAndServe serves the API on addr until ctx is cancelled, then ends the
+// open streams and waits for in-flight requests to finish. Requests run with
+// the values of ctx, such as the actor recorded in the audit log, but are not
+// cancelled along with it.
+func (s *Server) ListenAndServe(ctx context.Context, addr string) error {
+	server := &http.Server{
+		Addr:              addr,
+		Handler:           s.engine,
+		ReadHeaderTimeout: 10 * time.Second,
+		BaseContext:       func(net.Listener) context.Context { return context.WithoutCancel(ctx) },
+	}
+	server.RegisterOnShutdown(s.streams.close)
+
+	errCh := make(chan error, 1)
+	go func() {
+		s.logger.Info("REST API listening", "addr", addr)
+		errCh <- server.ListenAndServe()
+	}()
+
+	select {
+	case err := <-errCh:
+		return err
+	case <-ctx.Done():
+	}
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+	if err := server.Shutdown(shutdownCtx); err != nil {
+		return err
+	}
+	if err := <-errCh; !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+	return ctx.Err()
+}
+
+// logRequests logs every request with its status and duration.
+func logRequests(log logger.Logger) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		start := time.Now()
+		c.Next()
+		log.Info("HTTP request",
+			"method", c.Request.Method,
+			"path", c.Request.URL.Path,
+			"status", c.Writer.Status(),
+			"duration", time.Since(start).Round(time.Microsecond),
+		)
+	}
+}
+
+// recoverPanics turns a panicking handler into an internal error response
+// instead of dropping the connection.
+func recoverPanics(log logger.Logger) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		defer func() {
+			if r := recover(); r != nil {
+				log.Error("Request handler panicked", "method", c.Request.Method, "path", c.Request.URL.Path, "panic", r)
+				fail(c, http.StatusInternalServerError, ErrCodeInternalError, "internal server error", nil)
+			}
+		}()
+		c.Next()
+	}
+}
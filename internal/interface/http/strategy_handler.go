@@ -0,0 +1,282 @@
+package http
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/shopspring/decimal"
+	"transaction/internal/usecase/strategy"
+	"transaction/pkg/logger"
+)
+
+// createStrategyBody is the body of POST /strategies. Prices may be given as
+// JSON numbers or strings; the cooldown is a duration such as "15m".
+type createStrategyBody struct {
+	Symbol    string           `json:"symbol"`
+	BuyLower  *decimal.Decimal `json:"buy_lower"`
+	SellUpper *decimal.Decimal `json:"sell_upper"`
+	Cooldown  *string          `json:"cooldown"`
+}
+
+// updateStrategyBody is the body of PUT /strategies/{id}. Omitted fields keep
+// their current value.
+type updateStrategyBody struct {
+	Symbol          *string          `json:"symbol"`
+	BuyLower        *decimal.Decimal `json:"buy_lower"`
+	SellUpper       *decimal.Decimal `json:"sell_upper"`
+	Cooldown        *string          `json:"cooldown"`
+	ExpectedVersion *int             `json:"expected_version"`
+}
+
+// strategyJSON is a strategy as returned by the API.
+type strategyJSON struct {
+	ID        string          `json:"id"`
+	Symbol    string          `json:"symbol"`
+	BuyLower  decimal.Decimal `json:"buy_lower"`
+	SellUpper decimal.Decimal `json:"sell_upper"`
+	Cooldown  string          `json:"cooldown"`
+	IsActive  bool            `json:"is_active"`
+	Version   int             `json:"version"`
+	CreatedAt time.Time       `json:"created_at"`
+	DeletedAt *time.Time      `json:"deleted_at,omitempty"`
+}
+
+// StrategyHandler serves the strategy endpoints.
+type StrategyHandler struct {
+	svc    *strategy.StrategyService
+	logger logger.Logger
+}
+
+// NewStrategyHandler creates a new instance of StrategyHandler.
+func NewStrategyHandler(svc *strategy.StrategyService, logger logger.Logger) *StrategyHandler {
+	return &StrategyHandler{svc: svc, logger: logger}
+}
+
+// register adds the strategy routes to group.
+func (h *StrategyHandler) register(group *gin.RouterGroup) {
+	group.GET("/strategies", h.List)
+	group.POST("/strategies", h.Create)
+	group.GET("/strategies/:id", h.Get)
+	group.PUT("/strategies/:id", h.Update)
+	group.DELETE("/strategies/:id", h.Delete)
+	group.POST("/strategies/:id/toggle", h.Toggle)
+}
+
+// List handles GET /strategies, filtered and paged by the query parameters
+// symbol, active, created_after, created_before, sort, desc, page, page_size
+// and include_deleted.
+func (h *StrategyHandler) List(c *gin.Context) {
+	req := &strategy.ListStrategiesRequest{Symbol: c.Query("symbol"), SortBy: c.Query("sort")}
+
+	if raw, ok := c.GetQuery("active"); ok {
+		var active bool
+		if !parseQuery(c, "active", raw, &active) {
+			return
+		}
+		req.Active = &active
+	}
+	params := []struct {
+		name string
+		dest interface{}
+	}{
+		{"desc", &req.Descending},
+		{"include_deleted", &req.IncludeDeleted},
+		{"created_after", &req.CreatedAfter},
+		{"created_before", &req.CreatedBefore},
+		{"page", &req.Page},
+		{"page_size", &req.PageSize},
+	}
+	for _, param := range params {
+		if raw, ok := c.GetQuery(param.name); ok && !parseQuery(c, param.name, raw, param.dest) {
+			return
+		}
+	}
+
+	results, err := h.svc.ListStrategies(c.Request.Context(), req)
+	if err != nil {
+		failWith(c, err, h.logger)
+		return
+	}
+
+	strategies := make([]strategyJSON, len(results))
+	for i, result := range results {
+		strategies[i] = toJSON(result)
+	}
+	respond(c, http.StatusOK, strategies)
+}
+
+// Create handles POST /strategies.
+func (h *StrategyHandler) Create(c *gin.Context) {
+	var body createStrategyBody
+	if !decodeBody(c, &body) {
+		return
+	}
+
+	switch {
+	case body.Symbol == "":
+		invalidField(c, "symbol", "symbol is required")
+		return
+	case body.BuyLower == nil:
+		invalidField(c, "buy_lower", "buy_lower is required")
+		return
+	case body.SellUpper == nil:
+		invalidField(c, "sell_upper", "sell_upper is required")
+		return
+	}
+
+	req := &strategy.CreateStrategyRequest{
+		Symbol:    body.Symbol,
+		BuyLower:  *body.BuyLower,
+		SellUpper: *body.SellUpper,
+	}
+	cooldown, ok := parseCooldown(c, body.Cooldown)
+	if !ok {
+		return
+	}
+	req.Cooldown = cooldown
+
+	result, err := h.svc.CreateStrategy(c.Request.Context(), req)
+	if err != nil {
+		failWith(c, err, h.logger)
+		return
+	}
+	respond(c, http.StatusCreated, toJSON(result))
+}
+
+// Get handles GET /strategies/{id}.
+func (h *StrategyHandler) Get(c *gin.Context) {
+	result, err := h.svc.GetStrategy(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		failWith(c, err, h.logger)
+		return
+	}
+	respond(c, http.StatusOK, toJSON(result))
+}
+
+// Update handles PUT /strategies/{id}, a partial update of the fields present
+// in the body. With expected_version the update is rejected if the strategy
+// has changed since that version.
+func (h *StrategyHandler) Update(c *gin.Context) {
+	var body updateStrategyBody
+	if !decodeBody(c, &body) {
+		return
+	}
+
+	req := &strategy.UpdateStrategyRequest{
+		ID:              c.Param("id"),
+		Symbol:          body.Symbol,
+		BuyLower:        body.BuyLower,
+		SellUpper:       body.SellUpper,
+		ExpectedVersion: body.ExpectedVersion,
+	}
+	cooldown, ok := parseCooldown(c, body.Cooldown)
+	if !ok {
+		return
+	}
+	req.Cooldown = cooldown
+
+	result, err := h.svc.UpdateStrategy(c.Request.Context(), req)
+	if err != nil {
+		failWith(c, err, h.logger)
+		return
+	}
+	respond(c, http.StatusOK, toJSON(result))
+}
+
+// Delete handles DELETE /strategies/{id}.
+func (h *StrategyHandler) Delete(c *gin.Context) {
+	if err := h.svc.DeleteStrategy(c.Request.Context(), c.Param("id")); err != nil {
+		failWith(c, err, h.logger)
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
+
+// Toggle handles POST /strategies/{id}/toggle, switching the strategy between
+// active and inactive.
+func (h *StrategyHandler) Toggle(c *gin.Context) {
+	result, err := h.svc.ToggleStrategy(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		failWith(c, err, h.logger)
+		return
+	}
+	respond(c, http.StatusOK, toJSON(result))
+}
+
+// decodeBody reads the JSON request body into dest, rejecting unknown fields
+// so misspelled ones are not silently ignored. It reports whether it succeeded
+// and writes the error response if it did not.
+func decodeBody(c *gin.Context, dest interface{}) bool {
+	decoder := json.NewDecoder(c.Request.Body)
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(dest); err != nil {
+		if errors.Is(err, io.EOF) {
+			fail(c, http.StatusBadRequest, ErrCodeValidationFailed, "request body is required", nil)
+		} else {
+			fail(c, http.StatusBadRequest, ErrCodeValidationFailed, "invalid request body: "+err.Error(), nil)
+		}
+		return false
+	}
+	return true
+}
+
+// parseQuery parses the query parameter name into dest, a *bool, *int or
+// *time.Time. It reports whether it succeeded and writes the error response if
+// it did not.
+func parseQuery(c *gin.Context, name, raw string, dest interface{}) bool {
+	var err error
+	var hint string
+	switch dest := dest.(type) {
+	case *bool:
+		*dest, err = strconv.ParseBool(raw)
+		hint = "use true or false"
+	case *int:
+		*dest, err = strconv.Atoi(raw)
+		hint = "must be a whole number"
+	case *time.Time:
+		*dest, err = time.Parse(time.RFC3339, raw)
+		hint = "use RFC 3339, e.g. 2024-01-31T00:00:00Z"
+	default:
+		panic(fmt.Sprintf("unsupported query parameter type %T", dest))
+	}
+	if err != nil {
+		invalidField(c, name, fmt.Sprintf("invalid %s %q: %s", name, raw, hint))
+		return false
+	}
+	return true
+}
+
+// parseCooldown parses an optional cooldown duration. It reports whether it
+// succeeded and writes the error response if it did not.
+func parseCooldown(c *gin.Context, raw *string) (*time.Duration, bool) {
+	if raw == nil {
+		return nil, true
+	}
+	cooldown, err := time.ParseDuration(*raw)
+	if err != nil {
+		invalidField(c, "cooldown", fmt.Sprintf("invalid cooldown %q: use a duration such as 15m or 1h", *raw))
+		return nil, false
+	}
+	return &cooldown, true
+}
+
+// toJSON converts a StrategyResponse to its API representation.
+func toJSON(s *strategy.StrategyResponse) strategyJSON {
+	return strategyJSON{
+		ID:        s.ID,
+		Symbol:    s.Symbol,
+		BuyLower:  s.BuyLower,
+		SellUpper: s.SellUpper,
+		Cooldown:  s.Cooldown.String(),
+		IsActive:  s.IsActive,
+		Version:   s.Version,
+		CreatedAt: s.CreatedAt,
+		DeletedAt: s.DeletedAt,
+	}
+}
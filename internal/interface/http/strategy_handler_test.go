@@ -0,0 +1,323 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"transaction/internal/adapter/repository/memory"
+	"transaction/internal/testutil"
+	"transaction/internal/usecase/strategy"
+)
+
+// testResponse is a response envelope with the data left undecoded.
+type testResponse struct {
+	Success bool            `json:"success"`
+	Data    json.RawMessage `json:"data"`
+	Error   *ErrorDetail    `json:"error"`
+}
+
+// setupServer returns a server backed by an empty in-memory strategy repository.
+func setupServer(t *testing.T) (http.Handler, *testutil.MockLogger) {
+	t.Helper()
+	mockLogger := testutil.NewMockLogger()
+
+	svc := strategy.NewStrategyService(memory.NewStrategyRepository(), testutil.NewMockMarkets(), testutil.NewMockAudits(), testutil.PassthroughTransactor{}, mockLogger)
+	return NewServer(svc, nil, mockLogger).Handler(), mockLogger
+}
+
+// do sends a request to handler and decodes the response envelope, which is
+// empty for 204 No Content.
+func do(t *testing.T, handler http.Handler, method, path, body string) (int, testResponse) {
+	t.Helper()
+	return doRequest(t, handler, httptest.NewRequest(method, path, strings.NewReader(body)))
+}
+
+func doRequest(t *testing.T, handler http.Handler, req *http.Request) (int, testResponse) {
+	t.Helper()
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+
+	var resp testResponse
+	if rec.Code != http.StatusNoContent {
+		require.Equal(t, "application/json; charset=utf-8", rec.Header().Get("Content-Type"))
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp), rec.Body.String())
+	}
+	return rec.Code, resp
+}
+
+// create creates a BTC/USDT strategy and returns it.
+func create(t *testing.T, handler http.Handler) strategyJSON {
+	t.Helper()
+	code, resp := do(t, handler, http.MethodPost, "/api/v1/strategies",
+		`{"symbol": "btc", "buy_lower": 30000, "sell_upper": "50000.5", "cooldown": "15m"}`)
+	require.Equal(t, http.StatusCreated, code)
+
+	var created strategyJSON
+	require.NoError(t, json.Unmarshal(resp.Data, &created))
+	return created
+}
+
+func TestCreateStrategy_Success(t *testing.T) {
+	handler, _ := setupServer(t)
+
+	code, resp := do(t, handler, http.MethodPost, "/api/v1/strategies",
+		`{"symbol": "btc", "buy_lower": 30000, "sell_upper": "50000.5", "cooldown": "15m"}`)
+
+	assert.Equal(t, http.StatusCreated, code)
+	assert.True(t, resp.Success)
+	assert.Nil(t, resp.Error)
+
+	var created map[string]interface{}
+	require.NoError(t, json.Unmarshal(resp.Data, &created))
+	assert.NotEmpty(t, created["id"])
+	assert.Equal(t, "BTC/USDT", created["symbol"])
+	assert.Equal(t, "30000", created["buy_lower"])
+	assert.Equal(t, "50000.5", created["sell_upper"])
+	assert.Equal(t, "15m0s", created["cooldown"])
+	assert.Equal(t, true, created["is_active"])
+	assert.Equal(t, float64(1), created["version"])
+	assert.NotContains(t, created, "deleted_at")
+}
+
+func TestCreateStrategy_ValidationErrors(t *testing.T) {
+	handler, _ := setupServer(t)
+
+	tests := []struct {
+		name   string
+		body   string
+		code   string
+		field  string
+		status int
+	}{
+		{"empty body", ``, ErrCodeValidationFailed, "", http.StatusBadRequest},
+		{"malformed JSON", `{"symbol": `, ErrCodeValidationFailed, "", http.StatusBadRequest},
+		{"unknown field", `{"symbol": "BTC", "buy_lower": 1, "sell_upper": 2, "sell_lower": 3}`, ErrCodeValidationFailed, "", http.StatusBadRequest},
+		{"missing symbol", `{"buy_lower": 30000, "sell_upper": 50000}`, ErrCodeValidationFailed, "symbol", http.StatusBadRequest},
+		{"missing sell upper", `{"symbol": "BTC", "buy_lower": 30000}`, ErrCodeValidationFailed, "sell_upper", http.StatusBadRequest},
+		{"invalid cooldown", `{"symbol": "BTC", "buy_lower": 30000, "sell_upper": 50000, "cooldown": "soon"}`, ErrCodeValidationFailed, "cooldown", http.StatusBadRequest},
+		{"inverted range", `{"symbol": "BTC", "buy_lower": 50000, "sell_upper": 30000}`, ErrCodeInvalidStrategy, "", http.StatusBadRequest},
+		{"off tick size", `{"symbol": "BTC", "buy_lower": 30000.001, "sell_upper": 50000}`, ErrCodeInvalidStrategy, "", http.StatusBadRequest},
+		{"unknown symbol", `{"symbol": "DOGE", "buy_lower": 1, "sell_upper": 2}`, ErrCodeInvalidStrategy, "", http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			code, resp := do(t, handler, http.MethodPost, "/api/v1/strategies", tt.body)
+
+			assert.Equal(t, tt.status, code)
+			assert.False(t, resp.Success)
+			require.NotNil(t, resp.Error)
+			assert.Equal(t, tt.code, resp.Error.Code)
+			assert.NotEmpty(t, resp.Error.Message)
+			if tt.field != "" {
+				assert.Equal(t, map[string]interface{}{"field": tt.field}, resp.Error.Details)
+			}
+		})
+	}
+}
+
+func TestGetStrategy(t *testing.T) {
+	handler, _ := setupServer(t)
+	created := create(t, handler)
+
+	code, resp := do(t, handler, http.MethodGet, "/api/v1/strategies/"+created.ID, "")
+	assert.Equal(t, http.StatusOK, code)
+
+	var fetched strategyJSON
+	require.NoError(t, json.Unmarshal(resp.Data, &fetched))
+	assert.Equal(t, created.ID, fetched.ID)
+	assert.True(t, created.BuyLower.Equal(fetched.BuyLower))
+}
+
+func TestStrategyNotFound(t *testing.T) {
+	handler, _ := setupServer(t)
+
+	tests := []struct {
+		method string
+		path   string
+		body   string
+	}{
+		{http.MethodGet, "/api/v1/strategies/missing", ""},
+		{http.MethodPut, "/api/v1/strategies/missing", `{"buy_lower": 1}`},
+		{http.MethodDelete, "/api/v1/strategies/missing", ""},
+		{http.MethodPost, "/api/v1/strategies/missing/toggle", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.method+" "+tt.path, func(t *testing.T) {
+			code, resp := do(t, handler, tt.method, tt.path, tt.body)
+
+			assert.Equal(t, http.StatusNotFound, code)
+			assert.False(t, resp.Success)
+			require.NotNil(t, resp.Error)
+			assert.Equal(t, ErrCodeStrategyNotFound, resp.Error.Code)
+		})
+	}
+}
+
+func TestUpdateStrategy(t *testing.T) {
+	handler, _ := setupServer(t)
+	created := create(t, handler)
+
+	code, resp := do(t, handler, http.MethodPut, "/api/v1/strategies/"+created.ID,
+		`{"sell_upper": "60000", "expected_version": 1}`)
+	assert.Equal(t, http.StatusOK, code)
+
+	var updated strategyJSON
+	require.NoError(t, json.Unmarshal(resp.Data, &updated))
+	assert.Equal(t, "60000", updated.SellUpper.String())
+	assert.Equal(t, "30000", updated.BuyLower.String())
+	assert.Equal(t, 2, updated.Version)
+}
+
+func TestUpdateStrategy_Errors(t *testing.T) {
+	handler, _ := setupServer(t)
+	created := create(t, handler)
+	path := "/api/v1/strategies/" + created.ID
+
+	tests := []struct {
+		name   string
+		body   string
+		status int
+		code   string
+	}{
+		{"nothing to update", `{}`, http.StatusBadRequest, ErrCodeInvalidStrategy},
+		{"inverted range", `{"buy_lower": 70000}`, http.StatusBadRequest, ErrCodeInvalidStrategy},
+		{"invalid price", `{"buy_lower": "cheap"}`, http.StatusBadRequest, ErrCodeValidationFailed},
+		{"stale version", `{"buy_lower": 31000, "expected_version": 7}`, http.StatusConflict, ErrCodeConcurrentModification},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			code, resp := do(t, handler, http.MethodPut, path, tt.body)
+
+			assert.Equal(t, tt.status, code)
+			require.NotNil(t, resp.Error)
+			assert.Equal(t, tt.code, resp.Error.Code)
+		})
+	}
+}
+
+func TestDeleteStrategy(t *testing.T) {
+	handler, _ := setupServer(t)
+	created := create(t, handler)
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodDelete, "/api/v1/strategies/"+created.ID, nil))
+	assert.Equal(t, http.StatusNoContent, rec.Code)
+	assert.Empty(t, rec.Body.String())
+
+	code, _ := do(t, handler, http.MethodGet, "/api/v1/strategies/"+created.ID, "")
+	assert.Equal(t, http.StatusNotFound, code)
+
+	// A deleted strategy is still listed on request
+	code, resp := do(t, handler, http.MethodGet, "/api/v1/strategies?include_deleted=true", "")
+	assert.Equal(t, http.StatusOK, code)
+	var listed []strategyJSON
+	require.NoError(t, json.Unmarshal(resp.Data, &listed))
+	require.Len(t, listed, 1)
+	assert.NotNil(t, listed[0].DeletedAt)
+}
+
+func TestToggleStrategy(t *testing.T) {
+	handler, _ := setupServer(t)
+	created := create(t, handler)
+
+	code, resp := do(t, handler, http.MethodPost, "/api/v1/strategies/"+created.ID+"/toggle", "")
+	assert.Equal(t, http.StatusOK, code)
+
+	var toggled strategyJSON
+	require.NoError(t, json.Unmarshal(resp.Data, &toggled))
+	assert.False(t, toggled.IsActive)
+	assert.Equal(t, 2, toggled.Version)
+}
+
+func TestListStrategies(t *testing.T) {
+	handler, _ := setupServer(t)
+	first := create(t, handler)
+	second := create(t, handler)
+	do(t, handler, http.MethodPost, "/api/v1/strategies/"+second.ID+"/toggle", "")
+
+	code, resp := do(t, handler, http.MethodGet, "/api/v1/strategies", "")
+	assert.Equal(t, http.StatusOK, code)
+	var listed []strategyJSON
+	require.NoError(t, json.Unmarshal(resp.Data, &listed))
+	assert.Len(t, listed, 2)
+
+	code, resp = do(t, handler, http.MethodGet, "/api/v1/strategies?symbol=btc&active=true&page=1&page_size=10", "")
+	assert.Equal(t, http.StatusOK, code)
+	require.NoError(t, json.Unmarshal(resp.Data, &listed))
+	require.Len(t, listed, 1)
+	assert.Equal(t, first.ID, listed[0].ID)
+
+	// An empty result is an empty list rather than null
+	code, resp = do(t, handler, http.MethodGet, "/api/v1/strategies?created_before=2000-01-01T00:00:00Z", "")
+	assert.Equal(t, http.StatusOK, code)
+	assert.JSONEq(t, `[]`, string(resp.Data))
+}
+
+func TestListStrategies_InvalidQuery(t *testing.T) {
+	handler, _ := setupServer(t)
+
+	tests := []struct {
+		query string
+		code  string
+		field string
+	}{
+		{"active=maybe", ErrCodeValidationFailed, "active"},
+		{"page=two", ErrCodeValidationFailed, "page"},
+		{"created_after=yesterday", ErrCodeValidationFailed, "created_after"},
+		{"sort=price", ErrCodeInvalidStrategy, ""},
+		{"page=-1", ErrCodeInvalidStrategy, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			code, resp := do(t, handler, http.MethodGet, "/api/v1/strategies?"+tt.query, "")
+
+			assert.Equal(t, http.StatusBadRequest, code)
+			require.NotNil(t, resp.Error)
+			assert.Equal(t, tt.code, resp.Error.Code)
+			if tt.field != "" {
+				assert.Equal(t, map[string]interface{}{"field": tt.field}, resp.Error.Details)
+			}
+		})
+	}
+}
+
+func TestInternalError_HidesMessage(t *testing.T) {
+	handler, mockLogger := setupServer(t)
+
+	// The in-memory repository fails once the request is cancelled
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/strategies", nil).WithContext(ctx)
+
+	code, resp := doRequest(t, handler, req)
+	assert.Equal(t, http.StatusInternalServerError, code)
+	require.NotNil(t, resp.Error)
+	assert.Equal(t, ErrCodeInternalError, resp.Error.Code)
+	assert.Equal(t, "internal server error", resp.Error.Message)
+	mockLogger.AssertCalled(t, "Error", "Request failed", mock.Anything)
+}
+
+func TestUnknownRouteAndMethod(t *testing.T) {
+	handler, _ := setupServer(t)
+
+	code, resp := do(t, handler, http.MethodGet, "/api/v1/trades", "")
+	assert.Equal(t, http.StatusNotFound, code)
+	require.NotNil(t, resp.Error)
+	assert.Equal(t, ErrCodeNotFound, resp.Error.Code)
+
+	code, resp = do(t, handler, http.MethodPatch, "/api/v1/strategies", "")
+	assert.Equal(t, http.StatusMethodNotAllowed, code)
+	require.NotNil(t, resp.Error)
+	assert.Equal(t, ErrCodeMethodNotAllowed, resp.Error.Code)
+}
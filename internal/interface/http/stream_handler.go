@@ -0,0 +1,147 @@
+package http
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/shopspring/decimal"
+	"transaction/internal/domain"
+	"transaction/internal/usecase/feed"
+	"transaction/pkg/logger"
+)
+
+// heartbeatInterval is how often an idle stream sends a comment, so proxies
+// and clients do not take it for a dead connection.
+const heartbeatInterval = 15 * time.Second
+
+// priceJSON is the data of a price event.
+type priceJSON struct {
+	Symbol     string          `json:"symbol"`
+	Price      decimal.Decimal `json:"price"`
+	ObservedAt time.Time       `json:"observed_at"`
+}
+
+// signalJSON is the data of a signal event.
+type signalJSON struct {
+	ID            string          `json:"id"`
+	StrategyID    string          `json:"strategy_id"`
+	Symbol        string          `json:"symbol"`
+	Side          string          `json:"side"`
+	TriggerPrice  decimal.Decimal `json:"trigger_price"`
+	ObservedPrice decimal.Decimal `json:"observed_price"`
+	TriggeredAt   time.Time       `json:"triggered_at"`
+}
+
+// StreamHandler serves the live feed of prices and signals as Server-Sent
+// Events.
+type StreamHandler struct {
+	feed   *feed.FeedService
+	logger logger.Logger
+
+	done      chan struct{}
+	closeOnce sync.Once
+}
+
+// NewStreamHandler creates a new instance of StreamHandler. A nil feed makes
+// the stream endpoint report that the live feed is unavailable.
+func NewStreamHandler(feed *feed.FeedService, logger logger.Logger) *StreamHandler {
+	return &StreamHandler{feed: feed, logger: logger, done: make(chan struct{})}
+}
+
+// register adds the stream route to group.
+func (h *StreamHandler) register(group *gin.RouterGroup) {
+	group.GET("/stream", h.Stream)
+}
+
+// close ends every open stream, which would otherwise hold up a shutdown.
+func (h *StreamHandler) close() {
+	h.closeOnce.Do(func() { close(h.done) })
+}
+
+// Stream handles GET /stream, pushing price and signal events for the comma
+// separated symbols of the symbols query parameter, or every symbol when it is
+// omitted, until the client disconnects. A symbol that is not a trading pair
+// is rejected with 400 before the stream starts.
+//
+// A client too slow to read its events misses prices; one that would miss a
+// signal receives an error event with code SLOW_CONSUMER and is disconnected.
+func (h *StreamHandler) Stream(c *gin.Context) {
+	if h.feed == nil {
+		fail(c, http.StatusServiceUnavailable, ErrCodeFeedUnavailable, "the live feed requires the price monitor: start the server with --monitor", nil)
+		return
+	}
+
+	var symbols []string
+	if raw := c.Query("symbols"); raw != "" {
+		symbols = strings.Split(raw, ",")
+	}
+	sub, err := h.feed.Subscribe(&feed.SubscribeRequest{Symbols: symbols})
+	var validationErr *domain.ValidationError
+	switch {
+	case errors.As(err, &validationErr):
+		invalidField(c, "symbols", err.Error())
+		return
+	case err != nil:
+		failWith(c, err, h.logger)
+		return
+	}
+	defer h.feed.Unsubscribe(sub)
+
+	c.Header("Content-Type", "text/event-stream")
+	c.Header("Cache-Control", "no-cache")
+	c.Header("X-Accel-Buffering", "no")
+	c.Status(http.StatusOK)
+	c.Writer.Flush()
+
+	heartbeat := time.NewTicker(heartbeatInterval)
+	defer heartbeat.Stop()
+
+	for {
+		select {
+		case event, ok := <-sub.Events():
+			if !ok {
+				if errors.Is(sub.Err(), domain.ErrSlowConsumer) {
+					c.SSEvent("error", ErrorDetail{Code: ErrCodeSlowConsumer, Message: sub.Err().Error()})
+					c.Writer.Flush()
+				}
+				return
+			}
+			c.SSEvent(string(event.Type), toEventJSON(event))
+		case <-heartbeat.C:
+			if _, err := io.WriteString(c.Writer, ": heartbeat\n\n"); err != nil {
+				return
+			}
+		case <-c.Request.Context().Done():
+			return
+		case <-h.done:
+			return
+		}
+		c.Writer.Flush()
+	}
+}
+
+// toEventJSON converts the payload of a feed event to its JSON form.
+func toEventJSON(event *feed.Event) interface{} {
+	if event.Signal != nil {
+		s := event.Signal
+		return signalJSON{
+			ID:            s.ID,
+			StrategyID:    s.StrategyID,
+			Symbol:        s.Symbol,
+			Side:          s.Side,
+			TriggerPrice:  s.TriggerPrice,
+			ObservedPrice: s.ObservedPrice,
+			TriggeredAt:   s.TriggeredAt,
+		}
+	}
+	return priceJSON{
+		Symbol:     event.Price.Symbol,
+		Price:      event.Price.Price,
+		ObservedAt: event.Price.ObservedAt,
+	}
+}
@@ -0,0 +1,140 @@
+package http
+
+import (
+	"bufio"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"transaction/internal/adapter/repository/memory"
+	"transaction/internal/domain"
+	"transaction/internal/testutil"
+	"transaction/internal/usecase/feed"
+	"transaction/internal/usecase/strategy"
+)
+
+// testEvent is a Server-Sent Event with its data left undecoded.
+type testEvent struct {
+	Name string
+	Data string
+}
+
+// setupStream returns a running server with a live feed and the feed.
+func setupStream(t *testing.T) (*Server, *httptest.Server, *feed.FeedService) {
+	t.Helper()
+	mockLogger := testutil.NewMockLogger()
+
+	svc := strategy.NewStrategyService(memory.NewStrategyRepository(), testutil.NewMockMarkets(), testutil.NewMockAudits(), testutil.PassthroughTransactor{}, mockLogger)
+	live := feed.NewFeedService(mockLogger)
+	server := NewServer(svc, live, mockLogger)
+	ts := httptest.NewServer(server.Handler())
+	t.Cleanup(ts.Close)
+	return server, ts, live
+}
+
+// openStream connects to the stream endpoint. The subscription is active once
+// it returns.
+func openStream(t *testing.T, url string) *http.Response {
+	t.Helper()
+	resp, err := http.Get(url)
+	require.NoError(t, err)
+	t.Cleanup(func() { resp.Body.Close() })
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.Equal(t, "text/event-stream", resp.Header.Get("Content-Type"))
+	return resp
+}
+
+// readEvent reads the next event from a stream, skipping comments.
+func readEvent(t *testing.T, r *bufio.Reader) testEvent {
+	t.Helper()
+	var event testEvent
+	for {
+		line, err := r.ReadString('\n')
+		require.NoError(t, err)
+		line = strings.TrimSuffix(line, "\n")
+		switch {
+		case line == "" && event.Name != "":
+			return event
+		case strings.HasPrefix(line, "event:"):
+			event.Name = strings.TrimPrefix(line, "event:")
+		case strings.HasPrefix(line, "data:"):
+			event.Data = strings.TrimPrefix(line, "data:")
+		}
+	}
+}
+
+func TestStream_DeliversSubscribedSymbols(t *testing.T) {
+	_, ts, live := setupStream(t)
+	resp := openStream(t, ts.URL+"/api/v1/stream?symbols=btc/usdt,SOL/USDT")
+	r := bufio.NewReader(resp.Body)
+
+	observedAt := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	live.PublishPrice("ETH/USDT", decimal.NewFromInt(2500), observedAt)
+	live.PublishPrice("BTC/USDT", decimal.NewFromInt(59000), observedAt)
+	live.PublishSignal(&domain.Signal{
+		ID:            "sig-1",
+		StrategyID:    "btc-1",
+		Symbol:        "BTC/USDT",
+		Side:          domain.SideBuy,
+		TriggerPrice:  decimal.NewFromInt(60000),
+		ObservedPrice: decimal.NewFromInt(59000),
+		TriggeredAt:   observedAt,
+	})
+
+	event := readEvent(t, r)
+	assert.Equal(t, "price", event.Name)
+	assert.JSONEq(t, `{"symbol": "BTC/USDT", "price": "59000", "observed_at": "2024-05-01T12:00:00Z"}`, event.Data)
+
+	event = readEvent(t, r)
+	assert.Equal(t, "signal", event.Name)
+	var signal map[string]interface{}
+	require.NoError(t, json.Unmarshal([]byte(event.Data), &signal))
+	assert.Equal(t, "sig-1", signal["id"])
+	assert.Equal(t, "btc-1", signal["strategy_id"])
+	assert.Equal(t, "BUY", signal["side"])
+	assert.Equal(t, "60000", signal["trigger_price"])
+	assert.Equal(t, "59000", signal["observed_price"])
+}
+
+func TestStream_EndsWhenServerStops(t *testing.T) {
+	server, ts, _ := setupStream(t)
+	resp := openStream(t, ts.URL+"/api/v1/stream")
+
+	server.streams.close()
+
+	_, err := io.ReadAll(resp.Body)
+	assert.NoError(t, err)
+}
+
+func TestStream_InvalidSymbol(t *testing.T) {
+	_, ts, _ := setupStream(t)
+
+	resp, err := http.Get(ts.URL + "/api/v1/stream?symbols=BTC/USDT,BTC/USDT/ETH")
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	var body Response
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+	require.NotNil(t, body.Error)
+	assert.Equal(t, ErrCodeValidationFailed, body.Error.Code)
+	assert.Contains(t, body.Error.Message, `invalid symbol "BTC/USDT/ETH"`)
+}
+
+func TestStream_FeedUnavailable(t *testing.T) {
+	handler, _ := setupServer(t)
+
+	code, resp := do(t, handler, http.MethodGet, "/api/v1/stream", "")
+
+	assert.Equal(t, http.StatusServiceUnavailable, code)
+	assert.False(t, resp.Success)
+	require.NotNil(t, resp.Error)
+	assert.Equal(t, ErrCodeFeedUnavailable, resp.Error.Code)
+}
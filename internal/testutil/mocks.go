@@ -0,0 +1,165 @@
+// Package testutil provides the fakes shared by the tests of the use cases and
+// of the APIs built on them, so that each package does not keep its own copy.
+package testutil
+
+import (
+	"context"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/mock"
+	"transaction/internal/adapter/repository"
+	"transaction/internal/domain"
+)
+
+// MockLogger is a mock implementation of Logger.
+type MockLogger struct {
+	mock.Mock
+}
+
+// NewMockLogger returns a logger accepting every message. Calls can still be
+// asserted with AssertCalled.
+func NewMockLogger() *MockLogger {
+	log := new(MockLogger)
+	log.On("Info", mock.Anything, mock.Anything).Return().Maybe()
+	log.On("Warn", mock.Anything, mock.Anything).Return().Maybe()
+	log.On("Error", mock.Anything, mock.Anything).Return().Maybe()
+	return log
+}
+
+func (m *MockLogger) Info(msg string, args ...interface{}) {
+	m.Called(msg, args)
+}
+
+func (m *MockLogger) Error(msg string, args ...interface{}) {
+	m.Called(msg, args)
+}
+
+func (m *MockLogger) Warn(msg string, args ...interface{}) {
+	m.Called(msg, args)
+}
+
+// MockMarketRepository is a mock implementation of IMarketRepository.
+type MockMarketRepository struct {
+	mock.Mock
+}
+
+// BTCUSDT returns the BTC/USDT market used by tests, with a 0.01 tick size
+// and a 0.00001 lot size.
+func BTCUSDT() *domain.Market {
+	return domain.NewMarket("BTC", "USDT", decimal.RequireFromString("0.01"), decimal.RequireFromString("0.00001"), decimal.NewFromInt(5))
+}
+
+// NewMockMarkets returns a market registry holding markets, or BTCUSDT alone
+// when none are given. Any other symbol is not found.
+func NewMockMarkets(markets ...*domain.Market) *MockMarketRepository {
+	if len(markets) == 0 {
+		markets = []*domain.Market{BTCUSDT()}
+	}
+	registry := new(MockMarketRepository)
+	for _, market := range markets {
+		registry.On("FindBySymbol", mock.Anything, market.Symbol).Return(market, nil).Maybe()
+	}
+	registry.On("FindBySymbol", mock.Anything, mock.Anything).Return(nil, domain.ErrMarketNotFound).Maybe()
+	return registry
+}
+
+func (m *MockMarketRepository) Create(ctx context.Context, market *domain.Market) (*domain.Market, error) {
+	args := m.Called(ctx, market)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Market), args.Error(1)
+}
+
+func (m *MockMarketRepository) FindBySymbol(ctx context.Context, symbol string) (*domain.Market, error) {
+	args := m.Called(ctx, symbol)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Market), args.Error(1)
+}
+
+func (m *MockMarketRepository) FindAll(ctx context.Context) ([]*domain.Market, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.Market), args.Error(1)
+}
+
+func (m *MockMarketRepository) Update(ctx context.Context, market *domain.Market) (*domain.Market, error) {
+	args := m.Called(ctx, market)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Market), args.Error(1)
+}
+
+// MockAuditRepository is a mock implementation of IStrategyAuditRepository.
+type MockAuditRepository struct {
+	mock.Mock
+}
+
+// NewMockAudits returns an audit log accepting every entry.
+func NewMockAudits() *MockAuditRepository {
+	audits := new(MockAuditRepository)
+	audits.On("Append", mock.Anything, mock.Anything).Return(nil).Maybe()
+	return audits
+}
+
+func (m *MockAuditRepository) Append(ctx context.Context, entry *domain.StrategyAudit) error {
+	args := m.Called(ctx, entry)
+	return args.Error(0)
+}
+
+func (m *MockAuditRepository) FindByStrategyID(ctx context.Context, strategyID string) ([]*domain.StrategyAudit, error) {
+	args := m.Called(ctx, strategyID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.StrategyAudit), args.Error(1)
+}
+
+// MockSignalRepository is a mock implementation of ISignalRepository.
+type MockSignalRepository struct {
+	mock.Mock
+}
+
+func (m *MockSignalRepository) Create(ctx context.Context, signal *domain.Signal) (*domain.Signal, error) {
+	args := m.Called(ctx, signal)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Signal), args.Error(1)
+}
+
+func (m *MockSignalRepository) FindByID(ctx context.Context, id string) (*domain.Signal, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Signal), args.Error(1)
+}
+
+func (m *MockSignalRepository) Find(ctx context.Context, filter repository.SignalFilter) ([]*domain.Signal, error) {
+	args := m.Called(ctx, filter)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.Signal), args.Error(1)
+}
+
+func (m *MockSignalRepository) Update(ctx context.Context, signal *domain.Signal) (*domain.Signal, error) {
+	args := m.Called(ctx, signal)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Signal), args.Error(1)
+}
+
+// PassthroughTransactor runs the unit of work without a real transaction.
+type PassthroughTransactor struct{}
+
+func (PassthroughTransactor) WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
@@ -0,0 +1,50 @@
+package feed
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// EventType distinguishes the events of the live feed.
+type EventType string
+
+const (
+	// EventPrice is a price observed by the monitor.
+	EventPrice EventType = "price"
+
+	// EventSignal is a signal triggered by a strategy.
+	EventSignal EventType = "signal"
+)
+
+// SubscribeRequest represents the request to follow the live feed.
+type SubscribeRequest struct {
+	Symbols []string // Optional: only prices and signals of these symbols, every symbol when empty
+	Buffer  int      // Optional: events held for a client that has not read them yet, defaults to DefaultBuffer
+}
+
+// Event is a price or a signal delivered to a subscriber. Exactly one of
+// Price and Signal is set, according to Type.
+type Event struct {
+	Type   EventType
+	Price  *PriceResponse
+	Signal *SignalResponse
+}
+
+// PriceResponse represents a price observed by the monitor.
+type PriceResponse struct {
+	Symbol     string          // BTC, ETH, USDT, etc.
+	Price      decimal.Decimal // Market price
+	ObservedAt time.Time       // When the monitor fetched the price
+}
+
+// SignalResponse represents a signal as it is triggered.
+type SignalResponse struct {
+	ID            string          // Unique identifier
+	StrategyID    string          // Strategy that triggered the signal
+	Symbol        string          // BTC, ETH, USDT, etc.
+	Side          string          // BUY or SELL
+	TriggerPrice  decimal.Decimal // Strategy bound that was crossed
+	ObservedPrice decimal.Decimal // Market price that crossed the bound
+	TriggeredAt   time.Time       // When the monitor observed the trigger
+}
@@ -0,0 +1,180 @@
+package feed
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"transaction/internal/domain"
+	"transaction/pkg/logger"
+
+	"github.com/shopspring/decimal"
+)
+
+// DefaultBuffer is how many events are held for a subscriber that has not
+// read them yet.
+const DefaultBuffer = 64
+
+// FeedService fans the prices and signals observed by the price monitor out to
+// the clients following them live.
+//
+// Publishing never waits for a client. A price is skipped for a client whose
+// buffer is full, since a newer one follows on the next check, but a signal is
+// never skipped: a client that cannot take one is disconnected with
+// domain.ErrSlowConsumer instead, and can catch up from the recorded signals.
+type FeedService struct {
+	mu          sync.RWMutex
+	subscribers map[*Subscription]struct{}
+	logger      logger.Logger
+}
+
+// NewFeedService creates a new instance of FeedService.
+func NewFeedService(logger logger.Logger) *FeedService {
+	return &FeedService{
+		subscribers: make(map[*Subscription]struct{}),
+		logger:      logger,
+	}
+}
+
+// Subscription is a client of the live feed.
+type Subscription struct {
+	symbols map[string]bool
+	events  chan *Event
+	dropped atomic.Int64
+	err     error
+}
+
+// Events delivers the events of the subscription in the order they were
+// published. It is closed when the subscription ends.
+func (s *Subscription) Events() <-chan *Event {
+	return s.events
+}
+
+// Err returns domain.ErrSlowConsumer once Events is closed because the client
+// fell behind, and nil otherwise.
+func (s *Subscription) Err() error {
+	return s.err
+}
+
+// Dropped returns how many prices were skipped because the client fell behind.
+func (s *Subscription) Dropped() int64 {
+	return s.dropped.Load()
+}
+
+// wants reports whether the client follows symbol.
+func (s *Subscription) wants(symbol string) bool {
+	return len(s.symbols) == 0 || s.symbols[domain.NormalizeSymbol(symbol)]
+}
+
+// Subscribe starts delivering the events published from now on. The caller
+// must end the subscription with Unsubscribe. Symbols may be written in any
+// form accepted by domain.ParseSymbol; one that is not a trading pair is a
+// validation error.
+func (s *FeedService) Subscribe(req *SubscribeRequest) (*Subscription, error) {
+	buffer := req.Buffer
+	if buffer == 0 {
+		buffer = DefaultBuffer
+	}
+	if buffer < 0 {
+		return nil, domain.Invalid(fmt.Errorf("buffer must be positive, got %d", buffer))
+	}
+
+	symbols := make(map[string]bool, len(req.Symbols))
+	for _, symbol := range req.Symbols {
+		if strings.TrimSpace(symbol) == "" {
+			continue
+		}
+		normalized, err := domain.ParseSymbol(symbol)
+		if err != nil {
+			return nil, domain.Invalid(err)
+		}
+		symbols[normalized] = true
+	}
+
+	sub := &Subscription{symbols: symbols, events: make(chan *Event, buffer)}
+
+	s.mu.Lock()
+	s.subscribers[sub] = struct{}{}
+	count := len(s.subscribers)
+	s.mu.Unlock()
+
+	s.logger.Info("Live feed client subscribed", "symbols", strings.Join(req.Symbols, ","), "subscribers", count)
+	return sub, nil
+}
+
+// Unsubscribe ends a subscription and closes its Events channel. Ending it
+// again has no effect.
+func (s *FeedService) Unsubscribe(sub *Subscription) {
+	s.remove(sub, nil)
+}
+
+// PublishPrice delivers a price observed by the monitor to the clients
+// following its symbol.
+func (s *FeedService) PublishPrice(symbol string, price decimal.Decimal, observedAt time.Time) {
+	s.publish(symbol, &Event{
+		Type:  EventPrice,
+		Price: &PriceResponse{Symbol: symbol, Price: price, ObservedAt: observedAt},
+	}, false)
+}
+
+// PublishSignal delivers a triggered signal to the clients following its symbol.
+func (s *FeedService) PublishSignal(signal *domain.Signal) {
+	s.publish(signal.Symbol, &Event{
+		Type: EventSignal,
+		Signal: &SignalResponse{
+			ID:            signal.ID,
+			StrategyID:    signal.StrategyID,
+			Symbol:        signal.Symbol,
+			Side:          string(signal.Side),
+			TriggerPrice:  signal.TriggerPrice,
+			ObservedPrice: signal.ObservedPrice,
+			TriggeredAt:   signal.TriggeredAt,
+		},
+	}, true)
+}
+
+// publish hands event to every client following symbol without waiting. When
+// a client's buffer is full the event is skipped, or the client disconnected
+// if the event must be delivered.
+func (s *FeedService) publish(symbol string, event *Event, mustDeliver bool) {
+	var lagging []*Subscription
+
+	s.mu.RLock()
+	for sub := range s.subscribers {
+		if !sub.wants(symbol) {
+			continue
+		}
+		select {
+		case sub.events <- event:
+		default:
+			if mustDeliver {
+				lagging = append(lagging, sub)
+			} else {
+				sub.dropped.Add(1)
+			}
+		}
+	}
+	s.mu.RUnlock()
+
+	for _, sub := range lagging {
+		if s.remove(sub, domain.ErrSlowConsumer) {
+			s.logger.Warn("Disconnected live feed client that fell behind", "dropped_prices", sub.Dropped())
+		}
+	}
+}
+
+// remove ends a subscription with err and reports whether it was still active.
+func (s *FeedService) remove(sub *Subscription, err error) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.subscribers[sub]; !ok {
+		return false
+	}
+	delete(s.subscribers, sub)
+	sub.err = err
+	close(sub.events)
+	return true
+}
@@ -0,0 +1,199 @@
+package feed
+
+import (
+	"sync"
+	"testing"
+	"time"
+	"transaction/internal/domain"
+	"transaction/internal/testutil"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestService() (*FeedService, *testutil.MockLogger) {
+	mockLogger := new(testutil.MockLogger)
+	mockLogger.On("Info", mock.Anything, mock.Anything).Return()
+	mockLogger.On("Warn", mock.Anything, mock.Anything).Return()
+	return NewFeedService(mockLogger), mockLogger
+}
+
+func testSignal(symbol string) *domain.Signal {
+	return &domain.Signal{
+		ID:            "sig-1",
+		StrategyID:    "btc-1",
+		Symbol:        symbol,
+		Side:          domain.SideBuy,
+		TriggerPrice:  decimal.NewFromInt(60000),
+		ObservedPrice: decimal.NewFromInt(59000),
+		TriggeredAt:   time.Now(),
+	}
+}
+
+// drain returns the events held for sub without waiting for more.
+func drain(sub *Subscription) []*Event {
+	var events []*Event
+	for {
+		select {
+		case event, ok := <-sub.Events():
+			if !ok {
+				return events
+			}
+			events = append(events, event)
+		default:
+			return events
+		}
+	}
+}
+
+func TestPublish_DeliversInOrder(t *testing.T) {
+	svc, _ := newTestService()
+	sub, err := svc.Subscribe(&SubscribeRequest{})
+	require.NoError(t, err)
+	defer svc.Unsubscribe(sub)
+
+	svc.PublishPrice("BTC", decimal.NewFromInt(59000), time.Now())
+	svc.PublishSignal(testSignal("BTC"))
+
+	events := drain(sub)
+	require.Len(t, events, 2)
+	assert.Equal(t, EventPrice, events[0].Type)
+	assert.Equal(t, "BTC", events[0].Price.Symbol)
+	assert.True(t, events[0].Price.Price.Equal(decimal.NewFromInt(59000)))
+	assert.Equal(t, EventSignal, events[1].Type)
+	assert.Equal(t, "sig-1", events[1].Signal.ID)
+	assert.Equal(t, "BUY", events[1].Signal.Side)
+}
+
+func TestPublish_FiltersBySymbol(t *testing.T) {
+	svc, _ := newTestService()
+	sub, err := svc.Subscribe(&SubscribeRequest{Symbols: []string{" btc ", "SOL"}})
+	require.NoError(t, err)
+	defer svc.Unsubscribe(sub)
+
+	svc.PublishPrice("BTC", decimal.NewFromInt(59000), time.Now())
+	svc.PublishPrice("ETH", decimal.NewFromInt(2500), time.Now())
+	svc.PublishSignal(testSignal("ETH"))
+	svc.PublishSignal(testSignal("BTC"))
+
+	events := drain(sub)
+	require.Len(t, events, 2)
+	assert.Equal(t, "BTC", events[0].Price.Symbol)
+	assert.Equal(t, "BTC", events[1].Signal.Symbol)
+}
+
+func TestPublish_MatchesAnySpellingOfTheSymbol(t *testing.T) {
+	svc, _ := newTestService()
+	sub, err := svc.Subscribe(&SubscribeRequest{Symbols: []string{"btc-usdt", "eth_usdt", ""}})
+	require.NoError(t, err)
+	defer svc.Unsubscribe(sub)
+
+	svc.PublishPrice("BTC/USDT", decimal.NewFromInt(59000), time.Now())
+	svc.PublishPrice("ETH/USDT", decimal.NewFromInt(2500), time.Now())
+	svc.PublishPrice("SOL/USDT", decimal.NewFromInt(150), time.Now())
+
+	events := drain(sub)
+	require.Len(t, events, 2)
+	assert.Equal(t, "BTC/USDT", events[0].Price.Symbol)
+	assert.Equal(t, "ETH/USDT", events[1].Price.Symbol)
+}
+
+func TestSubscribe_InvalidSymbol(t *testing.T) {
+	svc, _ := newTestService()
+
+	sub, err := svc.Subscribe(&SubscribeRequest{Symbols: []string{"BTC/USDT", "BTC/USDT/ETH"}})
+
+	assert.Nil(t, sub)
+	var validationErr *domain.ValidationError
+	assert.ErrorAs(t, err, &validationErr)
+	assert.ErrorContains(t, err, `invalid symbol "BTC/USDT/ETH"`)
+}
+
+func TestPublish_SkipsPricesForSlowClient(t *testing.T) {
+	svc, _ := newTestService()
+	slow, err := svc.Subscribe(&SubscribeRequest{Buffer: 1})
+	require.NoError(t, err)
+	defer svc.Unsubscribe(slow)
+	fast, err := svc.Subscribe(&SubscribeRequest{Buffer: 10})
+	require.NoError(t, err)
+	defer svc.Unsubscribe(fast)
+
+	for i := 1; i <= 3; i++ {
+		svc.PublishPrice("BTC", decimal.NewFromInt(int64(i)), time.Now())
+	}
+
+	assert.Len(t, drain(slow), 1)
+	assert.Equal(t, int64(2), slow.Dropped())
+	assert.Len(t, drain(fast), 3)
+	assert.Equal(t, int64(0), fast.Dropped())
+}
+
+func TestPublish_DisconnectsClientMissingSignal(t *testing.T) {
+	svc, mockLogger := newTestService()
+	sub, err := svc.Subscribe(&SubscribeRequest{Buffer: 1})
+	require.NoError(t, err)
+
+	svc.PublishPrice("BTC", decimal.NewFromInt(59000), time.Now())
+	svc.PublishSignal(testSignal("BTC"))
+
+	events := drain(sub)
+	require.Len(t, events, 1)
+	assert.Equal(t, EventPrice, events[0].Type)
+	_, open := <-sub.Events()
+	assert.False(t, open)
+	assert.ErrorIs(t, sub.Err(), domain.ErrSlowConsumer)
+	mockLogger.AssertCalled(t, "Warn", "Disconnected live feed client that fell behind", mock.Anything)
+
+	// Later events and ending the subscription have no effect
+	svc.PublishSignal(testSignal("BTC"))
+	svc.Unsubscribe(sub)
+}
+
+func TestUnsubscribe_ClosesEvents(t *testing.T) {
+	svc, _ := newTestService()
+	sub, err := svc.Subscribe(&SubscribeRequest{})
+	require.NoError(t, err)
+
+	svc.Unsubscribe(sub)
+	svc.Unsubscribe(sub)
+	svc.PublishPrice("BTC", decimal.NewFromInt(59000), time.Now())
+
+	_, open := <-sub.Events()
+	assert.False(t, open)
+	assert.NoError(t, sub.Err())
+}
+
+func TestSubscribe_InvalidBuffer(t *testing.T) {
+	svc, _ := newTestService()
+
+	sub, err := svc.Subscribe(&SubscribeRequest{Buffer: -1})
+
+	assert.Nil(t, sub)
+	var validationErr *domain.ValidationError
+	assert.ErrorAs(t, err, &validationErr)
+}
+
+func TestPublish_ConcurrentSubscribers(t *testing.T) {
+	svc, _ := newTestService()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			sub, err := svc.Subscribe(&SubscribeRequest{Buffer: 2})
+			if !assert.NoError(t, err) {
+				return
+			}
+			drain(sub)
+			svc.Unsubscribe(sub)
+		}()
+	}
+	for i := 0; i < 100; i++ {
+		svc.PublishPrice("BTC", decimal.NewFromInt(int64(i)), time.Now())
+		svc.PublishSignal(testSignal("BTC"))
+	}
+	wg.Wait()
+}
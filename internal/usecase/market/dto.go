@@ -0,0 +1,22 @@
+package market
+
+import "github.com/shopspring/decimal"
+
+// AddMarketRequest represents the request to register a new market.
+type AddMarketRequest struct {
+	Symbol      string          // Trading pair, e.g. BTC/USDT or btc-usdt
+	TickSize    decimal.Decimal // Smallest price increment
+	LotSize     decimal.Decimal // Smallest quantity increment
+	MinNotional decimal.Decimal // Smallest order value in the quote asset
+}
+
+// MarketResponse represents the response containing market data.
+type MarketResponse struct {
+	Symbol      string          // Trading pair, e.g. BTC/USDT
+	BaseAsset   string          // Asset being bought or sold
+	QuoteAsset  string          // Asset prices are quoted in
+	TickSize    decimal.Decimal // Smallest price increment
+	LotSize     decimal.Decimal // Smallest quantity increment
+	MinNotional decimal.Decimal // Smallest order value in the quote asset
+	Enabled     bool            // Whether new strategies may use the market
+}
@@ -0,0 +1,161 @@
+package market
+
+import (
+	"context"
+	_ "embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"transaction/internal/adapter/repository"
+	"transaction/internal/domain"
+	"transaction/pkg/logger"
+
+	"github.com/shopspring/decimal"
+)
+
+// defaultMarkets is the bundled registry seeded into an empty database.
+//
+//go:embed markets.json
+var defaultMarkets []byte
+
+// seedMarket is one entry of the bundled registry.
+type seedMarket struct {
+	Base        string          `json:"base"`
+	Quote       string          `json:"quote"`
+	TickSize    decimal.Decimal `json:"tick_size"`
+	LotSize     decimal.Decimal `json:"lot_size"`
+	MinNotional decimal.Decimal `json:"min_notional"`
+}
+
+// MarketService implements business logic for the registry of tradable markets.
+type MarketService struct {
+	repo   repository.IMarketRepository
+	logger logger.Logger
+}
+
+// NewMarketService creates a new instance of MarketService.
+func NewMarketService(repo repository.IMarketRepository, logger logger.Logger) *MarketService {
+	return &MarketService{
+		repo:   repo,
+		logger: logger,
+	}
+}
+
+// SeedDefaults registers the bundled markets that are not registered yet and
+// returns how many were added. Markets already present, including ones the
+// user disabled, are left untouched.
+func (s *MarketService) SeedDefaults(ctx context.Context) (int, error) {
+	var seeds []seedMarket
+	if err := json.Unmarshal(defaultMarkets, &seeds); err != nil {
+		return 0, fmt.Errorf("invalid bundled markets: %w", err)
+	}
+
+	added := 0
+	for _, seed := range seeds {
+		market := domain.NewMarket(seed.Base, seed.Quote, seed.TickSize, seed.LotSize, seed.MinNotional)
+		if err := market.Validate(); err != nil {
+			return added, fmt.Errorf("invalid bundled market %s: %w", market.Symbol, err)
+		}
+		if _, err := s.repo.Create(ctx, market); err != nil {
+			if errors.Is(err, domain.ErrMarketExists) {
+				continue
+			}
+			s.logger.Error("Failed to seed market", "symbol", market.Symbol, "error", err.Error())
+			return added, err
+		}
+		added++
+	}
+	if added > 0 {
+		s.logger.Info("Seeded markets", "count", added)
+	}
+	return added, nil
+}
+
+// ListMarkets retrieves all registered markets, ordered by symbol.
+func (s *MarketService) ListMarkets(ctx context.Context) ([]*MarketResponse, error) {
+	s.logger.Info("Listing markets")
+
+	markets, err := s.repo.FindAll(ctx)
+	if err != nil {
+		s.logger.Error("Failed to list markets", "error", err.Error())
+		return nil, err
+	}
+
+	responses := make([]*MarketResponse, len(markets))
+	for i, market := range markets {
+		responses[i] = toResponse(market)
+	}
+	return responses, nil
+}
+
+// AddMarket registers a new market.
+func (s *MarketService) AddMarket(ctx context.Context, req *AddMarketRequest) (*MarketResponse, error) {
+	s.logger.Info("Adding market", "symbol", req.Symbol)
+
+	parts := strings.Split(domain.NormalizeSymbol(req.Symbol), "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return nil, fmt.Errorf("invalid symbol %q: use BASE/QUOTE such as BTC/USDT", req.Symbol)
+	}
+
+	market := domain.NewMarket(parts[0], parts[1], req.TickSize, req.LotSize, req.MinNotional)
+	if err := market.Validate(); err != nil {
+		s.logger.Error("Market validation failed", "error", err.Error())
+		return nil, err
+	}
+
+	created, err := s.repo.Create(ctx, market)
+	if err != nil {
+		s.logger.Error("Failed to add market", "symbol", market.Symbol, "error", err.Error())
+		return nil, err
+	}
+
+	return toResponse(created), nil
+}
+
+// DisableMarket stops new strategies from using a market. Existing strategies
+// keep being monitored.
+func (s *MarketService) DisableMarket(ctx context.Context, symbol string) (*MarketResponse, error) {
+	return s.setEnabled(ctx, symbol, false)
+}
+
+// EnableMarket allows strategies to use a previously disabled market again.
+func (s *MarketService) EnableMarket(ctx context.Context, symbol string) (*MarketResponse, error) {
+	return s.setEnabled(ctx, symbol, true)
+}
+
+// setEnabled switches the enabled flag of a market.
+func (s *MarketService) setEnabled(ctx context.Context, symbol string, enabled bool) (*MarketResponse, error) {
+	symbol = domain.NormalizeSymbol(symbol)
+	s.logger.Info("Changing market status", "symbol", symbol, "enabled", enabled)
+
+	market, err := s.repo.FindBySymbol(ctx, symbol)
+	if err != nil {
+		s.logger.Error("Market not found", "symbol", symbol)
+		return nil, err
+	}
+
+	market.Enabled = enabled
+
+	updated, err := s.repo.Update(ctx, market)
+	if err != nil {
+		s.logger.Error("Failed to change market status", "symbol", symbol)
+		return nil, err
+	}
+
+	return toResponse(updated), nil
+}
+
+// toResponse converts a domain Market to a MarketResponse.
+func toResponse(m *domain.Market) *MarketResponse {
+	return &MarketResponse{
+		Symbol:      m.Symbol,
+		BaseAsset:   m.BaseAsset,
+		QuoteAsset:  m.QuoteAsset,
+		TickSize:    m.TickSize,
+		LotSize:     m.LotSize,
+		MinNotional: m.MinNotional,
+		Enabled:     m.Enabled,
+	}
+}
@@ -0,0 +1,165 @@
+package market
+
+import (
+	"context"
+	"testing"
+	"transaction/internal/domain"
+	"transaction/internal/testutil"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func newTestMarket(base string) *domain.Market {
+	return domain.NewMarket(base, "USDT", decimal.RequireFromString("0.01"), decimal.RequireFromString("0.001"), decimal.NewFromInt(5))
+}
+
+func TestSeedDefaults_AddsMissingMarkets(t *testing.T) {
+	mockRepo := new(testutil.MockMarketRepository)
+	mockLogger := new(testutil.MockLogger)
+	service := NewMarketService(mockRepo, mockLogger)
+
+	mockLogger.On("Info", mock.Anything, mock.Anything).Return()
+	mockRepo.On("Create", mock.Anything, mock.MatchedBy(func(m *domain.Market) bool {
+		return m.Symbol == "BTC/USDT"
+	})).Return(nil, domain.ErrMarketExists)
+	mockRepo.On("Create", mock.Anything, mock.MatchedBy(func(m *domain.Market) bool {
+		return m.Symbol != "BTC/USDT" && m.Validate() == nil && m.Enabled
+	})).Return(&domain.Market{}, nil)
+
+	added, err := service.SeedDefaults(context.Background())
+	assert.NoError(t, err)
+	assert.Positive(t, added)
+	mockRepo.AssertCalled(t, "Create", mock.Anything, mock.MatchedBy(func(m *domain.Market) bool {
+		return m.Symbol == "ETH/USDT" && m.TickSize.Equal(decimal.RequireFromString("0.01"))
+	}))
+}
+
+func TestSeedDefaults_RepositoryError(t *testing.T) {
+	mockRepo := new(testutil.MockMarketRepository)
+	mockLogger := new(testutil.MockLogger)
+	service := NewMarketService(mockRepo, mockLogger)
+
+	mockLogger.On("Error", mock.Anything, mock.Anything).Return()
+	mockRepo.On("Create", mock.Anything, mock.Anything).Return(nil, assert.AnError)
+
+	added, err := service.SeedDefaults(context.Background())
+	assert.ErrorIs(t, err, assert.AnError)
+	assert.Zero(t, added)
+}
+
+func TestAddMarket_Success(t *testing.T) {
+	mockRepo := new(testutil.MockMarketRepository)
+	mockLogger := new(testutil.MockLogger)
+	service := NewMarketService(mockRepo, mockLogger)
+
+	mockLogger.On("Info", mock.Anything, mock.Anything).Return()
+	mockRepo.On("Create", mock.Anything, mock.MatchedBy(func(m *domain.Market) bool {
+		return m.Symbol == "PEPE/USDT" && m.BaseAsset == "PEPE" && m.QuoteAsset == "USDT" && m.Enabled
+	})).Return(newTestMarket("PEPE"), nil)
+
+	resp, err := service.AddMarket(context.Background(), &AddMarketRequest{
+		Symbol:      "pepe-usdt",
+		TickSize:    decimal.RequireFromString("0.01"),
+		LotSize:     decimal.RequireFromString("0.001"),
+		MinNotional: decimal.NewFromInt(5),
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "PEPE/USDT", resp.Symbol)
+}
+
+func TestAddMarket_InvalidSymbol(t *testing.T) {
+	mockRepo := new(testutil.MockMarketRepository)
+	mockLogger := new(testutil.MockLogger)
+	service := NewMarketService(mockRepo, mockLogger)
+
+	mockLogger.On("Info", mock.Anything, mock.Anything).Return()
+
+	resp, err := service.AddMarket(context.Background(), &AddMarketRequest{
+		Symbol:   "BTC/USDT/EUR",
+		TickSize: decimal.RequireFromString("0.01"),
+		LotSize:  decimal.RequireFromString("0.001"),
+	})
+	assert.Error(t, err)
+	assert.Nil(t, resp)
+	mockRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+}
+
+func TestAddMarket_InvalidTickSize(t *testing.T) {
+	mockRepo := new(testutil.MockMarketRepository)
+	mockLogger := new(testutil.MockLogger)
+	service := NewMarketService(mockRepo, mockLogger)
+
+	mockLogger.On("Info", mock.Anything, mock.Anything).Return()
+	mockLogger.On("Error", mock.Anything, mock.Anything).Return()
+
+	resp, err := service.AddMarket(context.Background(), &AddMarketRequest{
+		Symbol:  "PEPE/USDT",
+		LotSize: decimal.RequireFromString("0.001"),
+	})
+	assert.EqualError(t, err, "tick size must be positive")
+	assert.Nil(t, resp)
+}
+
+func TestAddMarket_Duplicate(t *testing.T) {
+	mockRepo := new(testutil.MockMarketRepository)
+	mockLogger := new(testutil.MockLogger)
+	service := NewMarketService(mockRepo, mockLogger)
+
+	mockLogger.On("Info", mock.Anything, mock.Anything).Return()
+	mockLogger.On("Error", mock.Anything, mock.Anything).Return()
+	mockRepo.On("Create", mock.Anything, mock.Anything).Return(nil, domain.ErrMarketExists)
+
+	resp, err := service.AddMarket(context.Background(), &AddMarketRequest{
+		Symbol:   "BTC/USDT",
+		TickSize: decimal.RequireFromString("0.01"),
+		LotSize:  decimal.RequireFromString("0.001"),
+	})
+	assert.ErrorIs(t, err, domain.ErrMarketExists)
+	assert.Nil(t, resp)
+}
+
+func TestListMarkets_Success(t *testing.T) {
+	mockRepo := new(testutil.MockMarketRepository)
+	mockLogger := new(testutil.MockLogger)
+	service := NewMarketService(mockRepo, mockLogger)
+
+	mockLogger.On("Info", mock.Anything, mock.Anything).Return()
+	mockRepo.On("FindAll", mock.Anything).Return([]*domain.Market{newTestMarket("BTC"), newTestMarket("ETH")}, nil)
+
+	resp, err := service.ListMarkets(context.Background())
+	assert.NoError(t, err)
+	assert.Len(t, resp, 2)
+	assert.Equal(t, "ETH/USDT", resp[1].Symbol)
+}
+
+func TestDisableMarket_Success(t *testing.T) {
+	mockRepo := new(testutil.MockMarketRepository)
+	mockLogger := new(testutil.MockLogger)
+	service := NewMarketService(mockRepo, mockLogger)
+
+	mockLogger.On("Info", mock.Anything, mock.Anything).Return()
+	mockRepo.On("FindBySymbol", mock.Anything, "BTC/USDT").Return(newTestMarket("BTC"), nil)
+	mockRepo.On("Update", mock.Anything, mock.MatchedBy(func(m *domain.Market) bool {
+		return m.Symbol == "BTC/USDT" && !m.Enabled
+	})).Return(&domain.Market{Symbol: "BTC/USDT"}, nil)
+
+	resp, err := service.DisableMarket(context.Background(), "btc")
+	assert.NoError(t, err)
+	assert.False(t, resp.Enabled)
+}
+
+func TestEnableMarket_NotFound(t *testing.T) {
+	mockRepo := new(testutil.MockMarketRepository)
+	mockLogger := new(testutil.MockLogger)
+	service := NewMarketService(mockRepo, mockLogger)
+
+	mockLogger.On("Info", mock.Anything, mock.Anything).Return()
+	mockLogger.On("Error", mock.Anything, mock.Anything).Return()
+	mockRepo.On("FindBySymbol", mock.Anything, "NOPE/USDT").Return(nil, domain.ErrMarketNotFound)
+
+	resp, err := service.EnableMarket(context.Background(), "NOPE/USDT")
+	assert.ErrorIs(t, err, domain.ErrMarketNotFound)
+	assert.Nil(t, resp)
+}
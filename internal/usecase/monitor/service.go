@@ -0,0 +1,221 @@
+package monitor
+
+import (
+	"context"
+	"runtime/debug"
+	"sync"
+	"time"
+
+	"transaction/internal/adapter/exchange"
+	"transaction/internal/adapter/repository"
+	"transaction/internal/domain"
+	"transaction/pkg/logger"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// ISignalNotifier delivers notifications for triggered signals.
+type ISignalNotifier interface {
+	// Notify delivers, queues or skips a triggered signal.
+	Notify(ctx context.Context, signal *domain.Signal) error
+
+	// FlushDigest delivers any signals held back during quiet hours.
+	FlushDigest(ctx context.Context) error
+}
+
+// ILiveFeed receives the prices and signals observed by the monitor as they
+// happen. Publishing must not block the monitor.
+type ILiveFeed interface {
+	// PublishPrice announces the price of a watched symbol.
+	PublishPrice(symbol string, price decimal.Decimal, observedAt time.Time)
+
+	// PublishSignal announces a triggered signal.
+	PublishSignal(signal *domain.Signal)
+}
+
+// PriceMonitor periodically evaluates active strategies against current market prices.
+type PriceMonitor struct {
+	repo       repository.IStrategyRepository
+	signalRepo repository.ISignalRepository
+	prices     exchange.IPriceFeed
+	notifier   ISignalNotifier
+	feed       ILiveFeed
+	logger     logger.Logger
+	interval   time.Duration
+	stopCh     chan struct{}
+	stopOnce   sync.Once
+	wg         sync.WaitGroup
+}
+
+// NewPriceMonitor creates a new instance of PriceMonitor.
+func NewPriceMonitor(
+	repo repository.IStrategyRepository,
+	signalRepo repository.ISignalRepository,
+	prices exchange.IPriceFeed,
+	notifier ISignalNotifier,
+	feed ILiveFeed,
+	logger logger.Logger,
+	interval time.Duration,
+) *PriceMonitor {
+	return &PriceMonitor{
+		repo:       repo,
+		signalRepo: signalRepo,
+		prices:     prices,
+		notifier:   notifier,
+		feed:       feed,
+		logger:     logger,
+		interval:   interval,
+		stopCh:     make(chan struct{}),
+	}
+}
+
+// Run checks prices immediately and then on every interval until the context
+// is cancelled or Stop is called. An in-flight check is always allowed to finish.
+func (m *PriceMonitor) Run(ctx context.Context) error {
+	m.wg.Add(1)
+	defer m.wg.Done()
+
+	m.logger.Info("Starting price monitor", "interval", m.interval)
+
+	ticker := time.NewTicker(m.interval)
+	defer ticker.Stop()
+
+	m.checkPrices(ctx)
+	for {
+		select {
+		case <-ticker.C:
+			m.checkPrices(ctx)
+		case <-m.stopCh:
+			m.logger.Info("Stopping price monitor")
+			return nil
+		case <-ctx.Done():
+			m.logger.Info("Context cancelled, stopping price monitor")
+			return ctx.Err()
+		}
+	}
+}
+
+// Stop signals Run to return and waits for the current check to complete.
+func (m *PriceMonitor) Stop() {
+	m.stopOnce.Do(func() {
+		close(m.stopCh)
+	})
+	m.wg.Wait()
+}
+
+// checkPrices loads the active strategies and evaluates each symbol concurrently,
+// then delivers any notification digest that is due.
+func (m *PriceMonitor) checkPrices(ctx context.Context) {
+	m.checkStrategies(ctx)
+
+	if m.notifier == nil {
+		return
+	}
+	if err := m.notifier.FlushDigest(ctx); err != nil {
+		m.logger.Error("Failed to send notification digest", "error", err.Error())
+	}
+}
+
+// checkStrategies loads the active strategies and evaluates each symbol concurrently.
+func (m *PriceMonitor) checkStrategies(ctx context.Context) {
+	strategies, err := m.repo.FindActive(ctx)
+	if err != nil {
+		m.logger.Error("Failed to load strategies", "error", err.Error())
+		return
+	}
+
+	bySymbol := groupActiveBySymbol(strategies)
+
+	var wg sync.WaitGroup
+	for symbol, group := range bySymbol {
+		wg.Add(1)
+		go func(symbol string, group []*domain.Strategy) {
+			defer wg.Done()
+			m.checkSymbol(ctx, symbol, group)
+		}(symbol, group)
+	}
+	wg.Wait()
+}
+
+// checkSymbol fetches one price for the symbol and evaluates every strategy on it.
+// A panic is recovered so that one misbehaving symbol cannot stop the others.
+func (m *PriceMonitor) checkSymbol(ctx context.Context, symbol string, strategies []*domain.Strategy) {
+	defer func() {
+		if r := recover(); r != nil {
+			m.logger.Error("Recovered from panic while checking symbol",
+				"symbol", symbol, "panic", r, "stack", string(debug.Stack()))
+		}
+	}()
+
+	price, err := m.prices.GetPrice(ctx, symbol)
+	if err != nil {
+		m.logger.Error("Failed to fetch price", "symbol", symbol, "error", err.Error())
+		return
+	}
+	if m.feed != nil {
+		m.feed.PublishPrice(symbol, price, time.Now())
+	}
+
+	for _, strategy := range strategies {
+		m.evaluate(ctx, strategy, price)
+	}
+}
+
+// evaluate records a signal when the price crosses into a strategy's buy or sell zone.
+// Signals are edge-triggered and rate limited by the strategy cooldown; the resulting
+// trigger state is persisted so a restart does not repeat signals.
+func (m *PriceMonitor) evaluate(ctx context.Context, strategy *domain.Strategy, price decimal.Decimal) {
+	previousZone := strategy.LastZone
+	side, triggered := strategy.Evaluate(price, time.Now())
+
+	if triggered {
+		switch side {
+		case domain.SideBuy:
+			m.logger.Info("Buy signal triggered",
+				"strategy_id", strategy.ID, "symbol", strategy.Symbol, "price", price, "buy_lower", strategy.BuyLower)
+		case domain.SideSell:
+			m.logger.Info("Sell signal triggered",
+				"strategy_id", strategy.ID, "symbol", strategy.Symbol, "price", price, "sell_upper", strategy.SellUpper)
+		}
+		m.recordSignal(ctx, strategy, side, price)
+	}
+
+	if triggered || strategy.LastZone != previousZone {
+		if err := m.repo.UpdateTriggerState(ctx, strategy); err != nil {
+			m.logger.Error("Failed to save trigger state", "strategy_id", strategy.ID, "error", err.Error())
+		}
+	}
+}
+
+// recordSignal persists a triggered signal so it can be audited later,
+// publishes it to the live feed and notifies the configured sinks. A failure in
+// any step is only logged.
+func (m *PriceMonitor) recordSignal(ctx context.Context, strategy *domain.Strategy, side domain.Side, price decimal.Decimal) {
+	signal := domain.NewSignal(uuid.New().String(), strategy, side, price, time.Now())
+	if _, err := m.signalRepo.Create(ctx, signal); err != nil {
+		m.logger.Error("Failed to record signal", "strategy_id", strategy.ID, "error", err.Error())
+	}
+	if m.feed != nil {
+		m.feed.PublishSignal(signal)
+	}
+
+	if m.notifier == nil {
+		return
+	}
+	if err := m.notifier.Notify(ctx, signal); err != nil {
+		m.logger.Error("Failed to send notification", "strategy_id", strategy.ID, "error", err.Error())
+	}
+}
+
+// groupActiveBySymbol groups active strategies by their symbol.
+func groupActiveBySymbol(strategies []*domain.Strategy) map[string][]*domain.Strategy {
+	bySymbol := make(map[string][]*domain.Strategy)
+	for _, strategy := range strategies {
+		if !strategy.IsActive {
+			continue
+		}
+		bySymbol[strategy.Symbol] = append(bySymbol[strategy.Symbol], strategy)
+	}
+	return bySymbol
+}
@@ -0,0 +1,537 @@
+package monitor
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+	"transaction/internal/adapter/repository"
+	"transaction/internal/domain"
+	"transaction/internal/testutil"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// MockRepository is a mock implementation of IStrategyRepository.
+type MockRepository struct {
+	mock.Mock
+}
+
+func (m *MockRepository) Create(ctx context.Context, strategy *domain.Strategy) (*domain.Strategy, error) {
+	args := m.Called(ctx, strategy)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Strategy), args.Error(1)
+}
+
+func (m *MockRepository) FindByID(ctx context.Context, id string) (*domain.Strategy, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Strategy), args.Error(1)
+}
+
+func (m *MockRepository) FindAll(ctx context.Context) ([]*domain.Strategy, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.Strategy), args.Error(1)
+}
+
+func (m *MockRepository) FindActive(ctx context.Context) ([]*domain.Strategy, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.Strategy), args.Error(1)
+}
+
+func (m *MockRepository) FindBySymbol(ctx context.Context, symbol string) ([]*domain.Strategy, error) {
+	args := m.Called(ctx, symbol)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.Strategy), args.Error(1)
+}
+
+func (m *MockRepository) Find(ctx context.Context, filter repository.StrategyFilter) ([]*domain.Strategy, error) {
+	args := m.Called(ctx, filter)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.Strategy), args.Error(1)
+}
+
+func (m *MockRepository) Update(ctx context.Context, strategy *domain.Strategy) (*domain.Strategy, error) {
+	args := m.Called(ctx, strategy)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Strategy), args.Error(1)
+}
+
+func (m *MockRepository) UpdateTriggerState(ctx context.Context, strategy *domain.Strategy) error {
+	args := m.Called(ctx, strategy)
+	return args.Error(0)
+}
+
+func (m *MockRepository) Delete(ctx context.Context, id string) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *MockRepository) Restore(ctx context.Context, id string) (*domain.Strategy, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Strategy), args.Error(1)
+}
+
+func (m *MockRepository) Purge(ctx context.Context, deletedBefore time.Time) (int64, error) {
+	args := m.Called(ctx, deletedBefore)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+// MockNotifier is a mock implementation of ISignalNotifier.
+type MockNotifier struct {
+	mock.Mock
+}
+
+func (m *MockNotifier) Notify(ctx context.Context, signal *domain.Signal) error {
+	args := m.Called(ctx, signal)
+	return args.Error(0)
+}
+
+func (m *MockNotifier) FlushDigest(ctx context.Context) error {
+	args := m.Called(ctx)
+	return args.Error(0)
+}
+
+// MockLiveFeed is a mock implementation of ILiveFeed.
+type MockLiveFeed struct {
+	mock.Mock
+}
+
+func (m *MockLiveFeed) PublishPrice(symbol string, price decimal.Decimal, observedAt time.Time) {
+	m.Called(symbol, price, observedAt)
+}
+
+func (m *MockLiveFeed) PublishSignal(signal *domain.Signal) {
+	m.Called(signal)
+}
+
+// fakePriceFeed returns prices from a map and records how often each symbol was requested.
+type fakePriceFeed struct {
+	mu       sync.Mutex
+	prices   map[string]decimal.Decimal
+	errs     map[string]error
+	panicOn  string
+	requests map[string]int
+}
+
+func newFakePriceFeed(prices map[string]decimal.Decimal) *fakePriceFeed {
+	return &fakePriceFeed{
+		prices:   prices,
+		errs:     make(map[string]error),
+		requests: make(map[string]int),
+	}
+}
+
+func (f *fakePriceFeed) GetPrice(ctx context.Context, symbol string) (decimal.Decimal, error) {
+	f.mu.Lock()
+	f.requests[symbol]++
+	f.mu.Unlock()
+
+	if symbol == f.panicOn {
+		panic("price source exploded")
+	}
+	if err, ok := f.errs[symbol]; ok {
+		return decimal.Zero, err
+	}
+	return f.prices[symbol], nil
+}
+
+func (f *fakePriceFeed) GetPrices(ctx context.Context, symbols []string) (map[string]decimal.Decimal, error) {
+	prices := make(map[string]decimal.Decimal, len(symbols))
+	for _, symbol := range symbols {
+		price, err := f.GetPrice(ctx, symbol)
+		if err != nil {
+			return nil, err
+		}
+		prices[symbol] = price
+	}
+	return prices, nil
+}
+
+func (f *fakePriceFeed) requestCount(symbol string) int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.requests[symbol]
+}
+
+func testStrategies() []*domain.Strategy {
+	return []*domain.Strategy{
+		{ID: "btc-1", Symbol: "BTC", BuyLower: decimal.NewFromInt(60000), SellUpper: decimal.NewFromInt(70000), IsActive: true},
+		{ID: "btc-2", Symbol: "BTC", BuyLower: decimal.NewFromInt(50000), SellUpper: decimal.NewFromInt(58000), IsActive: true},
+		{ID: "eth-1", Symbol: "ETH", BuyLower: decimal.NewFromInt(2000), SellUpper: decimal.NewFromInt(3000), IsActive: true},
+		{ID: "sol-1", Symbol: "SOL", BuyLower: decimal.NewFromInt(100), SellUpper: decimal.NewFromInt(200), IsActive: false},
+	}
+}
+
+func TestCheckPrices_FetchesOncePerActiveSymbol(t *testing.T) {
+	mockRepo := new(MockRepository)
+	mockSignalRepo := new(testutil.MockSignalRepository)
+	mockLogger := new(testutil.MockLogger)
+	prices := newFakePriceFeed(map[string]decimal.Decimal{"BTC": decimal.NewFromInt(59000), "ETH": decimal.NewFromInt(2500)})
+	monitor := NewPriceMonitor(mockRepo, mockSignalRepo, prices, nil, nil, mockLogger, time.Minute)
+
+	mockLogger.On("Info", mock.Anything, mock.Anything).Return()
+	mockRepo.On("FindActive", mock.Anything).Return(testStrategies(), nil)
+	mockRepo.On("UpdateTriggerState", mock.Anything, mock.Anything).Return(nil)
+	mockSignalRepo.On("Create", mock.Anything, mock.Anything).Return(&domain.Signal{}, nil)
+
+	monitor.checkPrices(context.Background())
+
+	assert.Equal(t, 1, prices.requestCount("BTC"))
+	assert.Equal(t, 1, prices.requestCount("ETH"))
+	assert.Equal(t, 0, prices.requestCount("SOL"), "inactive strategies should not be checked")
+}
+
+func TestCheckPrices_ReportsTriggeredSignals(t *testing.T) {
+	mockRepo := new(MockRepository)
+	mockSignalRepo := new(testutil.MockSignalRepository)
+	mockLogger := new(testutil.MockLogger)
+	prices := newFakePriceFeed(map[string]decimal.Decimal{"BTC": decimal.NewFromInt(59000), "ETH": decimal.NewFromInt(2500)})
+	monitor := NewPriceMonitor(mockRepo, mockSignalRepo, prices, nil, nil, mockLogger, time.Minute)
+
+	mockLogger.On("Info", mock.Anything, mock.Anything).Return()
+	mockRepo.On("FindActive", mock.Anything).Return(testStrategies(), nil)
+	mockRepo.On("UpdateTriggerState", mock.Anything, mock.Anything).Return(nil)
+	mockSignalRepo.On("Create", mock.Anything, mock.Anything).Return(&domain.Signal{}, nil)
+
+	monitor.checkPrices(context.Background())
+
+	mockLogger.AssertCalled(t, "Info", "Buy signal triggered", mock.MatchedBy(func(args []interface{}) bool {
+		return len(args) > 1 && args[1] == "btc-1"
+	}))
+	mockLogger.AssertCalled(t, "Info", "Sell signal triggered", mock.MatchedBy(func(args []interface{}) bool {
+		return len(args) > 1 && args[1] == "btc-2"
+	}))
+	mockLogger.AssertNotCalled(t, "Info", "Buy signal triggered", mock.MatchedBy(func(args []interface{}) bool {
+		return len(args) > 1 && args[1] == "eth-1"
+	}))
+}
+
+func TestCheckPrices_RecordsSignals(t *testing.T) {
+	mockRepo := new(MockRepository)
+	mockSignalRepo := new(testutil.MockSignalRepository)
+	mockLogger := new(testutil.MockLogger)
+	prices := newFakePriceFeed(map[string]decimal.Decimal{"BTC": decimal.NewFromInt(59000), "ETH": decimal.NewFromInt(2500)})
+	monitor := NewPriceMonitor(mockRepo, mockSignalRepo, prices, nil, nil, mockLogger, time.Minute)
+
+	mockLogger.On("Info", mock.Anything, mock.Anything).Return()
+	mockRepo.On("FindActive", mock.Anything).Return(testStrategies(), nil)
+	mockRepo.On("UpdateTriggerState", mock.Anything, mock.Anything).Return(nil)
+	mockSignalRepo.On("Create", mock.Anything, mock.MatchedBy(func(s *domain.Signal) bool {
+		return s.StrategyID == "btc-1" && s.Side == domain.SideBuy &&
+			s.TriggerPrice.Equal(decimal.NewFromInt(60000)) && s.ObservedPrice.Equal(decimal.NewFromInt(59000)) && s.ID != ""
+	})).Return(&domain.Signal{}, nil).Once()
+	mockSignalRepo.On("Create", mock.Anything, mock.MatchedBy(func(s *domain.Signal) bool {
+		return s.StrategyID == "btc-2" && s.Side == domain.SideSell &&
+			s.TriggerPrice.Equal(decimal.NewFromInt(58000)) && s.ObservedPrice.Equal(decimal.NewFromInt(59000))
+	})).Return(&domain.Signal{}, nil).Once()
+
+	monitor.checkPrices(context.Background())
+
+	mockSignalRepo.AssertExpectations(t)
+	mockSignalRepo.AssertNumberOfCalls(t, "Create", 2)
+}
+
+func TestCheckPrices_IsEdgeTriggered(t *testing.T) {
+	mockRepo := new(MockRepository)
+	mockSignalRepo := new(testutil.MockSignalRepository)
+	mockLogger := new(testutil.MockLogger)
+	prices := newFakePriceFeed(map[string]decimal.Decimal{"BTC": decimal.NewFromInt(59000), "ETH": decimal.NewFromInt(2500)})
+	monitor := NewPriceMonitor(mockRepo, mockSignalRepo, prices, nil, nil, mockLogger, time.Minute)
+
+	mockLogger.On("Info", mock.Anything, mock.Anything).Return()
+	mockRepo.On("FindActive", mock.Anything).Return(testStrategies(), nil)
+	mockRepo.On("UpdateTriggerState", mock.Anything, mock.Anything).Return(nil)
+	mockSignalRepo.On("Create", mock.Anything, mock.Anything).Return(&domain.Signal{}, nil)
+
+	monitor.checkPrices(context.Background())
+	monitor.checkPrices(context.Background())
+
+	mockSignalRepo.AssertNumberOfCalls(t, "Create", 2)
+	mockRepo.AssertNumberOfCalls(t, "UpdateTriggerState", 2)
+	mockRepo.AssertCalled(t, "UpdateTriggerState", mock.Anything, mock.MatchedBy(func(s *domain.Strategy) bool {
+		return s.ID == "btc-1" && s.LastZone == domain.ZoneBuy && s.LastSignalAt != nil
+	}))
+	mockRepo.AssertNotCalled(t, "UpdateTriggerState", mock.Anything, mock.MatchedBy(func(s *domain.Strategy) bool {
+		return s.ID == "eth-1"
+	}))
+}
+
+func TestCheckPrices_RespectsPersistedTriggerState(t *testing.T) {
+	mockRepo := new(MockRepository)
+	mockSignalRepo := new(testutil.MockSignalRepository)
+	mockLogger := new(testutil.MockLogger)
+	prices := newFakePriceFeed(map[string]decimal.Decimal{"BTC": decimal.NewFromInt(59000)})
+	monitor := NewPriceMonitor(mockRepo, mockSignalRepo, prices, nil, nil, mockLogger, time.Minute)
+
+	lastSignalAt := time.Now().Add(-time.Hour)
+	strategies := []*domain.Strategy{
+		{ID: "btc-1", Symbol: "BTC", BuyLower: decimal.NewFromInt(60000), SellUpper: decimal.NewFromInt(70000), IsActive: true,
+			LastZone: domain.ZoneBuy, LastSignalAt: &lastSignalAt},
+	}
+
+	mockLogger.On("Info", mock.Anything, mock.Anything).Return()
+	mockRepo.On("FindActive", mock.Anything).Return(strategies, nil)
+
+	monitor.checkPrices(context.Background())
+
+	mockSignalRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	mockRepo.AssertNotCalled(t, "UpdateTriggerState", mock.Anything, mock.Anything)
+}
+
+func TestCheckPrices_SignalPersistenceErrorIsLogged(t *testing.T) {
+	mockRepo := new(MockRepository)
+	mockSignalRepo := new(testutil.MockSignalRepository)
+	mockLogger := new(testutil.MockLogger)
+	prices := newFakePriceFeed(map[string]decimal.Decimal{"BTC": decimal.NewFromInt(59000), "ETH": decimal.NewFromInt(2500)})
+	monitor := NewPriceMonitor(mockRepo, mockSignalRepo, prices, nil, nil, mockLogger, time.Minute)
+
+	mockLogger.On("Info", mock.Anything, mock.Anything).Return()
+	mockLogger.On("Error", mock.Anything, mock.Anything).Return()
+	mockRepo.On("FindActive", mock.Anything).Return(testStrategies(), nil)
+	mockRepo.On("UpdateTriggerState", mock.Anything, mock.Anything).Return(nil)
+	mockSignalRepo.On("Create", mock.Anything, mock.Anything).Return(nil, errors.New("disk full"))
+
+	monitor.checkPrices(context.Background())
+
+	mockLogger.AssertCalled(t, "Error", "Failed to record signal", mock.Anything)
+}
+
+func TestCheckPrices_NotifiesTriggeredSignals(t *testing.T) {
+	mockRepo := new(MockRepository)
+	mockSignalRepo := new(testutil.MockSignalRepository)
+	mockNotifier := new(MockNotifier)
+	mockLogger := new(testutil.MockLogger)
+	prices := newFakePriceFeed(map[string]decimal.Decimal{"BTC": decimal.NewFromInt(59000), "ETH": decimal.NewFromInt(2500)})
+	monitor := NewPriceMonitor(mockRepo, mockSignalRepo, prices, mockNotifier, nil, mockLogger, time.Minute)
+
+	mockLogger.On("Info", mock.Anything, mock.Anything).Return()
+	mockRepo.On("FindActive", mock.Anything).Return(testStrategies(), nil)
+	mockRepo.On("UpdateTriggerState", mock.Anything, mock.Anything).Return(nil)
+	mockSignalRepo.On("Create", mock.Anything, mock.Anything).Return(&domain.Signal{}, nil)
+	mockNotifier.On("Notify", mock.Anything, mock.MatchedBy(func(s *domain.Signal) bool {
+		return s.StrategyID == "btc-1" && s.Side == domain.SideBuy
+	})).Return(nil).Once()
+	mockNotifier.On("Notify", mock.Anything, mock.MatchedBy(func(s *domain.Signal) bool {
+		return s.StrategyID == "btc-2" && s.Side == domain.SideSell
+	})).Return(nil).Once()
+	mockNotifier.On("FlushDigest", mock.Anything).Return(nil).Once()
+
+	monitor.checkPrices(context.Background())
+
+	mockNotifier.AssertExpectations(t)
+}
+
+func TestCheckPrices_PublishesPricesAndSignals(t *testing.T) {
+	mockRepo := new(MockRepository)
+	mockSignalRepo := new(testutil.MockSignalRepository)
+	mockFeed := new(MockLiveFeed)
+	mockLogger := new(testutil.MockLogger)
+	prices := newFakePriceFeed(map[string]decimal.Decimal{"BTC": decimal.NewFromInt(59000), "ETH": decimal.NewFromInt(2500)})
+	prices.errs["ETH"] = errors.New("exchange unavailable")
+	monitor := NewPriceMonitor(mockRepo, mockSignalRepo, prices, nil, mockFeed, mockLogger, time.Minute)
+
+	mockLogger.On("Info", mock.Anything, mock.Anything).Return()
+	mockLogger.On("Error", mock.Anything, mock.Anything).Return()
+	mockRepo.On("FindActive", mock.Anything).Return(testStrategies(), nil)
+	mockRepo.On("UpdateTriggerState", mock.Anything, mock.Anything).Return(nil)
+	mockSignalRepo.On("Create", mock.Anything, mock.Anything).Return(&domain.Signal{}, nil)
+	mockFeed.On("PublishPrice", "BTC", decimal.NewFromInt(59000), mock.Anything).Return().Once()
+	mockFeed.On("PublishSignal", mock.MatchedBy(func(s *domain.Signal) bool {
+		return s.StrategyID == "btc-1" && s.Side == domain.SideBuy
+	})).Return().Once()
+	mockFeed.On("PublishSignal", mock.MatchedBy(func(s *domain.Signal) bool {
+		return s.StrategyID == "btc-2" && s.Side == domain.SideSell
+	})).Return().Once()
+
+	monitor.checkPrices(context.Background())
+
+	mockFeed.AssertExpectations(t)
+	mockFeed.AssertNotCalled(t, "PublishPrice", "ETH", mock.Anything, mock.Anything)
+}
+
+func TestCheckPrices_NotificationErrorIsLogged(t *testing.T) {
+	mockRepo := new(MockRepository)
+	mockSignalRepo := new(testutil.MockSignalRepository)
+	mockNotifier := new(MockNotifier)
+	mockLogger := new(testutil.MockLogger)
+	prices := newFakePriceFeed(map[string]decimal.Decimal{"BTC": decimal.NewFromInt(59000)})
+	monitor := NewPriceMonitor(mockRepo, mockSignalRepo, prices, mockNotifier, nil, mockLogger, time.Minute)
+
+	mockLogger.On("Info", mock.Anything, mock.Anything).Return()
+	mockLogger.On("Error", mock.Anything, mock.Anything).Return()
+	mockRepo.On("FindActive", mock.Anything).Return(testStrategies(), nil)
+	mockRepo.On("UpdateTriggerState", mock.Anything, mock.Anything).Return(nil)
+	mockSignalRepo.On("Create", mock.Anything, mock.Anything).Return(&domain.Signal{}, nil)
+	mockNotifier.On("Notify", mock.Anything, mock.Anything).Return(errors.New("webhook down"))
+	mockNotifier.On("FlushDigest", mock.Anything).Return(nil)
+
+	monitor.checkPrices(context.Background())
+
+	mockLogger.AssertCalled(t, "Error", "Failed to send notification", mock.Anything)
+	mockRepo.AssertCalled(t, "UpdateTriggerState", mock.Anything, mock.MatchedBy(func(s *domain.Strategy) bool {
+		return s.ID == "btc-1" && s.LastSignalAt != nil
+	}))
+}
+
+func TestCheckPrices_DigestErrorIsLogged(t *testing.T) {
+	mockRepo := new(MockRepository)
+	mockSignalRepo := new(testutil.MockSignalRepository)
+	mockNotifier := new(MockNotifier)
+	mockLogger := new(testutil.MockLogger)
+	prices := newFakePriceFeed(map[string]decimal.Decimal{"ETH": decimal.NewFromInt(2500)})
+	monitor := NewPriceMonitor(mockRepo, mockSignalRepo, prices, mockNotifier, nil, mockLogger, time.Minute)
+
+	mockLogger.On("Info", mock.Anything, mock.Anything).Return()
+	mockLogger.On("Error", mock.Anything, mock.Anything).Return()
+	mockRepo.On("FindActive", mock.Anything).Return([]*domain.Strategy{
+		{ID: "eth-1", Symbol: "ETH", BuyLower: decimal.NewFromInt(2000), SellUpper: decimal.NewFromInt(3000), IsActive: true},
+	}, nil)
+	mockNotifier.On("FlushDigest", mock.Anything).Return(errors.New("webhook down"))
+
+	monitor.checkPrices(context.Background())
+
+	mockNotifier.AssertNotCalled(t, "Notify", mock.Anything, mock.Anything)
+	mockLogger.AssertCalled(t, "Error", "Failed to send notification digest", mock.Anything)
+}
+
+func TestCheckPrices_PriceErrorDoesNotAffectOtherSymbols(t *testing.T) {
+	mockRepo := new(MockRepository)
+	mockSignalRepo := new(testutil.MockSignalRepository)
+	mockLogger := new(testutil.MockLogger)
+	prices := newFakePriceFeed(map[string]decimal.Decimal{"BTC": decimal.NewFromInt(59000)})
+	prices.errs["ETH"] = errors.New("exchange unavailable")
+	monitor := NewPriceMonitor(mockRepo, mockSignalRepo, prices, nil, nil, mockLogger, time.Minute)
+
+	mockLogger.On("Info", mock.Anything, mock.Anything).Return()
+	mockLogger.On("Error", mock.Anything, mock.Anything).Return()
+	mockRepo.On("FindActive", mock.Anything).Return(testStrategies(), nil)
+	mockRepo.On("UpdateTriggerState", mock.Anything, mock.Anything).Return(nil)
+	mockSignalRepo.On("Create", mock.Anything, mock.Anything).Return(&domain.Signal{}, nil)
+
+	monitor.checkPrices(context.Background())
+
+	mockLogger.AssertCalled(t, "Error", "Failed to fetch price", mock.Anything)
+	mockLogger.AssertCalled(t, "Info", "Buy signal triggered", mock.Anything)
+}
+
+func TestCheckPrices_RecoversFromPanicPerSymbol(t *testing.T) {
+	mockRepo := new(MockRepository)
+	mockSignalRepo := new(testutil.MockSignalRepository)
+	mockLogger := new(testutil.MockLogger)
+	prices := newFakePriceFeed(map[string]decimal.Decimal{"BTC": decimal.NewFromInt(59000)})
+	prices.panicOn = "ETH"
+	monitor := NewPriceMonitor(mockRepo, mockSignalRepo, prices, nil, nil, mockLogger, time.Minute)
+
+	mockLogger.On("Info", mock.Anything, mock.Anything).Return()
+	mockLogger.On("Error", mock.Anything, mock.Anything).Return()
+	mockRepo.On("FindActive", mock.Anything).Return(testStrategies(), nil)
+	mockRepo.On("UpdateTriggerState", mock.Anything, mock.Anything).Return(nil)
+	mockSignalRepo.On("Create", mock.Anything, mock.Anything).Return(&domain.Signal{}, nil)
+
+	assert.NotPanics(t, func() {
+		monitor.checkPrices(context.Background())
+	})
+	mockLogger.AssertCalled(t, "Error", "Recovered from panic while checking symbol", mock.Anything)
+	mockLogger.AssertCalled(t, "Info", "Buy signal triggered", mock.Anything)
+}
+
+func TestCheckPrices_RepositoryError(t *testing.T) {
+	mockRepo := new(MockRepository)
+	mockSignalRepo := new(testutil.MockSignalRepository)
+	mockLogger := new(testutil.MockLogger)
+	prices := newFakePriceFeed(nil)
+	monitor := NewPriceMonitor(mockRepo, mockSignalRepo, prices, nil, nil, mockLogger, time.Minute)
+
+	mockLogger.On("Error", mock.Anything, mock.Anything).Return()
+	mockRepo.On("FindActive", mock.Anything).Return(nil, errors.New("database locked"))
+
+	monitor.checkPrices(context.Background())
+
+	mockLogger.AssertCalled(t, "Error", "Failed to load strategies", mock.Anything)
+	assert.Equal(t, 0, prices.requestCount("BTC"))
+}
+
+func TestRun_StopsWhenContextCancelled(t *testing.T) {
+	mockRepo := new(MockRepository)
+	mockSignalRepo := new(testutil.MockSignalRepository)
+	mockLogger := new(testutil.MockLogger)
+	prices := newFakePriceFeed(map[string]decimal.Decimal{"BTC": decimal.NewFromInt(65000), "ETH": decimal.NewFromInt(2500)})
+	monitor := NewPriceMonitor(mockRepo, mockSignalRepo, prices, nil, nil, mockLogger, 10*time.Millisecond)
+
+	mockLogger.On("Info", mock.Anything, mock.Anything).Return()
+	mockRepo.On("FindActive", mock.Anything).Return(testStrategies(), nil)
+	mockRepo.On("UpdateTriggerState", mock.Anything, mock.Anything).Return(nil)
+	mockSignalRepo.On("Create", mock.Anything, mock.Anything).Return(&domain.Signal{}, nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() {
+		done <- monitor.Run(ctx)
+	}()
+
+	require.Eventually(t, func() bool {
+		return prices.requestCount("BTC") >= 2
+	}, time.Second, 5*time.Millisecond)
+	cancel()
+
+	select {
+	case err := <-done:
+		assert.ErrorIs(t, err, context.Canceled)
+	case <-time.After(time.Second):
+		t.Fatal("monitor did not stop after context cancellation")
+	}
+}
+
+func TestStop_WaitsForRunToReturn(t *testing.T) {
+	mockRepo := new(MockRepository)
+	mockSignalRepo := new(testutil.MockSignalRepository)
+	mockLogger := new(testutil.MockLogger)
+	prices := newFakePriceFeed(map[string]decimal.Decimal{"BTC": decimal.NewFromInt(65000), "ETH": decimal.NewFromInt(2500)})
+	monitor := NewPriceMonitor(mockRepo, mockSignalRepo, prices, nil, nil, mockLogger, 10*time.Millisecond)
+
+	mockLogger.On("Info", mock.Anything, mock.Anything).Return()
+	mockRepo.On("FindActive", mock.Anything).Return(testStrategies(), nil)
+	mockRepo.On("UpdateTriggerState", mock.Anything, mock.Anything).Return(nil)
+	mockSignalRepo.On("Create", mock.Anything, mock.Anything).Return(&domain.Signal{}, nil)
+
+	done := make(chan error, 1)
+	go func() {
+		done <- monitor.Run(context.Background())
+	}()
+
+	require.Eventually(t, func() bool {
+		return prices.requestCount("BTC") >= 1
+	}, time.Second, 5*time.Millisecond)
+	monitor.Stop()
+
+	select {
+	case err := <-done:
+		assert.NoError(t, err)
+	case <-time.After(time.Second):
+		t.Fatal("monitor did not stop")
+	}
+}
@@ -0,0 +1,22 @@
+package notification
+
+import "time"
+
+// UpdatePreferencesRequest represents a change to the notification preferences.
+// Nil or empty fields leave the current value unchanged.
+type UpdatePreferencesRequest struct {
+	Timezone         *string  // Optional: IANA timezone of the quiet hours
+	QuietHours       []string // Optional: replaces the quiet hours (HH:MM-HH:MM); an empty non-nil slice clears them
+	MuteStrategies   []string // Strategy IDs to mute
+	UnmuteStrategies []string // Strategy IDs to unmute
+	MinSeverity      *string  // Optional: info, warning or critical
+}
+
+// PreferencesResponse represents the response containing notification preferences.
+type PreferencesResponse struct {
+	Timezone        string    // IANA timezone of the quiet hours
+	QuietHours      []string  // Windows during which notifications are queued
+	MutedStrategies []string  // Strategies whose signals are never notified
+	MinSeverity     string    // Signals below this severity are not notified
+	UpdatedAt       time.Time // When the preferences were last changed
+}
@@ -0,0 +1,180 @@
+package notification
+
+import (
+	"context"
+	"time"
+
+	"transaction/internal/adapter/notifier"
+	"transaction/internal/adapter/repository"
+	"transaction/internal/domain"
+	"transaction/pkg/logger"
+)
+
+// NotificationService applies the user's notification preferences before
+// handing signals to the notification sinks.
+type NotificationService struct {
+	repo     repository.INotificationRepository
+	notifier notifier.INotifier
+	logger   logger.Logger
+	now      func() time.Time
+}
+
+// NewNotificationService creates a new instance of NotificationService.
+func NewNotificationService(repo repository.INotificationRepository, notifier notifier.INotifier, logger logger.Logger) *NotificationService {
+	return &NotificationService{
+		repo:     repo,
+		notifier: notifier,
+		logger:   logger,
+		now:      time.Now,
+	}
+}
+
+// GetPreferences retrieves the current notification preferences.
+func (s *NotificationService) GetPreferences(ctx context.Context) (*PreferencesResponse, error) {
+	s.logger.Info("Fetching notification preferences")
+
+	prefs, err := s.repo.GetPreferences(ctx)
+	if err != nil {
+		s.logger.Error("Failed to load notification preferences", "error", err.Error())
+		return nil, err
+	}
+
+	return toResponse(prefs), nil
+}
+
+// UpdatePreferences applies the requested changes to the notification preferences.
+func (s *NotificationService) UpdatePreferences(ctx context.Context, req *UpdatePreferencesRequest) (*PreferencesResponse, error) {
+	s.logger.Info("Updating notification preferences")
+
+	prefs, err := s.repo.GetPreferences(ctx)
+	if err != nil {
+		s.logger.Error("Failed to load notification preferences", "error", err.Error())
+		return nil, err
+	}
+
+	if req.Timezone != nil {
+		prefs.Timezone = *req.Timezone
+	}
+	if req.QuietHours != nil {
+		windows := make(domain.MuteWindows, 0, len(req.QuietHours))
+		for _, value := range req.QuietHours {
+			window, err := domain.ParseMuteWindow(value)
+			if err != nil {
+				s.logger.Error("Notification preferences validation failed", "error", err.Error())
+				return nil, err
+			}
+			windows = append(windows, window)
+		}
+		prefs.QuietHours = windows
+	}
+	for _, id := range req.MuteStrategies {
+		prefs.Mute(id)
+	}
+	for _, id := range req.UnmuteStrategies {
+		prefs.Unmute(id)
+	}
+	if req.MinSeverity != nil {
+		severity, err := domain.ParseSeverity(*req.MinSeverity)
+		if err != nil {
+			s.logger.Error("Notification preferences validation failed", "error", err.Error())
+			return nil, err
+		}
+		prefs.MinSeverity = severity
+	}
+
+	if err := prefs.Validate(); err != nil {
+		s.logger.Error("Notification preferences validation failed", "error", err.Error())
+		return nil, err
+	}
+
+	saved, err := s.repo.SavePreferences(ctx, prefs)
+	if err != nil {
+		s.logger.Error("Failed to save notification preferences", "error", err.Error())
+		return nil, err
+	}
+
+	return toResponse(saved), nil
+}
+
+// Notify delivers a triggered signal according to the preferences. Signals of
+// muted strategies or below the minimum severity are skipped, and signals
+// arriving during quiet hours are queued for the next digest.
+func (s *NotificationService) Notify(ctx context.Context, signal *domain.Signal) error {
+	prefs, err := s.repo.GetPreferences(ctx)
+	if err != nil {
+		return err
+	}
+
+	if prefs.IsMuted(signal.StrategyID) {
+		s.logger.Info("Notification skipped for muted strategy", "strategy_id", signal.StrategyID)
+		return nil
+	}
+	if severity := signal.Severity(); !prefs.Allows(severity) {
+		s.logger.Info("Notification skipped below minimum severity",
+			"strategy_id", signal.StrategyID, "severity", severity)
+		return nil
+	}
+
+	now := s.now()
+	quiet, err := prefs.InQuietHours(now)
+	if err != nil {
+		return err
+	}
+	if quiet {
+		s.logger.Info("Notification queued during quiet hours", "signal_id", signal.ID)
+		return s.repo.Enqueue(ctx, &domain.QueuedNotification{SignalID: signal.ID, QueuedAt: now})
+	}
+
+	return s.notifier.Send(ctx, notifier.NewSignalMessage(signal))
+}
+
+// FlushDigest delivers the signals queued during quiet hours as a single digest
+// once the quiet hours are over. The queue is kept if delivery fails.
+func (s *NotificationService) FlushDigest(ctx context.Context) error {
+	prefs, err := s.repo.GetPreferences(ctx)
+	if err != nil {
+		return err
+	}
+
+	quiet, err := prefs.InQuietHours(s.now())
+	if err != nil {
+		return err
+	}
+	if quiet {
+		return nil
+	}
+
+	signals, err := s.repo.FindQueued(ctx)
+	if err != nil {
+		return err
+	}
+	if len(signals) == 0 {
+		return nil
+	}
+
+	s.logger.Info("Sending notification digest", "signals", len(signals))
+	if err := s.notifier.Send(ctx, notifier.NewDigestMessage(signals)); err != nil {
+		return err
+	}
+
+	ids := make([]string, len(signals))
+	for i, signal := range signals {
+		ids[i] = signal.ID
+	}
+	return s.repo.Dequeue(ctx, ids)
+}
+
+// toResponse converts domain NotificationPreferences to a PreferencesResponse.
+func toResponse(p *domain.NotificationPreferences) *PreferencesResponse {
+	quietHours := make([]string, len(p.QuietHours))
+	for i, w := range p.QuietHours {
+		quietHours[i] = w.String()
+	}
+	return &PreferencesResponse{
+		Timezone:        p.Timezone,
+		QuietHours:      quietHours,
+		MutedStrategies: append([]string{}, p.MutedStrategies...),
+		MinSeverity:     string(p.MinSeverity),
+		UpdatedAt:       p.UpdatedAt,
+	}
+}
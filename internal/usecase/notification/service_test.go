@@ -0,0 +1,248 @@
+package notification
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+	"transaction/internal/adapter/notifier"
+	"transaction/internal/domain"
+	"transaction/internal/testutil"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockNotificationRepository is a mock implementation of INotificationRepository.
+type MockNotificationRepository struct {
+	mock.Mock
+}
+
+func (m *MockNotificationRepository) GetPreferences(ctx context.Context) (*domain.NotificationPreferences, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.NotificationPreferences), args.Error(1)
+}
+
+func (m *MockNotificationRepository) SavePreferences(ctx context.Context, prefs *domain.NotificationPreferences) (*domain.NotificationPreferences, error) {
+	args := m.Called(ctx, prefs)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.NotificationPreferences), args.Error(1)
+}
+
+func (m *MockNotificationRepository) Enqueue(ctx context.Context, queued *domain.QueuedNotification) error {
+	args := m.Called(ctx, queued)
+	return args.Error(0)
+}
+
+func (m *MockNotificationRepository) FindQueued(ctx context.Context) ([]*domain.Signal, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.Signal), args.Error(1)
+}
+
+func (m *MockNotificationRepository) Dequeue(ctx context.Context, signalIDs []string) error {
+	args := m.Called(ctx, signalIDs)
+	return args.Error(0)
+}
+
+// MockNotifier is a mock implementation of INotifier.
+type MockNotifier struct {
+	mock.Mock
+}
+
+func (m *MockNotifier) Name() string {
+	return "mock"
+}
+
+func (m *MockNotifier) Send(ctx context.Context, msg *notifier.Message) error {
+	args := m.Called(ctx, msg)
+	return args.Error(0)
+}
+
+// newTestService creates a service whose clock is fixed at now.
+func newTestService(now time.Time) (*NotificationService, *MockNotificationRepository, *MockNotifier) {
+	mockRepo := new(MockNotificationRepository)
+	mockNotifier := new(MockNotifier)
+	mockLogger := new(testutil.MockLogger)
+	mockLogger.On("Info", mock.Anything, mock.Anything).Return()
+	mockLogger.On("Error", mock.Anything, mock.Anything).Return()
+
+	service := NewNotificationService(mockRepo, mockNotifier, mockLogger)
+	service.now = func() time.Time { return now }
+	return service, mockRepo, mockNotifier
+}
+
+// quietPrefs returns preferences with quiet hours from 22:00 to 07:00 UTC.
+func quietPrefs() *domain.NotificationPreferences {
+	prefs := domain.DefaultNotificationPreferences()
+	prefs.QuietHours = domain.MuteWindows{{Start: 22 * 60, End: 7 * 60}}
+	return prefs
+}
+
+func testSignal(id string) *domain.Signal {
+	return &domain.Signal{
+		ID:            id,
+		StrategyID:    "strategy-1",
+		Symbol:        "BTC",
+		Side:          domain.SideBuy,
+		TriggerPrice:  decimal.NewFromInt(60000),
+		ObservedPrice: decimal.NewFromInt(59900),
+	}
+}
+
+var (
+	daytime = time.Date(2025, 11, 5, 12, 0, 0, 0, time.UTC)
+	night   = time.Date(2025, 11, 5, 23, 0, 0, 0, time.UTC)
+)
+
+func TestUpdatePreferences_Success(t *testing.T) {
+	service, mockRepo, _ := newTestService(daytime)
+
+	prefs := domain.DefaultNotificationPreferences()
+	prefs.Mute("strategy-old")
+	timezone := "Asia/Taipei"
+	severity := "warning"
+
+	mockRepo.On("GetPreferences", mock.Anything).Return(prefs, nil)
+	mockRepo.On("SavePreferences", mock.Anything, mock.MatchedBy(func(p *domain.NotificationPreferences) bool {
+		return p.Timezone == "Asia/Taipei" && len(p.QuietHours) == 1 &&
+			p.IsMuted("strategy-1") && !p.IsMuted("strategy-old") && p.MinSeverity == domain.SeverityWarning
+	})).Return(prefs, nil)
+
+	resp, err := service.UpdatePreferences(context.Background(), &UpdatePreferencesRequest{
+		Timezone:         &timezone,
+		QuietHours:       []string{"22:00-07:00"},
+		MuteStrategies:   []string{"strategy-1"},
+		UnmuteStrategies: []string{"strategy-old"},
+		MinSeverity:      &severity,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"22:00-07:00"}, resp.QuietHours)
+	assert.Equal(t, []string{"strategy-1"}, resp.MutedStrategies)
+	assert.Equal(t, "warning", resp.MinSeverity)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestUpdatePreferences_InvalidValues(t *testing.T) {
+	badTimezone := "Mars/Olympus"
+	badSeverity := "urgent"
+
+	tests := []struct {
+		name string
+		req  *UpdatePreferencesRequest
+	}{
+		{name: "invalid quiet hours", req: &UpdatePreferencesRequest{QuietHours: []string{"late"}}},
+		{name: "invalid timezone", req: &UpdatePreferencesRequest{Timezone: &badTimezone}},
+		{name: "invalid severity", req: &UpdatePreferencesRequest{MinSeverity: &badSeverity}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, mockRepo, _ := newTestService(daytime)
+			mockRepo.On("GetPreferences", mock.Anything).Return(domain.DefaultNotificationPreferences(), nil)
+
+			resp, err := service.UpdatePreferences(context.Background(), tt.req)
+			assert.Error(t, err)
+			assert.Nil(t, resp)
+			mockRepo.AssertNotCalled(t, "SavePreferences", mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func TestNotify_SendsOutsideQuietHours(t *testing.T) {
+	service, mockRepo, mockNotifier := newTestService(daytime)
+
+	mockRepo.On("GetPreferences", mock.Anything).Return(quietPrefs(), nil)
+	mockNotifier.On("Send", mock.Anything, mock.MatchedBy(func(msg *notifier.Message) bool {
+		return msg.Title == "BUY signal: BTC"
+	})).Return(nil)
+
+	err := service.Notify(context.Background(), testSignal("signal-1"))
+	assert.NoError(t, err)
+	mockNotifier.AssertExpectations(t)
+	mockRepo.AssertNotCalled(t, "Enqueue", mock.Anything, mock.Anything)
+}
+
+func TestNotify_QueuesDuringQuietHours(t *testing.T) {
+	service, mockRepo, mockNotifier := newTestService(night)
+
+	mockRepo.On("GetPreferences", mock.Anything).Return(quietPrefs(), nil)
+	mockRepo.On("Enqueue", mock.Anything, &domain.QueuedNotification{SignalID: "signal-1", QueuedAt: night}).Return(nil)
+
+	err := service.Notify(context.Background(), testSignal("signal-1"))
+	assert.NoError(t, err)
+	mockRepo.AssertExpectations(t)
+	mockNotifier.AssertNotCalled(t, "Send", mock.Anything, mock.Anything)
+}
+
+func TestNotify_SkipsMutedStrategy(t *testing.T) {
+	service, mockRepo, mockNotifier := newTestService(daytime)
+
+	prefs := domain.DefaultNotificationPreferences()
+	prefs.Mute("strategy-1")
+	mockRepo.On("GetPreferences", mock.Anything).Return(prefs, nil)
+
+	err := service.Notify(context.Background(), testSignal("signal-1"))
+	assert.NoError(t, err)
+	mockNotifier.AssertNotCalled(t, "Send", mock.Anything, mock.Anything)
+}
+
+func TestNotify_SkipsBelowMinimumSeverity(t *testing.T) {
+	service, mockRepo, mockNotifier := newTestService(daytime)
+
+	prefs := domain.DefaultNotificationPreferences()
+	prefs.MinSeverity = domain.SeverityCritical
+	mockRepo.On("GetPreferences", mock.Anything).Return(prefs, nil)
+
+	err := service.Notify(context.Background(), testSignal("signal-1"))
+	assert.NoError(t, err)
+	mockNotifier.AssertNotCalled(t, "Send", mock.Anything, mock.Anything)
+}
+
+func TestFlushDigest_SendsQueuedSignalsAfterQuietHours(t *testing.T) {
+	service, mockRepo, mockNotifier := newTestService(daytime)
+
+	queued := []*domain.Signal{testSignal("signal-1"), testSignal("signal-2")}
+	mockRepo.On("GetPreferences", mock.Anything).Return(quietPrefs(), nil)
+	mockRepo.On("FindQueued", mock.Anything).Return(queued, nil)
+	mockNotifier.On("Send", mock.Anything, mock.MatchedBy(func(msg *notifier.Message) bool {
+		return len(msg.Signals) == 2
+	})).Return(nil)
+	mockRepo.On("Dequeue", mock.Anything, []string{"signal-1", "signal-2"}).Return(nil)
+
+	err := service.FlushDigest(context.Background())
+	assert.NoError(t, err)
+	mockRepo.AssertExpectations(t)
+	mockNotifier.AssertExpectations(t)
+}
+
+func TestFlushDigest_WaitsUntilQuietHoursEnd(t *testing.T) {
+	service, mockRepo, mockNotifier := newTestService(night)
+
+	mockRepo.On("GetPreferences", mock.Anything).Return(quietPrefs(), nil)
+
+	err := service.FlushDigest(context.Background())
+	assert.NoError(t, err)
+	mockRepo.AssertNotCalled(t, "FindQueued", mock.Anything)
+	mockNotifier.AssertNotCalled(t, "Send", mock.Anything, mock.Anything)
+}
+
+func TestFlushDigest_KeepsQueueWhenDeliveryFails(t *testing.T) {
+	service, mockRepo, mockNotifier := newTestService(daytime)
+
+	mockRepo.On("GetPreferences", mock.Anything).Return(quietPrefs(), nil)
+	mockRepo.On("FindQueued", mock.Anything).Return([]*domain.Signal{testSignal("signal-1")}, nil)
+	mockNotifier.On("Send", mock.Anything, mock.Anything).Return(errors.New("webhook down"))
+
+	err := service.FlushDigest(context.Background())
+	assert.Error(t, err)
+	mockRepo.AssertNotCalled(t, "Dequeue", mock.Anything, mock.Anything)
+}
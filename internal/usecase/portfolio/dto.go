@@ -0,0 +1,26 @@
+package portfolio
+
+import "github.com/shopspring/decimal"
+
+// PositionResponse represents the holding of one symbol.
+type PositionResponse struct {
+	Symbol         string          // BTC, ETH, USDT, etc.
+	Quantity       decimal.Decimal // Amount currently held
+	AverageCost    decimal.Decimal // Weighted average cost per unit
+	CostBasis      decimal.Decimal // Total cost of the amount held
+	RealizedPnL    decimal.Decimal // Profit or loss locked in by sells
+	PriceAvailable bool            // Whether a market price could be fetched
+	MarketPrice    decimal.Decimal // Latest market price, zero if unavailable
+	MarketValue    decimal.Decimal // Value of the amount held at the market price
+	UnrealizedPnL  decimal.Decimal // Profit or loss of the amount held at the market price
+}
+
+// PortfolioResponse represents all positions and their totals.
+// Totals only include the market value and unrealized PnL of positions with a price.
+type PortfolioResponse struct {
+	Positions          []*PositionResponse
+	TotalCostBasis     decimal.Decimal
+	TotalMarketValue   decimal.Decimal
+	TotalRealizedPnL   decimal.Decimal
+	TotalUnrealizedPnL decimal.Decimal
+}
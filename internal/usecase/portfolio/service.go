@@ -0,0 +1,76 @@
+package portfolio
+
+import (
+	"context"
+
+	"transaction/internal/adapter/exchange"
+	"transaction/internal/adapter/repository"
+	"transaction/internal/domain"
+	"transaction/pkg/logger"
+)
+
+// PortfolioService derives positions and profit and loss from recorded trades.
+type PortfolioService struct {
+	repo   repository.ITradeRepository
+	prices exchange.IPriceFeed
+	logger logger.Logger
+}
+
+// NewPortfolioService creates a new instance of PortfolioService.
+func NewPortfolioService(repo repository.ITradeRepository, prices exchange.IPriceFeed, logger logger.Logger) *PortfolioService {
+	return &PortfolioService{
+		repo:   repo,
+		prices: prices,
+		logger: logger,
+	}
+}
+
+// GetPortfolio replays all trades into positions and values the open ones at
+// the latest market price. A symbol whose price cannot be fetched is reported
+// without a valuation instead of failing the whole portfolio.
+func (s *PortfolioService) GetPortfolio(ctx context.Context) (*PortfolioResponse, error) {
+	s.logger.Info("Building portfolio")
+
+	trades, err := s.repo.FindAll(ctx)
+	if err != nil {
+		s.logger.Error("Failed to load trades", "error", err.Error())
+		return nil, err
+	}
+
+	positions, err := domain.BuildPositions(trades)
+	if err != nil {
+		s.logger.Error("Failed to build positions", "error", err.Error())
+		return nil, err
+	}
+
+	resp := &PortfolioResponse{Positions: make([]*PositionResponse, 0, len(positions))}
+	for _, position := range positions {
+		item := &PositionResponse{
+			Symbol:      position.Symbol,
+			Quantity:    position.Quantity,
+			AverageCost: position.AverageCost,
+			CostBasis:   position.CostBasis(),
+			RealizedPnL: position.RealizedPnL,
+		}
+
+		if position.IsOpen() {
+			price, err := s.prices.GetPrice(ctx, position.Symbol)
+			if err != nil {
+				s.logger.Warn("Price unavailable for position", "symbol", position.Symbol, "error", err.Error())
+			} else {
+				item.PriceAvailable = true
+				item.MarketPrice = price
+				item.MarketValue = position.MarketValue(price)
+				item.UnrealizedPnL = position.UnrealizedPnL(price)
+			}
+		}
+
+		resp.Positions = append(resp.Positions, item)
+		resp.TotalCostBasis = resp.TotalCostBasis.Add(item.CostBasis)
+		resp.TotalMarketValue = resp.TotalMarketValue.Add(item.MarketValue)
+		resp.TotalRealizedPnL = resp.TotalRealizedPnL.Add(item.RealizedPnL)
+		resp.TotalUnrealizedPnL = resp.TotalUnrealizedPnL.Add(item.UnrealizedPnL)
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,138 @@
+package portfolio
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+	"transaction/internal/adapter/exchange/static"
+	"transaction/internal/adapter/repository"
+	"transaction/internal/domain"
+	"transaction/internal/testutil"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// MockTradeRepository is a mock implementation of ITradeRepository.
+type MockTradeRepository struct {
+	mock.Mock
+}
+
+func (m *MockTradeRepository) Create(ctx context.Context, trade *domain.Trade) (*domain.Trade, error) {
+	args := m.Called(ctx, trade)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Trade), args.Error(1)
+}
+
+func (m *MockTradeRepository) FindByID(ctx context.Context, id string) (*domain.Trade, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Trade), args.Error(1)
+}
+
+func (m *MockTradeRepository) FindAll(ctx context.Context) ([]*domain.Trade, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.Trade), args.Error(1)
+}
+
+func (m *MockTradeRepository) FindBySymbol(ctx context.Context, symbol string) ([]*domain.Trade, error) {
+	args := m.Called(ctx, symbol)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.Trade), args.Error(1)
+}
+
+func (m *MockTradeRepository) Find(ctx context.Context, filter repository.TradeFilter) ([]*domain.Trade, error) {
+	args := m.Called(ctx, filter)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.Trade), args.Error(1)
+}
+
+func testTrades() []*domain.Trade {
+	base := time.Date(2025, 11, 5, 10, 0, 0, 0, time.UTC)
+	return []*domain.Trade{
+		{Symbol: "BTC", Side: domain.SideBuy, Quantity: decimal.NewFromInt(2), Price: decimal.NewFromInt(50000), ExecutedAt: base},
+		{Symbol: "BTC", Side: domain.SideSell, Quantity: decimal.NewFromInt(1), Price: decimal.NewFromInt(60000), ExecutedAt: base.Add(time.Hour)},
+		{Symbol: "ETH", Side: domain.SideBuy, Quantity: decimal.NewFromInt(10), Price: decimal.NewFromInt(3000), ExecutedAt: base},
+		{Symbol: "SOL", Side: domain.SideBuy, Quantity: decimal.NewFromInt(5), Price: decimal.NewFromInt(100), ExecutedAt: base},
+		{Symbol: "SOL", Side: domain.SideSell, Quantity: decimal.NewFromInt(5), Price: decimal.NewFromInt(120), ExecutedAt: base.Add(time.Hour)},
+	}
+}
+
+func TestGetPortfolio_Success(t *testing.T) {
+	mockRepo := new(MockTradeRepository)
+	mockLogger := new(testutil.MockLogger)
+	prices := static.NewPriceFeed(map[string]decimal.Decimal{"BTC": decimal.NewFromInt(70000), "ETH": decimal.NewFromInt(2500)})
+	service := NewPortfolioService(mockRepo, prices, mockLogger)
+
+	mockLogger.On("Info", mock.Anything, mock.Anything).Return()
+	mockRepo.On("FindAll", mock.Anything).Return(testTrades(), nil)
+
+	resp, err := service.GetPortfolio(context.Background())
+	require.NoError(t, err)
+	require.Len(t, resp.Positions, 3)
+
+	btc := resp.Positions[0]
+	assert.Equal(t, "BTC", btc.Symbol)
+	assert.Equal(t, "1", btc.Quantity.String())
+	assert.Equal(t, "10000", btc.RealizedPnL.String())
+	assert.Equal(t, "70000", btc.MarketValue.String())
+	assert.Equal(t, "20000", btc.UnrealizedPnL.String())
+
+	eth := resp.Positions[1]
+	assert.Equal(t, "-5000", eth.UnrealizedPnL.String())
+
+	sol := resp.Positions[2]
+	assert.Equal(t, "0", sol.Quantity.String())
+	assert.Equal(t, "100", sol.RealizedPnL.String())
+	assert.False(t, sol.PriceAvailable)
+
+	assert.Equal(t, "80000", resp.TotalCostBasis.String())
+	assert.Equal(t, "95000", resp.TotalMarketValue.String())
+	assert.Equal(t, "10100", resp.TotalRealizedPnL.String())
+	assert.Equal(t, "15000", resp.TotalUnrealizedPnL.String())
+}
+
+func TestGetPortfolio_MissingPriceIsReported(t *testing.T) {
+	mockRepo := new(MockTradeRepository)
+	mockLogger := new(testutil.MockLogger)
+	prices := static.NewPriceFeed(map[string]decimal.Decimal{"BTC": decimal.NewFromInt(70000)})
+	service := NewPortfolioService(mockRepo, prices, mockLogger)
+
+	mockLogger.On("Info", mock.Anything, mock.Anything).Return()
+	mockLogger.On("Warn", mock.Anything, mock.Anything).Return()
+	mockRepo.On("FindAll", mock.Anything).Return(testTrades(), nil)
+
+	resp, err := service.GetPortfolio(context.Background())
+	require.NoError(t, err)
+	assert.False(t, resp.Positions[1].PriceAvailable)
+	assert.Equal(t, "70000", resp.TotalMarketValue.String())
+	mockLogger.AssertCalled(t, "Warn", "Price unavailable for position", mock.Anything)
+}
+
+func TestGetPortfolio_RepositoryError(t *testing.T) {
+	mockRepo := new(MockTradeRepository)
+	mockLogger := new(testutil.MockLogger)
+	service := NewPortfolioService(mockRepo, static.NewPriceFeed(nil), mockLogger)
+
+	mockLogger.On("Info", mock.Anything, mock.Anything).Return()
+	mockLogger.On("Error", mock.Anything, mock.Anything).Return()
+	mockRepo.On("FindAll", mock.Anything).Return(nil, errors.New("database error"))
+
+	resp, err := service.GetPortfolio(context.Background())
+	assert.Error(t, err)
+	assert.Nil(t, resp)
+}
@@ -0,0 +1,10 @@
+package schema
+
+import "time"
+
+// MigrationResponse represents one schema migration and whether it has been applied.
+type MigrationResponse struct {
+	Version   int        // Position in the migration history, starting at 1
+	Name      string     // Short description of the change
+	AppliedAt *time.Time // When the migration was applied, nil while it is pending
+}
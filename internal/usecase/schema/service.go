@@ -0,0 +1,82 @@
+package schema
+
+import (
+	"context"
+	"fmt"
+
+	"transaction/internal/adapter/repository"
+	"transaction/pkg/logger"
+)
+
+// SchemaService implements business logic for managing the database schema.
+type SchemaService struct {
+	migrator repository.IMigrator
+	logger   logger.Logger
+}
+
+// NewSchemaService creates a new instance of SchemaService.
+func NewSchemaService(migrator repository.IMigrator, logger logger.Logger) *SchemaService {
+	return &SchemaService{
+		migrator: migrator,
+		logger:   logger,
+	}
+}
+
+// MigrateUp applies every pending migration and returns the ones applied.
+func (s *SchemaService) MigrateUp(ctx context.Context) ([]*MigrationResponse, error) {
+	s.logger.Info("Applying pending migrations")
+
+	applied, err := s.migrator.Up(ctx)
+	if err != nil {
+		s.logger.Error("Failed to apply migrations", "applied", len(applied), "error", err.Error())
+		return nil, err
+	}
+
+	return toResponses(applied), nil
+}
+
+// MigrateDown rolls back the latest steps applied migrations, newest first,
+// and returns the ones rolled back. Rolling back drops the data they hold.
+func (s *SchemaService) MigrateDown(ctx context.Context, steps int) ([]*MigrationResponse, error) {
+	s.logger.Info("Rolling back migrations", "steps", steps)
+
+	if steps < 1 {
+		return nil, fmt.Errorf("steps must be at least 1")
+	}
+
+	rolledBack, err := s.migrator.Down(ctx, steps)
+	if err != nil {
+		s.logger.Error("Failed to roll back migrations", "rolled_back", len(rolledBack), "error", err.Error())
+		return nil, err
+	}
+
+	return toResponses(rolledBack), nil
+}
+
+// Status lists every known migration in version order.
+func (s *SchemaService) Status(ctx context.Context) ([]*MigrationResponse, error) {
+	statuses, err := s.migrator.Status(ctx)
+	if err != nil {
+		s.logger.Error("Failed to read migration status", "error", err.Error())
+		return nil, err
+	}
+
+	responses := make([]*MigrationResponse, len(statuses))
+	for i, status := range statuses {
+		responses[i] = &MigrationResponse{
+			Version:   status.Version,
+			Name:      status.Name,
+			AppliedAt: status.AppliedAt,
+		}
+	}
+	return responses, nil
+}
+
+// toResponses converts migrations to MigrationResponses.
+func toResponses(migrations []repository.Migration) []*MigrationResponse {
+	responses := make([]*MigrationResponse, len(migrations))
+	for i, migration := range migrations {
+		responses[i] = &MigrationResponse{Version: migration.Version, Name: migration.Name}
+	}
+	return responses
+}
@@ -0,0 +1,111 @@
+package schema
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"transaction/internal/adapter/repository"
+	"transaction/internal/testutil"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// MockMigrator is a mock implementation of IMigrator.
+type MockMigrator struct {
+	mock.Mock
+}
+
+func (m *MockMigrator) Up(ctx context.Context) ([]repository.Migration, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]repository.Migration), args.Error(1)
+}
+
+func (m *MockMigrator) Down(ctx context.Context, steps int) ([]repository.Migration, error) {
+	args := m.Called(ctx, steps)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]repository.Migration), args.Error(1)
+}
+
+func (m *MockMigrator) Status(ctx context.Context) ([]repository.MigrationStatus, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]repository.MigrationStatus), args.Error(1)
+}
+
+func TestMigrateUp_Success(t *testing.T) {
+	migrator := new(MockMigrator)
+	service := NewSchemaService(migrator, testutil.NewMockLogger())
+
+	migrator.On("Up", mock.Anything).Return([]repository.Migration{
+		{Version: 4, Name: "create_markets"},
+		{Version: 5, Name: "create_strategy_audits"},
+	}, nil)
+
+	applied, err := service.MigrateUp(context.Background())
+	require.NoError(t, err)
+	require.Len(t, applied, 2)
+	assert.Equal(t, 4, applied[0].Version)
+	assert.Equal(t, "create_strategy_audits", applied[1].Name)
+}
+
+func TestMigrateUp_Error(t *testing.T) {
+	migrator := new(MockMigrator)
+	service := NewSchemaService(migrator, testutil.NewMockLogger())
+
+	migrator.On("Up", mock.Anything).Return(nil, errors.New("migration 0002_broken failed"))
+
+	applied, err := service.MigrateUp(context.Background())
+	assert.Error(t, err)
+	assert.Nil(t, applied)
+}
+
+func TestMigrateDown_Success(t *testing.T) {
+	migrator := new(MockMigrator)
+	service := NewSchemaService(migrator, testutil.NewMockLogger())
+
+	migrator.On("Down", mock.Anything, 1).Return([]repository.Migration{
+		{Version: 5, Name: "create_strategy_audits"},
+	}, nil)
+
+	rolledBack, err := service.MigrateDown(context.Background(), 1)
+	require.NoError(t, err)
+	require.Len(t, rolledBack, 1)
+	assert.Equal(t, 5, rolledBack[0].Version)
+}
+
+func TestMigrateDown_InvalidSteps(t *testing.T) {
+	migrator := new(MockMigrator)
+	service := NewSchemaService(migrator, testutil.NewMockLogger())
+
+	_, err := service.MigrateDown(context.Background(), 0)
+	assert.Error(t, err)
+	migrator.AssertNotCalled(t, "Down", mock.Anything, mock.Anything)
+}
+
+func TestStatus_Success(t *testing.T) {
+	migrator := new(MockMigrator)
+	service := NewSchemaService(migrator, testutil.NewMockLogger())
+
+	appliedAt := time.Date(2025, 11, 5, 10, 0, 0, 0, time.UTC)
+	migrator.On("Status", mock.Anything).Return([]repository.MigrationStatus{
+		{Migration: repository.Migration{Version: 1, Name: "create_strategies"}, AppliedAt: &appliedAt},
+		{Migration: repository.Migration{Version: 2, Name: "create_signals_and_notifications"}},
+	}, nil)
+
+	statuses, err := service.Status(context.Background())
+	require.NoError(t, err)
+	require.Len(t, statuses, 2)
+	assert.Equal(t, &appliedAt, statuses[0].AppliedAt)
+	assert.Nil(t, statuses[1].AppliedAt)
+}
@@ -0,0 +1,32 @@
+package signal
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// ListSignalsRequest represents the request to list recorded signals.
+type ListSignalsRequest struct {
+	StrategyID string    // Optional: only signals of this strategy
+	Since      time.Time // Optional: only signals triggered at or after this time
+}
+
+// WatchSignalsRequest represents the request to follow newly recorded signals.
+type WatchSignalsRequest struct {
+	StrategyID string        // Optional: only signals of this strategy
+	Interval   time.Duration // Optional: time between two checks, defaults to DefaultWatchInterval
+}
+
+// SignalResponse represents the response containing signal data.
+type SignalResponse struct {
+	ID             string          // Unique identifier
+	StrategyID     string          // Strategy that triggered the signal
+	Symbol         string          // BTC, ETH, USDT, etc.
+	Side           string          // BUY or SELL
+	TriggerPrice   decimal.Decimal // Strategy bound that was crossed
+	ObservedPrice  decimal.Decimal // Market price that crossed the bound
+	TriggeredAt    time.Time       // When the monitor observed the trigger
+	Acknowledged   bool            // Whether the signal has been reviewed
+	AcknowledgedAt *time.Time      // When the signal was reviewed
+}
@@ -0,0 +1,150 @@
+package signal
+
+import (
+	"context"
+	"time"
+
+	"transaction/internal/adapter/repository"
+	"transaction/internal/domain"
+	"transaction/pkg/logger"
+)
+
+// DefaultWatchInterval is how often WatchSignals checks for new signals. It
+// bounds how late a signal reaches a watcher, for one database query per
+// watcher per interval.
+const DefaultWatchInterval = time.Second
+
+// watchLookback is how far back WatchSignals checks again before its previous
+// check, so a signal stored shortly after the moment it was triggered, possibly
+// by a monitor running in another process, is not missed.
+const watchLookback = time.Minute
+
+// SignalService implements business logic for reviewing recorded signals.
+type SignalService struct {
+	repo   repository.ISignalRepository
+	logger logger.Logger
+}
+
+// NewSignalService creates a new instance of SignalService.
+func NewSignalService(repo repository.ISignalRepository, logger logger.Logger) *SignalService {
+	return &SignalService{
+		repo:   repo,
+		logger: logger,
+	}
+}
+
+// ListSignals retrieves the recorded signals matching the request, most recent first.
+func (s *SignalService) ListSignals(ctx context.Context, req *ListSignalsRequest) ([]*SignalResponse, error) {
+	s.logger.Info("Listing signals", "strategy_id", req.StrategyID)
+
+	signals, err := s.repo.Find(ctx, repository.SignalFilter{
+		StrategyID: req.StrategyID,
+		Since:      req.Since,
+	})
+	if err != nil {
+		s.logger.Error("Failed to list signals", "error", err.Error())
+		return nil, err
+	}
+
+	responses := make([]*SignalResponse, len(signals))
+	for i, signal := range signals {
+		responses[i] = toResponse(signal)
+	}
+	return responses, nil
+}
+
+// AcknowledgeSignal marks a signal as reviewed.
+func (s *SignalService) AcknowledgeSignal(ctx context.Context, id string) (*SignalResponse, error) {
+	s.logger.Info("Acknowledging signal", "id", id)
+
+	signal, err := s.repo.FindByID(ctx, id)
+	if err != nil {
+		s.logger.Error("Signal not found", "id", id)
+		return nil, err
+	}
+
+	signal.Acknowledge(time.Now())
+
+	updated, err := s.repo.Update(ctx, signal)
+	if err != nil {
+		s.logger.Error("Failed to acknowledge signal", "id", id)
+		return nil, err
+	}
+
+	return toResponse(updated), nil
+}
+
+// WatchSignals passes every signal recorded after the call to emit, oldest
+// first, until ctx is cancelled or emit fails. Signals are read back from the
+// repository, so those recorded by a monitor in another process are included.
+// That is the only way to see them: the live feed (feed.FeedService) carries
+// the signals of a monitor in the same process only, and should be preferred
+// when there is one.
+func (s *SignalService) WatchSignals(ctx context.Context, req *WatchSignalsRequest, emit func(*SignalResponse) error) error {
+	s.logger.Info("Watching signals", "strategy_id", req.StrategyID)
+
+	interval := req.Interval
+	if interval <= 0 {
+		interval = DefaultWatchInterval
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	start := time.Now()
+	lastCheck := start
+	seen := make(map[string]time.Time)
+	for {
+		since := lastCheck.Add(-watchLookback)
+		if since.Before(start) {
+			since = start
+		}
+		lastCheck = time.Now()
+
+		signals, err := s.repo.Find(ctx, repository.SignalFilter{StrategyID: req.StrategyID, Since: since})
+		if err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			s.logger.Error("Failed to watch signals", "error", err.Error())
+			return err
+		}
+
+		// Find returns the most recent signal first
+		for i := len(signals) - 1; i >= 0; i-- {
+			signal := signals[i]
+			if _, ok := seen[signal.ID]; ok {
+				continue
+			}
+			seen[signal.ID] = signal.TriggeredAt
+			if err := emit(toResponse(signal)); err != nil {
+				return err
+			}
+		}
+		for id, triggeredAt := range seen {
+			if triggeredAt.Before(since) {
+				delete(seen, id)
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// toResponse converts a domain Signal to a SignalResponse.
+func toResponse(s *domain.Signal) *SignalResponse {
+	return &SignalResponse{
+		ID:             s.ID,
+		StrategyID:     s.StrategyID,
+		Symbol:         s.Symbol,
+		Side:           string(s.Side),
+		TriggerPrice:   s.TriggerPrice,
+		ObservedPrice:  s.ObservedPrice,
+		TriggeredAt:    s.TriggeredAt,
+		Acknowledged:   s.Acknowledged,
+		AcknowledgedAt: s.AcknowledgedAt,
+	}
+}
@@ -0,0 +1,152 @@
+package signal
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+	"transaction/internal/adapter/repository"
+	"transaction/internal/domain"
+	"transaction/internal/testutil"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestListSignals_Success(t *testing.T) {
+	mockRepo := new(testutil.MockSignalRepository)
+	mockLogger := new(testutil.MockLogger)
+	service := NewSignalService(mockRepo, mockLogger)
+
+	since := time.Date(2025, 11, 1, 0, 0, 0, 0, time.UTC)
+	signals := []*domain.Signal{
+		{ID: "signal-2", StrategyID: "strategy-1", Symbol: "BTC", Side: domain.SideSell, TriggerPrice: decimal.NewFromInt(70000), ObservedPrice: decimal.NewFromInt(70100)},
+		{ID: "signal-1", StrategyID: "strategy-1", Symbol: "BTC", Side: domain.SideBuy, TriggerPrice: decimal.NewFromInt(60000), ObservedPrice: decimal.NewFromInt(59900)},
+	}
+
+	mockLogger.On("Info", mock.Anything, mock.Anything).Return()
+	mockRepo.On("Find", mock.Anything, repository.SignalFilter{StrategyID: "strategy-1", Since: since}).Return(signals, nil)
+
+	resp, err := service.ListSignals(context.Background(), &ListSignalsRequest{StrategyID: "strategy-1", Since: since})
+	assert.NoError(t, err)
+	assert.Len(t, resp, 2)
+	assert.Equal(t, "SELL", resp[0].Side)
+	assert.Equal(t, "59900", resp[1].ObservedPrice.String())
+}
+
+func TestListSignals_Error(t *testing.T) {
+	mockRepo := new(testutil.MockSignalRepository)
+	mockLogger := new(testutil.MockLogger)
+	service := NewSignalService(mockRepo, mockLogger)
+
+	mockLogger.On("Info", mock.Anything, mock.Anything).Return()
+	mockLogger.On("Error", mock.Anything, mock.Anything).Return()
+	mockRepo.On("Find", mock.Anything, mock.Anything).Return(nil, assert.AnError)
+
+	resp, err := service.ListSignals(context.Background(), &ListSignalsRequest{})
+	assert.Error(t, err)
+	assert.Nil(t, resp)
+}
+
+func TestAcknowledgeSignal_Success(t *testing.T) {
+	mockRepo := new(testutil.MockSignalRepository)
+	mockLogger := new(testutil.MockLogger)
+	service := NewSignalService(mockRepo, mockLogger)
+
+	signal := &domain.Signal{ID: "signal-1", StrategyID: "strategy-1", Side: domain.SideBuy}
+
+	mockLogger.On("Info", mock.Anything, mock.Anything).Return()
+	mockRepo.On("FindByID", mock.Anything, "signal-1").Return(signal, nil)
+	mockRepo.On("Update", mock.Anything, mock.MatchedBy(func(s *domain.Signal) bool {
+		return s.ID == "signal-1" && s.Acknowledged && s.AcknowledgedAt != nil
+	})).Return(signal, nil)
+
+	resp, err := service.AcknowledgeSignal(context.Background(), "signal-1")
+	assert.NoError(t, err)
+	assert.True(t, resp.Acknowledged)
+}
+
+func TestAcknowledgeSignal_NotFound(t *testing.T) {
+	mockRepo := new(testutil.MockSignalRepository)
+	mockLogger := new(testutil.MockLogger)
+	service := NewSignalService(mockRepo, mockLogger)
+
+	mockLogger.On("Info", mock.Anything, mock.Anything).Return()
+	mockLogger.On("Error", mock.Anything, mock.Anything).Return()
+	mockRepo.On("FindByID", mock.Anything, "missing").Return(nil, domain.ErrSignalNotFound)
+
+	resp, err := service.AcknowledgeSignal(context.Background(), "missing")
+	assert.ErrorIs(t, err, domain.ErrSignalNotFound)
+	assert.Nil(t, resp)
+}
+
+func TestWatchSignals_EmitsNewSignalsOnce(t *testing.T) {
+	mockRepo := new(testutil.MockSignalRepository)
+	mockLogger := new(testutil.MockLogger)
+	service := NewSignalService(mockRepo, mockLogger)
+
+	now := time.Now()
+	first := &domain.Signal{ID: "signal-1", StrategyID: "strategy-1", Side: domain.SideBuy, TriggeredAt: now.Add(time.Second)}
+	second := &domain.Signal{ID: "signal-2", StrategyID: "strategy-1", Side: domain.SideSell, TriggeredAt: now.Add(2 * time.Second)}
+	third := &domain.Signal{ID: "signal-3", StrategyID: "strategy-1", Side: domain.SideBuy, TriggeredAt: now.Add(3 * time.Second)}
+
+	mockLogger.On("Info", mock.Anything, mock.Anything).Return()
+	filter := mock.MatchedBy(func(f repository.SignalFilter) bool {
+		return f.StrategyID == "strategy-1" && !f.Since.Before(now)
+	})
+	mockRepo.On("Find", mock.Anything, filter).Return([]*domain.Signal{}, nil).Once()
+	mockRepo.On("Find", mock.Anything, filter).Return([]*domain.Signal{second, first}, nil).Once()
+	mockRepo.On("Find", mock.Anything, filter).Return([]*domain.Signal{third, second, first}, nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var emitted []string
+	err := service.WatchSignals(ctx, &WatchSignalsRequest{StrategyID: "strategy-1", Interval: time.Millisecond}, func(s *SignalResponse) error {
+		emitted = append(emitted, s.ID)
+		if len(emitted) == 3 {
+			cancel()
+		}
+		return nil
+	})
+
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Equal(t, []string{"signal-1", "signal-2", "signal-3"}, emitted)
+}
+
+func TestWatchSignals_StopsWhenEmitFails(t *testing.T) {
+	mockRepo := new(testutil.MockSignalRepository)
+	mockLogger := new(testutil.MockLogger)
+	service := NewSignalService(mockRepo, mockLogger)
+
+	sendErr := errors.New("client went away")
+	signal := &domain.Signal{ID: "signal-1", TriggeredAt: time.Now()}
+
+	mockLogger.On("Info", mock.Anything, mock.Anything).Return()
+	mockRepo.On("Find", mock.Anything, mock.Anything).Return([]*domain.Signal{signal}, nil)
+
+	err := service.WatchSignals(context.Background(), &WatchSignalsRequest{Interval: time.Millisecond}, func(*SignalResponse) error {
+		return sendErr
+	})
+
+	assert.ErrorIs(t, err, sendErr)
+	mockRepo.AssertNumberOfCalls(t, "Find", 1)
+}
+
+func TestWatchSignals_RepositoryError(t *testing.T) {
+	mockRepo := new(testutil.MockSignalRepository)
+	mockLogger := new(testutil.MockLogger)
+	service := NewSignalService(mockRepo, mockLogger)
+
+	mockLogger.On("Info", mock.Anything, mock.Anything).Return()
+	mockLogger.On("Error", mock.Anything, mock.Anything).Return()
+	mockRepo.On("Find", mock.Anything, mock.Anything).Return(nil, errors.New("database is locked"))
+
+	err := service.WatchSignals(context.Background(), &WatchSignalsRequest{}, func(*SignalResponse) error {
+		t.Fatal("no signal expected")
+		return nil
+	})
+
+	assert.EqualError(t, err, "database is locked")
+}
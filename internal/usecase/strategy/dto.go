@@ -1,25 +1,120 @@
 package strategy
 
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
 // CreateStrategyRequest represents the request to create a new strategy.
 type CreateStrategyRequest struct {
-	Symbol    string  // BTC, ETH, USDT, etc.
-	BuyLower  float64 // Minimum price to trigger buy signal
-	SellUpper float64 // Maximum price to trigger sell signal
+	Symbol    string          // BTC, ETH, USDT, etc.
+	BuyLower  decimal.Decimal // Minimum price to trigger buy signal
+	SellUpper decimal.Decimal // Maximum price to trigger sell signal
+	Cooldown  *time.Duration  // Optional: minimum time between two signals
 }
 
-// UpdateStrategyRequest represents the request to update an existing strategy.
+// UpdateStrategyRequest represents a partial update of an existing strategy.
+// Nil fields keep their current value.
 type UpdateStrategyRequest struct {
-	ID        string  // Strategy ID
-	Symbol    string  // BTC, ETH, USDT, etc.
-	BuyLower  float64 // Minimum price to trigger buy signal
-	SellUpper float64 // Maximum price to trigger sell signal
+	ID              string           // Strategy ID
+	Symbol          *string          // Optional: BTC, ETH, USDT, etc.
+	BuyLower        *decimal.Decimal // Optional: minimum price to trigger buy signal
+	SellUpper       *decimal.Decimal // Optional: maximum price to trigger sell signal
+	Cooldown        *time.Duration   // Optional: minimum time between two signals
+	ExpectedVersion *int             // Optional: reject the update unless the strategy is still at this version
+}
+
+// ListStrategiesRequest represents the filters, ordering and page of a strategy query.
+// Zero-valued fields are ignored.
+type ListStrategiesRequest struct {
+	Symbol         string    // Only strategies of this symbol
+	Active         *bool     // Only active or only inactive strategies
+	CreatedAfter   time.Time // Only strategies created at or after this time
+	CreatedBefore  time.Time // Only strategies created before this time
+	SortBy         string    // created_at, symbol, buy_lower or sell_upper; defaults to created_at
+	Descending     bool      // Sort in descending order
+	Page           int       // 1-based page number, requires PageSize
+	PageSize       int       // Strategies per page, zero returns every match
+	IncludeDeleted bool      // Also list soft deleted strategies
 }
 
 // StrategyResponse represents the response containing strategy data.
 type StrategyResponse struct {
-	ID        string  // Unique identifier
-	Symbol    string  // BTC, ETH, USDT, etc.
-	BuyLower  float64 // Minimum price to trigger buy signal
-	SellUpper float64 // Maximum price to trigger sell signal
-	IsActive  bool    // Whether the strategy is currently active
+	ID        string          // Unique identifier
+	Symbol    string          // BTC, ETH, USDT, etc.
+	BuyLower  decimal.Decimal // Minimum price to trigger buy signal
+	SellUpper decimal.Decimal // Maximum price to trigger sell signal
+	Cooldown  time.Duration   // Minimum time between two signals
+	IsActive  bool            // Whether the strategy is currently active
+	Version   int             // Incremented on every change, used for concurrency checks
+	CreatedAt time.Time       // When the strategy was created
+	DeletedAt *time.Time      // When the strategy was deleted, nil if it was not
+}
+
+// FieldChange describes one strategy field changed by an audited action.
+type FieldChange struct {
+	Field string // symbol, buy_lower, sell_upper, cooldown or active
+	From  string // Value before the change, empty for a creation
+	To    string // Value after the change, empty for a deletion
+}
+
+// AuditEntryResponse represents one entry of a strategy's audit log.
+type AuditEntryResponse struct {
+	ID        string        // Unique identifier
+	Action    string        // CREATE, UPDATE, DELETE, TOGGLE or RESTORE
+	Actor     string        // Who made the change
+	Changes   []FieldChange // Fields that differ between the snapshots
+	Before    string        // Strategy before the change as JSON, empty for a creation
+	After     string        // Strategy after the change as JSON, empty for a deletion
+	CreatedAt time.Time     // When the change was made
+}
+
+// Import results of a valid row, in ImportRowResult.Action.
+const (
+	ImportCreated   = "CREATED"   // The row is a new strategy
+	ImportUpdated   = "UPDATED"   // The row changes an existing strategy
+	ImportUnchanged = "UNCHANGED" // The row matches an existing strategy
+)
+
+// ImportStrategyRow is one strategy of an imported file. Fields are kept as
+// written in the file so that a malformed value is reported with its row.
+type ImportStrategyRow struct {
+	Row       int    // Position in the file, used in the report
+	ID        string // Optional: ID of the strategy, matched against existing strategies
+	Symbol    string // BTC/USDT, ETH, etc.
+	BuyLower  string // Minimum price to trigger buy signal
+	SellUpper string // Maximum price to trigger sell signal
+	Cooldown  string // Optional: duration such as 15m; a new strategy defaults to DefaultCooldown
+	Active    string // Optional: true or false; a new strategy defaults to true
+	Version   string // Optional: version an existing strategy must still be at, as exported
+}
+
+// ImportStrategiesRequest represents the request to create or update many
+// strategies at once. Either every row is applied or none is.
+type ImportStrategiesRequest struct {
+	Rows   []*ImportStrategyRow
+	DryRun bool // Validate every row without saving anything
+	Upsert bool // Update the strategies whose ID already exists instead of rejecting their row
+}
+
+// ImportStrategiesResponse reports the outcome of every row of an import.
+// Nothing is saved when Errors is not empty.
+type ImportStrategiesResponse struct {
+	Results []*ImportRowResult // Valid rows, in the order of the request
+	Errors  []*ImportRowError  // Invalid rows, in the order of the request
+}
+
+// ImportRowResult represents what an import does, or would do in a dry run,
+// with a valid row.
+type ImportRowResult struct {
+	Row      int               // Position in the file
+	Action   string            // CREATED, UPDATED or UNCHANGED
+	Strategy *StrategyResponse // Strategy as saved, or as it would be saved in a dry run
+}
+
+// ImportRowError represents why a row cannot be imported.
+type ImportRowError struct {
+	Row int   // Position in the file
+	Err error // Validation error written for the author of the file
 }
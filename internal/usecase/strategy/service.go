@@ -1,45 +1,80 @@
 package strategy
 
 import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
 	"transaction/internal/adapter/repository"
 	"transaction/internal/domain"
 	"transaction/pkg/logger"
 
 	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
 )
 
 // StrategyService implements business logic for strategy management.
 type StrategyService struct {
-	repo   repository.IStrategyRepository
-	logger logger.Logger
+	repo    repository.IStrategyRepository
+	markets repository.IMarketRepository
+	audits  repository.IStrategyAuditRepository
+	tx      repository.ITransactor
+	logger  logger.Logger
 }
 
-// NewStrategyService creates a new instance of StrategyService.
-func NewStrategyService(repo repository.IStrategyRepository, logger logger.Logger) *StrategyService {
+// NewStrategyService creates a new instance of StrategyService. Every change
+// is written to the audit log in the same transaction as the change itself.
+func NewStrategyService(
+	repo repository.IStrategyRepository,
+	markets repository.IMarketRepository,
+	audits repository.IStrategyAuditRepository,
+	tx repository.ITransactor,
+	logger logger.Logger,
+) *StrategyService {
 	return &StrategyService{
-		repo:   repo,
-		logger: logger,
+		repo:    repo,
+		markets: markets,
+		audits:  audits,
+		tx:      tx,
+		logger:  logger,
 	}
 }
 
 // CreateStrategy creates a new strategy.
-func (s *StrategyService) CreateStrategy(req *CreateStrategyRequest) (*StrategyResponse, error) {
+func (s *StrategyService) CreateStrategy(ctx context.Context, req *CreateStrategyRequest) (*StrategyResponse, error) {
 	s.logger.Info("Creating strategy", "symbol", req.Symbol)
 
+	market, err := s.findMarket(ctx, req.Symbol)
+	if err != nil {
+		s.logger.Error("Strategy validation failed", "error", err.Error())
+		return nil, err
+	}
+
 	strategy := &domain.Strategy{
 		ID:        uuid.New().String(),
-		Symbol:    req.Symbol,
+		Symbol:    market.Symbol,
 		BuyLower:  req.BuyLower,
 		SellUpper: req.SellUpper,
+		Cooldown:  req.Cooldown,
 		IsActive:  true,
 	}
 
-	if err := strategy.Validate(); err != nil {
+	if err := validate(strategy, market); err != nil {
 		s.logger.Error("Strategy validation failed", "error", err.Error())
 		return nil, err
 	}
 
-	created, err := s.repo.Create(strategy)
+	var created *domain.Strategy
+	err = s.tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		if created, err = s.repo.Create(ctx, strategy); err != nil {
+			return err
+		}
+		return s.record(ctx, domain.AuditCreate, nil, created)
+	})
 	if err != nil {
 		s.logger.Error("Failed to create strategy", "error", err.Error())
 		return nil, err
@@ -49,10 +84,10 @@ func (s *StrategyService) CreateStrategy(req *CreateStrategyRequest) (*StrategyR
 }
 
 // GetStrategy retrieves a strategy by ID.
-func (s *StrategyService) GetStrategy(id string) (*StrategyResponse, error) {
+func (s *StrategyService) GetStrategy(ctx context.Context, id string) (*StrategyResponse, error) {
 	s.logger.Info("Fetching strategy", "id", id)
 
-	strategy, err := s.repo.FindByID(id)
+	strategy, err := s.repo.FindByID(ctx, id)
 	if err != nil {
 		s.logger.Error("Strategy not found", "id", id)
 		return nil, err
@@ -61,11 +96,17 @@ func (s *StrategyService) GetStrategy(id string) (*StrategyResponse, error) {
 	return toResponse(strategy), nil
 }
 
-// ListStrategies retrieves all strategies.
-func (s *StrategyService) ListStrategies() ([]*StrategyResponse, error) {
-	s.logger.Info("Listing all strategies")
+// ListStrategies retrieves the strategies matching the request.
+func (s *StrategyService) ListStrategies(ctx context.Context, req *ListStrategiesRequest) ([]*StrategyResponse, error) {
+	s.logger.Info("Listing strategies")
 
-	strategies, err := s.repo.FindAll()
+	filter, err := toFilter(req)
+	if err != nil {
+		s.logger.Error("Invalid strategy filter", "error", err.Error())
+		return nil, domain.Invalid(err)
+	}
+
+	strategies, err := s.repo.Find(ctx, filter)
 	if err != nil {
 		s.logger.Error("Failed to list strategies", "error", err.Error())
 		return nil, err
@@ -78,36 +119,81 @@ func (s *StrategyService) ListStrategies() ([]*StrategyResponse, error) {
 	return responses, nil
 }
 
-// UpdateStrategy updates an existing strategy.
-func (s *StrategyService) UpdateStrategy(req *UpdateStrategyRequest) (*StrategyResponse, error) {
+// UpdateStrategy applies a partial update to an existing strategy. Fields left
+// nil in the request keep their current value. The update fails with
+// domain.ErrConcurrentModification if the strategy changed since it was read,
+// or since ExpectedVersion when one is given.
+func (s *StrategyService) UpdateStrategy(ctx context.Context, req *UpdateStrategyRequest) (*StrategyResponse, error) {
 	s.logger.Info("Updating strategy", "id", req.ID)
 
-	strategy := &domain.Strategy{
-		ID:        req.ID,
-		Symbol:    req.Symbol,
-		BuyLower:  req.BuyLower,
-		SellUpper: req.SellUpper,
-	}
-
-	if err := strategy.Validate(); err != nil {
+	if req.Symbol == nil && req.BuyLower == nil && req.SellUpper == nil && req.Cooldown == nil {
+		err := domain.Invalid(fmt.Errorf("nothing to update: set at least one of symbol, buy lower, sell upper or cooldown"))
 		s.logger.Error("Strategy validation failed", "error", err.Error())
 		return nil, err
 	}
 
-	updated, err := s.repo.Update(strategy)
+	var updated *domain.Strategy
+	err := s.tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		before, err := s.repo.FindByID(ctx, req.ID)
+		if err != nil {
+			return err
+		}
+		if req.ExpectedVersion != nil && *req.ExpectedVersion != before.Version {
+			return fmt.Errorf("%w: expected version %d, found %d",
+				domain.ErrConcurrentModification, *req.ExpectedVersion, before.Version)
+		}
+
+		strategy := *before
+		symbol := before.Symbol
+		if req.Symbol != nil {
+			symbol = *req.Symbol
+		}
+		market, err := s.findMarket(ctx, symbol)
+		if err != nil {
+			return err
+		}
+		strategy.Symbol = market.Symbol
+		if req.BuyLower != nil {
+			strategy.BuyLower = *req.BuyLower
+		}
+		if req.SellUpper != nil {
+			strategy.SellUpper = *req.SellUpper
+		}
+		if req.Cooldown != nil {
+			strategy.Cooldown = req.Cooldown
+		}
+
+		if err := validate(&strategy, market); err != nil {
+			return err
+		}
+		if updated, err = s.repo.Update(ctx, &strategy); err != nil {
+			return err
+		}
+		return s.record(ctx, domain.AuditUpdate, before, updated)
+	})
 	if err != nil {
-		s.logger.Error("Failed to update strategy", "id", req.ID)
+		s.logger.Error("Failed to update strategy", "id", req.ID, "error", err.Error())
 		return nil, err
 	}
 
 	return toResponse(updated), nil
 }
 
-// DeleteStrategy deletes a strategy by ID.
-func (s *StrategyService) DeleteStrategy(id string) error {
+// DeleteStrategy soft deletes a strategy by ID. It stops being monitored and
+// listed but can be restored until it is purged.
+func (s *StrategyService) DeleteStrategy(ctx context.Context, id string) error {
 	s.logger.Info("Deleting strategy", "id", id)
 
-	err := s.repo.Delete(id)
+	err := s.tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		before, err := s.repo.FindByID(ctx, id)
+		if err != nil {
+			return err
+		}
+		if err := s.repo.Delete(ctx, id); err != nil {
+			return err
+		}
+		return s.record(ctx, domain.AuditDelete, before, nil)
+	})
 	if err != nil {
 		s.logger.Error("Failed to delete strategy", "id", id)
 		return err
@@ -117,24 +203,390 @@ func (s *StrategyService) DeleteStrategy(id string) error {
 }
 
 // ToggleStrategy toggles the active status of a strategy.
-func (s *StrategyService) ToggleStrategy(id string) (*StrategyResponse, error) {
+func (s *StrategyService) ToggleStrategy(ctx context.Context, id string) (*StrategyResponse, error) {
 	s.logger.Info("Toggling strategy status", "id", id)
 
-	strategy, err := s.repo.FindByID(id)
+	var updated *domain.Strategy
+	err := s.tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		before, err := s.repo.FindByID(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		strategy := *before
+		strategy.IsActive = !strategy.IsActive
+
+		if updated, err = s.repo.Update(ctx, &strategy); err != nil {
+			return err
+		}
+		return s.record(ctx, domain.AuditToggle, before, updated)
+	})
 	if err != nil {
-		s.logger.Error("Strategy not found", "id", id)
+		s.logger.Error("Failed to toggle strategy", "id", id)
 		return nil, err
 	}
 
-	strategy.IsActive = !strategy.IsActive
+	return toResponse(updated), nil
+}
+
+// RestoreStrategy brings back a soft deleted strategy.
+func (s *StrategyService) RestoreStrategy(ctx context.Context, id string) (*StrategyResponse, error) {
+	s.logger.Info("Restoring strategy", "id", id)
 
-	updated, err := s.repo.Update(strategy)
+	var restored *domain.Strategy
+	err := s.tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		if restored, err = s.repo.Restore(ctx, id); err != nil {
+			return err
+		}
+		return s.record(ctx, domain.AuditRestore, nil, restored)
+	})
 	if err != nil {
-		s.logger.Error("Failed to toggle strategy", "id", id)
+		s.logger.Error("Failed to restore strategy", "id", id)
 		return nil, err
 	}
 
-	return toResponse(updated), nil
+	return toResponse(restored), nil
+}
+
+// PurgeStrategies permanently removes the strategies deleted more than
+// olderThan ago and returns how many were removed. Their audit history is kept.
+func (s *StrategyService) PurgeStrategies(ctx context.Context, olderThan time.Duration) (int64, error) {
+	s.logger.Info("Purging deleted strategies", "older_than", olderThan)
+
+	if olderThan < 0 {
+		return 0, domain.Invalid(fmt.Errorf("purge age must not be negative"))
+	}
+
+	purged, err := s.repo.Purge(ctx, time.Now().Add(-olderThan))
+	if err != nil {
+		s.logger.Error("Failed to purge strategies", "error", err.Error())
+		return 0, err
+	}
+
+	return purged, nil
+}
+
+// GetStrategyHistory retrieves the audit log of a strategy, oldest change first.
+// The history of a deleted strategy remains available, and a strategy without
+// audit entries has an empty history.
+func (s *StrategyService) GetStrategyHistory(ctx context.Context, id string) ([]*AuditEntryResponse, error) {
+	s.logger.Info("Fetching strategy history", "id", id)
+
+	entries, err := s.audits.FindByStrategyID(ctx, id)
+	if err != nil {
+		s.logger.Error("Failed to load strategy history", "id", id, "error", err.Error())
+		return nil, err
+	}
+	if len(entries) == 0 {
+		// A strategy created before the audit log existed has no history yet.
+		found, err := s.repo.Find(ctx, repository.StrategyFilter{ID: id, IncludeDeleted: true})
+		if err != nil {
+			s.logger.Error("Failed to load strategy history", "id", id, "error", err.Error())
+			return nil, err
+		}
+		if len(found) == 0 {
+			s.logger.Error("Strategy not found", "id", id)
+			return nil, domain.ErrStrategyNotFound
+		}
+	}
+
+	responses := make([]*AuditEntryResponse, len(entries))
+	for i, entry := range entries {
+		response, err := toAuditResponse(entry)
+		if err != nil {
+			s.logger.Error("Failed to read strategy history", "id", id, "error", err.Error())
+			return nil, err
+		}
+		responses[i] = response
+	}
+	return responses, nil
+}
+
+// importPlan is what an import does with a valid row.
+type importPlan struct {
+	row      int
+	action   string
+	before   *domain.Strategy // nil for a new strategy
+	strategy *domain.Strategy
+}
+
+// ImportStrategies creates the strategies of an imported file and, with
+// Upsert, updates those whose ID already exists, in a single transaction.
+// Every row is checked before anything is saved, so a row failing validation
+// leaves the strategies untouched and is reported in the response Errors
+// rather than returned as an error. A new strategy without an ID is given one
+// when it is saved.
+//
+// A row giving the version of an existing strategy is only applied if the
+// strategy is still at that version, as with UpdateStrategyRequest's
+// ExpectedVersion; otherwise the row is a conflict wrapping
+// domain.ErrConcurrentModification and nothing is saved. The version of a
+// row creating a strategy is ignored, so an export can be imported into an
+// empty database.
+func (s *StrategyService) ImportStrategies(ctx context.Context, req *ImportStrategiesRequest) (*ImportStrategiesResponse, error) {
+	s.logger.Info("Importing strategies", "rows", len(req.Rows), "dry_run", req.DryRun, "upsert", req.Upsert)
+
+	var response *ImportStrategiesResponse
+	err := s.tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		response = &ImportStrategiesResponse{Results: make([]*ImportRowResult, 0, len(req.Rows))}
+
+		existing, err := s.repo.Find(ctx, repository.StrategyFilter{IncludeDeleted: true})
+		if err != nil {
+			return err
+		}
+		byID := make(map[string]*domain.Strategy, len(existing))
+		for _, strategy := range existing {
+			byID[strategy.ID] = strategy
+		}
+
+		seen := make(map[string]int, len(req.Rows))
+		plans := make([]*importPlan, 0, len(req.Rows))
+		for _, row := range req.Rows {
+			plan, err := s.planImport(ctx, row, byID, seen, req.Upsert)
+			var validationErr *domain.ValidationError
+			switch {
+			case errors.As(err, &validationErr):
+				response.Errors = append(response.Errors, &ImportRowError{Row: row.Row, Err: err})
+			case err != nil:
+				return err
+			default:
+				plans = append(plans, plan)
+			}
+		}
+
+		for _, plan := range plans {
+			if len(response.Errors) == 0 && !req.DryRun {
+				if err := s.applyImport(ctx, plan); err != nil {
+					return fmt.Errorf("row %d: %w", plan.row, err)
+				}
+			}
+			response.Results = append(response.Results, &ImportRowResult{
+				Row:      plan.row,
+				Action:   plan.action,
+				Strategy: toResponse(plan.strategy),
+			})
+		}
+		return nil
+	})
+	if err != nil {
+		s.logger.Error("Failed to import strategies", "error", err.Error())
+		return nil, err
+	}
+	if len(response.Errors) > 0 {
+		s.logger.Error("Strategy import rejected", "invalid_rows", len(response.Errors))
+	}
+
+	return response, nil
+}
+
+// planImport checks an imported row and works out the strategy it describes.
+// IDs are matched against existing, and against the IDs of earlier rows
+// recorded in seen. Problems with the row are validation errors.
+func (s *StrategyService) planImport(
+	ctx context.Context,
+	row *ImportStrategyRow,
+	existing map[string]*domain.Strategy,
+	seen map[string]int,
+	upsert bool,
+) (*importPlan, error) {
+	id := strings.TrimSpace(row.ID)
+	var before *domain.Strategy
+	if id != "" {
+		if first, ok := seen[id]; ok {
+			return nil, domain.Invalid(fmt.Errorf("id %s is already used by row %d", id, first))
+		}
+		seen[id] = row.Row
+
+		before = existing[id]
+		switch {
+		case before == nil:
+		case before.IsDeleted():
+			return nil, domain.Invalid(fmt.Errorf("strategy %s is deleted: restore it before importing it", id))
+		case !upsert:
+			return nil, domain.Invalid(fmt.Errorf("strategy %s already exists: enable upsert to update it", id))
+		}
+	}
+	if raw := strings.TrimSpace(row.Version); raw != "" {
+		version, err := strconv.Atoi(raw)
+		if err != nil || version < 1 {
+			return nil, domain.Invalid(fmt.Errorf("version %q must be a positive whole number", raw))
+		}
+		if before != nil && version != before.Version {
+			return nil, domain.Invalid(fmt.Errorf("%w: strategy %s expected version %d, found %d",
+				domain.ErrConcurrentModification, id, version, before.Version))
+		}
+	}
+
+	symbol := strings.TrimSpace(row.Symbol)
+	if symbol == "" {
+		return nil, domain.Invalid(fmt.Errorf("symbol is required"))
+	}
+	buyLower, err := parseImportPrice("buy_lower", row.BuyLower)
+	if err != nil {
+		return nil, err
+	}
+	sellUpper, err := parseImportPrice("sell_upper", row.SellUpper)
+	if err != nil {
+		return nil, err
+	}
+
+	strategy := &domain.Strategy{ID: id, IsActive: true}
+	if before != nil {
+		copied := *before
+		strategy = &copied
+	}
+	strategy.BuyLower = buyLower
+	strategy.SellUpper = sellUpper
+
+	if raw := strings.TrimSpace(row.Cooldown); raw != "" {
+		cooldown, err := time.ParseDuration(raw)
+		if err != nil {
+			return nil, domain.Invalid(fmt.Errorf("cooldown %q is not a duration such as 15m", raw))
+		}
+		strategy.Cooldown = &cooldown
+	}
+	if raw := strings.TrimSpace(row.Active); raw != "" {
+		active, err := strconv.ParseBool(raw)
+		if err != nil {
+			return nil, domain.Invalid(fmt.Errorf("is_active %q must be true or false", raw))
+		}
+		strategy.IsActive = active
+	}
+
+	market, err := s.findMarket(ctx, symbol)
+	if err != nil {
+		return nil, err
+	}
+	strategy.Symbol = market.Symbol
+	if err := validate(strategy, market); err != nil {
+		return nil, err
+	}
+
+	plan := &importPlan{row: row.Row, action: ImportCreated, before: before, strategy: strategy}
+	if before != nil {
+		plan.action = ImportUpdated
+		if len(domain.DiffStrategies(before, strategy)) == 0 {
+			plan.action = ImportUnchanged
+		}
+	}
+	return plan, nil
+}
+
+// applyImport saves the strategy of a planned row along with its audit entry.
+func (s *StrategyService) applyImport(ctx context.Context, plan *importPlan) error {
+	var err error
+	switch plan.action {
+	case ImportCreated:
+		if plan.strategy.ID == "" {
+			plan.strategy.ID = uuid.New().String()
+		}
+		if plan.strategy, err = s.repo.Create(ctx, plan.strategy); err != nil {
+			return err
+		}
+		return s.record(ctx, domain.AuditCreate, nil, plan.strategy)
+	case ImportUpdated:
+		if plan.strategy, err = s.repo.Update(ctx, plan.strategy); err != nil {
+			return err
+		}
+		return s.record(ctx, domain.AuditUpdate, plan.before, plan.strategy)
+	default:
+		return nil
+	}
+}
+
+// parseImportPrice parses a price of an imported row.
+func parseImportPrice(field, raw string) (decimal.Decimal, error) {
+	raw = strings.TrimSpace(raw)
+	if raw == "" {
+		return decimal.Zero, domain.Invalid(fmt.Errorf("%s is required", field))
+	}
+	price, err := decimal.NewFromString(raw)
+	if err != nil {
+		return decimal.Zero, domain.Invalid(fmt.Errorf("%s %q is not a number", field, raw))
+	}
+	return price, nil
+}
+
+// record appends an audit entry for a change made by the actor bound to ctx.
+func (s *StrategyService) record(ctx context.Context, action domain.AuditAction, before, after *domain.Strategy) error {
+	entry, err := domain.NewStrategyAudit(uuid.New().String(), action, domain.ActorFromContext(ctx), before, after, time.Now())
+	if err != nil {
+		return err
+	}
+	return s.audits.Append(ctx, entry)
+}
+
+// toFilter validates a list request and converts it to a repository filter.
+func toFilter(req *ListStrategiesRequest) (repository.StrategyFilter, error) {
+	filter := repository.StrategyFilter{
+		Active:         req.Active,
+		CreatedAfter:   req.CreatedAfter,
+		CreatedBefore:  req.CreatedBefore,
+		SortBy:         strings.ToLower(req.SortBy),
+		Descending:     req.Descending,
+		IncludeDeleted: req.IncludeDeleted,
+	}
+	if req.Symbol != "" {
+		filter.Symbol = domain.NormalizeSymbol(req.Symbol)
+	}
+
+	switch filter.SortBy {
+	case "", repository.StrategySortCreatedAt, repository.StrategySortSymbol,
+		repository.StrategySortBuyLower, repository.StrategySortSellUpper:
+	default:
+		return filter, fmt.Errorf("cannot sort by %q: use created_at, symbol, buy_lower or sell_upper", req.SortBy)
+	}
+
+	if !req.CreatedAfter.IsZero() && !req.CreatedBefore.IsZero() && !req.CreatedAfter.Before(req.CreatedBefore) {
+		return filter, fmt.Errorf("created range is empty: the start must be before the end")
+	}
+
+	if req.PageSize < 0 || req.Page < 0 {
+		return filter, fmt.Errorf("page and page size must not be negative")
+	}
+	if req.PageSize > 0 {
+		page := req.Page
+		if page == 0 {
+			page = 1
+		}
+		filter.Limit = req.PageSize
+		filter.Offset = (page - 1) * req.PageSize
+	}
+
+	return filter, nil
+}
+
+// findMarket resolves a user supplied symbol to an enabled registered market.
+// An unknown or disabled market is a validation error.
+func (s *StrategyService) findMarket(ctx context.Context, symbol string) (*domain.Market, error) {
+	normalized := domain.NormalizeSymbol(symbol)
+	market, err := s.markets.FindBySymbol(ctx, normalized)
+	if err != nil {
+		if errors.Is(err, domain.ErrMarketNotFound) {
+			return nil, domain.Invalid(fmt.Errorf("%w: %q is not a registered trading pair", domain.ErrMarketNotFound, symbol))
+		}
+		return nil, err
+	}
+	if !market.Enabled {
+		return nil, domain.Invalid(fmt.Errorf("%w: %s", domain.ErrMarketDisabled, market.Symbol))
+	}
+	return market, nil
+}
+
+// validate checks the strategy on its own and against the trading rules of
+// its market, reporting a problem as a validation error.
+func validate(strategy *domain.Strategy, market *domain.Market) error {
+	if err := strategy.Validate(); err != nil {
+		return domain.Invalid(err)
+	}
+	if err := market.CheckPrice("buy lower bound", strategy.BuyLower); err != nil {
+		return domain.Invalid(err)
+	}
+	if err := market.CheckPrice("sell upper bound", strategy.SellUpper); err != nil {
+		return domain.Invalid(err)
+	}
+	return nil
 }
 
 // toResponse converts a domain Strategy to a StrategyResponse.
@@ -144,6 +596,34 @@ func toResponse(s *domain.Strategy) *StrategyResponse {
 		Symbol:    s.Symbol,
 		BuyLower:  s.BuyLower,
 		SellUpper: s.SellUpper,
+		Cooldown:  s.CooldownPeriod(),
 		IsActive:  s.IsActive,
+		Version:   s.Version,
+		CreatedAt: s.CreatedAt,
+		DeletedAt: s.DeletedAt,
 	}
 }
+
+// toAuditResponse converts a domain StrategyAudit to an AuditEntryResponse.
+func toAuditResponse(a *domain.StrategyAudit) (*AuditEntryResponse, error) {
+	before, after, err := a.Snapshots()
+	if err != nil {
+		return nil, err
+	}
+
+	diff := domain.DiffStrategies(before, after)
+	changes := make([]FieldChange, len(diff))
+	for i, change := range diff {
+		changes[i] = FieldChange{Field: change.Field, From: change.From, To: change.To}
+	}
+
+	return &AuditEntryResponse{
+		ID:        a.ID,
+		Action:    string(a.Action),
+		Actor:     a.Actor,
+		Changes:   changes,
+		Before:    a.Before,
+		After:     a.After,
+		CreatedAt: a.CreatedAt,
+	}, nil
+}
@@ -1,383 +1,1135 @@
 package strategy
 
 import (
+	"context"
+	"errors"
 	"testing"
+	"time"
+	"transaction/internal/adapter/repository"
+	"transaction/internal/adapter/repository/memory"
 	"transaction/internal/domain"
+	"transaction/internal/testutil"
 
+	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 )
 
-// MockRepository is a mock implementation of IStrategyRepository.
-type MockRepository struct {
-	mock.Mock
+// failingRepository is an in-memory repository whose Create, Find and Update
+// fail with the errors set on it.
+type failingRepository struct {
+	repository.IStrategyRepository
+	createErr error
+	findErr   error
+	updateErr error
 }
 
-func (m *MockRepository) Create(strategy *domain.Strategy) (*domain.Strategy, error) {
-	args := m.Called(strategy)
-	if args.Get(0) == nil {
-		return nil, args.Error(1)
+func (r *failingRepository) Create(ctx context.Context, strategy *domain.Strategy) (*domain.Strategy, error) {
+	if r.createErr != nil {
+		return nil, r.createErr
 	}
-	return args.Get(0).(*domain.Strategy), args.Error(1)
+	return r.IStrategyRepository.Create(ctx, strategy)
 }
 
-func (m *MockRepository) FindByID(id string) (*domain.Strategy, error) {
-	args := m.Called(id)
-	if args.Get(0) == nil {
-		return nil, args.Error(1)
+func (r *failingRepository) Find(ctx context.Context, filter repository.StrategyFilter) ([]*domain.Strategy, error) {
+	if r.findErr != nil {
+		return nil, r.findErr
 	}
-	return args.Get(0).(*domain.Strategy), args.Error(1)
+	return r.IStrategyRepository.Find(ctx, filter)
 }
 
-func (m *MockRepository) FindAll() ([]*domain.Strategy, error) {
-	args := m.Called()
-	if args.Get(0) == nil {
-		return nil, args.Error(1)
+func (r *failingRepository) Update(ctx context.Context, strategy *domain.Strategy) (*domain.Strategy, error) {
+	if r.updateErr != nil {
+		return nil, r.updateErr
 	}
-	return args.Get(0).([]*domain.Strategy), args.Error(1)
+	return r.IStrategyRepository.Update(ctx, strategy)
 }
 
-func (m *MockRepository) Update(strategy *domain.Strategy) (*domain.Strategy, error) {
-	args := m.Called(strategy)
-	if args.Get(0) == nil {
-		return nil, args.Error(1)
+// newRepository returns an in-memory repository holding strategies.
+func newRepository(t *testing.T, strategies ...*domain.Strategy) repository.IStrategyRepository {
+	repo := memory.NewStrategyRepository()
+	for _, strategy := range strategies {
+		_, err := repo.Create(context.Background(), strategy)
+		require.NoError(t, err)
 	}
-	return args.Get(0).(*domain.Strategy), args.Error(1)
+	return repo
 }
 
-func (m *MockRepository) Delete(id string) error {
-	args := m.Called(id)
-	return args.Error(0)
+// btcStrategy returns an active BTC/USDT strategy with ID test-id.
+func btcStrategy() *domain.Strategy {
+	return &domain.Strategy{
+		ID:        "test-id",
+		Symbol:    "BTC/USDT",
+		BuyLower:  decimal.NewFromInt(30000),
+		SellUpper: decimal.NewFromInt(50000),
+		IsActive:  true,
+	}
 }
 
-// MockLogger is a mock implementation of Logger.
-type MockLogger struct {
-	mock.Mock
+// assertNoStrategies asserts that repo holds no strategies, deleted or not.
+func assertNoStrategies(t *testing.T, repo repository.IStrategyRepository) {
+	strategies, err := repo.Find(context.Background(), repository.StrategyFilter{IncludeDeleted: true})
+	require.NoError(t, err)
+	assert.Empty(t, strategies)
 }
 
-func (m *MockLogger) Info(msg string, args ...interface{}) {
-	m.Called(msg, args)
-}
+func TestCreateStrategy_Success(t *testing.T) {
+	repo := memory.NewStrategyRepository()
+	mockLogger := new(testutil.MockLogger)
+	service := NewStrategyService(repo, testutil.NewMockMarkets(), testutil.NewMockAudits(), testutil.PassthroughTransactor{}, mockLogger)
 
-func (m *MockLogger) Error(msg string, args ...interface{}) {
-	m.Called(msg, args)
+	req := &CreateStrategyRequest{
+		Symbol:    "BTC",
+		BuyLower:  decimal.NewFromInt(30000),
+		SellUpper: decimal.NewFromInt(50000),
+	}
+
+	mockLogger.On("Info", mock.Anything, mock.Anything).Return()
+
+	resp, err := service.CreateStrategy(context.Background(), req)
+	require.NoError(t, err)
+	assert.Equal(t, "BTC/USDT", resp.Symbol)
+
+	stored, err := repo.FindByID(context.Background(), resp.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "BTC/USDT", stored.Symbol)
+	assert.True(t, stored.BuyLower.Equal(decimal.NewFromInt(30000)))
+	assert.True(t, stored.SellUpper.Equal(decimal.NewFromInt(50000)))
+	assert.True(t, stored.IsActive)
 }
 
-func (m *MockLogger) Warn(msg string, args ...interface{}) {
-	m.Called(msg, args)
+func TestCreateStrategy_WithCooldown(t *testing.T) {
+	repo := memory.NewStrategyRepository()
+	mockLogger := new(testutil.MockLogger)
+	service := NewStrategyService(repo, testutil.NewMockMarkets(), testutil.NewMockAudits(), testutil.PassthroughTransactor{}, mockLogger)
+
+	cooldown := 30 * time.Minute
+	req := &CreateStrategyRequest{
+		Symbol:    "BTC",
+		BuyLower:  decimal.NewFromInt(30000),
+		SellUpper: decimal.NewFromInt(50000),
+		Cooldown:  &cooldown,
+	}
+
+	mockLogger.On("Info", mock.Anything, mock.Anything).Return()
+
+	resp, err := service.CreateStrategy(context.Background(), req)
+	require.NoError(t, err)
+	assert.Equal(t, cooldown, resp.Cooldown)
+
+	stored, err := repo.FindByID(context.Background(), resp.ID)
+	require.NoError(t, err)
+	require.NotNil(t, stored.Cooldown)
+	assert.Equal(t, cooldown, *stored.Cooldown)
 }
 
-func TestCreateStrategy_Success(t *testing.T) {
-	mockRepo := new(MockRepository)
-	mockLogger := new(MockLogger)
-	service := NewStrategyService(mockRepo, mockLogger)
+func TestCreateStrategy_DefaultCooldown(t *testing.T) {
+	repo := memory.NewStrategyRepository()
+	mockLogger := new(testutil.MockLogger)
+	service := NewStrategyService(repo, testutil.NewMockMarkets(), testutil.NewMockAudits(), testutil.PassthroughTransactor{}, mockLogger)
 
 	req := &CreateStrategyRequest{
 		Symbol:    "BTC",
-		BuyLower:  30000.0,
-		SellUpper: 50000.0,
+		BuyLower:  decimal.NewFromInt(30000),
+		SellUpper: decimal.NewFromInt(50000),
 	}
 
 	mockLogger.On("Info", mock.Anything, mock.Anything).Return()
-	mockRepo.On("Create", mock.MatchedBy(func(s *domain.Strategy) bool {
-		return s.Symbol == "BTC" && s.BuyLower == 30000.0 && s.SellUpper == 50000.0 && s.IsActive
-	})).Return(&domain.Strategy{
-		ID:        "test-id",
+
+	resp, err := service.CreateStrategy(context.Background(), req)
+	require.NoError(t, err)
+	assert.Equal(t, domain.DefaultCooldown, resp.Cooldown)
+
+	stored, err := repo.FindByID(context.Background(), resp.ID)
+	require.NoError(t, err)
+	assert.Nil(t, stored.Cooldown)
+}
+
+func TestCreateStrategy_NegativeCooldown(t *testing.T) {
+	repo := memory.NewStrategyRepository()
+	mockLogger := new(testutil.MockLogger)
+	service := NewStrategyService(repo, testutil.NewMockMarkets(), testutil.NewMockAudits(), testutil.PassthroughTransactor{}, mockLogger)
+
+	cooldown := -time.Minute
+	req := &CreateStrategyRequest{
 		Symbol:    "BTC",
-		BuyLower:  30000.0,
-		SellUpper: 50000.0,
-		IsActive:  true,
-	}, nil)
+		BuyLower:  decimal.NewFromInt(30000),
+		SellUpper: decimal.NewFromInt(50000),
+		Cooldown:  &cooldown,
+	}
 
-	resp, err := service.CreateStrategy(req)
-	assert.NoError(t, err)
-	assert.NotNil(t, resp)
-	assert.Equal(t, "BTC", resp.Symbol)
+	mockLogger.On("Info", mock.Anything, mock.Anything).Return()
+	mockLogger.On("Error", mock.Anything, mock.Anything).Return()
+
+	resp, err := service.CreateStrategy(context.Background(), req)
+	assert.Error(t, err)
+	assert.Nil(t, resp)
+	assertNoStrategies(t, repo)
 }
 
 func TestCreateStrategy_InvalidPrice(t *testing.T) {
-	mockRepo := new(MockRepository)
-	mockLogger := new(MockLogger)
-	service := NewStrategyService(mockRepo, mockLogger)
+	repo := memory.NewStrategyRepository()
+	mockLogger := new(testutil.MockLogger)
+	service := NewStrategyService(repo, testutil.NewMockMarkets(), testutil.NewMockAudits(), testutil.PassthroughTransactor{}, mockLogger)
 
 	req := &CreateStrategyRequest{
 		Symbol:    "BTC",
-		BuyLower:  -100.0,
-		SellUpper: 50000.0,
+		BuyLower:  decimal.NewFromInt(-100),
+		SellUpper: decimal.NewFromInt(50000),
 	}
 
 	mockLogger.On("Info", mock.Anything, mock.Anything).Return()
 	mockLogger.On("Error", mock.Anything, mock.Anything).Return()
 
-	resp, err := service.CreateStrategy(req)
-	assert.Error(t, err)
+	resp, err := service.CreateStrategy(context.Background(), req)
+	var validationErr *domain.ValidationError
+	assert.ErrorAs(t, err, &validationErr)
 	assert.Nil(t, resp)
+	assertNoStrategies(t, repo)
 }
 
 func TestCreateStrategy_InvalidBoundaryRelation(t *testing.T) {
-	mockRepo := new(MockRepository)
-	mockLogger := new(MockLogger)
-	service := NewStrategyService(mockRepo, mockLogger)
+	repo := memory.NewStrategyRepository()
+	mockLogger := new(testutil.MockLogger)
+	service := NewStrategyService(repo, testutil.NewMockMarkets(), testutil.NewMockAudits(), testutil.PassthroughTransactor{}, mockLogger)
 
 	req := &CreateStrategyRequest{
 		Symbol:    "BTC",
-		BuyLower:  50000.0,
-		SellUpper: 30000.0,
+		BuyLower:  decimal.NewFromInt(50000),
+		SellUpper: decimal.NewFromInt(30000),
 	}
 
 	mockLogger.On("Info", mock.Anything, mock.Anything).Return()
 	mockLogger.On("Error", mock.Anything, mock.Anything).Return()
 
-	resp, err := service.CreateStrategy(req)
+	resp, err := service.CreateStrategy(context.Background(), req)
 	assert.Error(t, err)
 	assert.Nil(t, resp)
+	assertNoStrategies(t, repo)
 }
 
 func TestGetStrategy_Success(t *testing.T) {
-	mockRepo := new(MockRepository)
-	mockLogger := new(MockLogger)
-	service := NewStrategyService(mockRepo, mockLogger)
+	mockLogger := new(testutil.MockLogger)
+	service := NewStrategyService(newRepository(t, btcStrategy()), testutil.NewMockMarkets(), testutil.NewMockAudits(), testutil.PassthroughTransactor{}, mockLogger)
 
 	mockLogger.On("Info", mock.Anything, mock.Anything).Return()
-	mockRepo.On("FindByID", "test-id").Return(&domain.Strategy{
-		ID:        "test-id",
-		Symbol:    "BTC",
-		BuyLower:  30000.0,
-		SellUpper: 50000.0,
-		IsActive:  true,
-	}, nil)
 
-	resp, err := service.GetStrategy("test-id")
+	resp, err := service.GetStrategy(context.Background(), "test-id")
 	assert.NoError(t, err)
 	assert.NotNil(t, resp)
 	assert.Equal(t, "test-id", resp.ID)
 }
 
 func TestGetStrategy_NotFound(t *testing.T) {
-	mockRepo := new(MockRepository)
-	mockLogger := new(MockLogger)
-	service := NewStrategyService(mockRepo, mockLogger)
+	mockLogger := new(testutil.MockLogger)
+	service := NewStrategyService(memory.NewStrategyRepository(), testutil.NewMockMarkets(), testutil.NewMockAudits(), testutil.PassthroughTransactor{}, mockLogger)
 
 	mockLogger.On("Info", mock.Anything, mock.Anything).Return()
 	mockLogger.On("Error", mock.Anything, mock.Anything).Return()
-	mockRepo.On("FindByID", "nonexistent").Return(nil, domain.ErrStrategyNotFound)
 
-	resp, err := service.GetStrategy("nonexistent")
-	assert.Error(t, err)
+	resp, err := service.GetStrategy(context.Background(), "nonexistent")
+	assert.ErrorIs(t, err, domain.ErrStrategyNotFound)
 	assert.Nil(t, resp)
 }
 
 func TestListStrategies_Success(t *testing.T) {
-	mockRepo := new(MockRepository)
-	mockLogger := new(MockLogger)
-	service := NewStrategyService(mockRepo, mockLogger)
-
-	strategies := []*domain.Strategy{
-		{
-			ID:        "id-1",
-			Symbol:    "BTC",
-			BuyLower:  30000.0,
-			SellUpper: 50000.0,
-			IsActive:  true,
-		},
-		{
+	repo := newRepository(t,
+		btcStrategy(),
+		&domain.Strategy{
 			ID:        "id-2",
-			Symbol:    "ETH",
-			BuyLower:  2000.0,
-			SellUpper: 3000.0,
+			Symbol:    "ETH/USDT",
+			BuyLower:  decimal.NewFromInt(2000),
+			SellUpper: decimal.NewFromInt(3000),
 			IsActive:  true,
 		},
-	}
+	)
+	mockLogger := new(testutil.MockLogger)
+	service := NewStrategyService(repo, testutil.NewMockMarkets(), testutil.NewMockAudits(), testutil.PassthroughTransactor{}, mockLogger)
 
 	mockLogger.On("Info", mock.Anything, mock.Anything).Return()
-	mockRepo.On("FindAll").Return(strategies, nil)
 
-	resp, err := service.ListStrategies()
+	resp, err := service.ListStrategies(context.Background(), &ListStrategiesRequest{})
 	assert.NoError(t, err)
 	assert.NotNil(t, resp)
 	assert.Len(t, resp, 2)
 }
 
 func TestUpdateStrategy_Success(t *testing.T) {
-	mockRepo := new(MockRepository)
-	mockLogger := new(MockLogger)
-	service := NewStrategyService(mockRepo, mockLogger)
+	repo := newRepository(t, btcStrategy())
+	mockLogger := new(testutil.MockLogger)
+	service := NewStrategyService(repo, testutil.NewMockMarkets(), testutil.NewMockAudits(), testutil.PassthroughTransactor{}, mockLogger)
 
 	req := &UpdateStrategyRequest{
 		ID:        "test-id",
-		Symbol:    "BTC",
-		BuyLower:  25000.0,
-		SellUpper: 55000.0,
+		BuyLower:  decimalPtr(decimal.NewFromInt(25000)),
+		SellUpper: decimalPtr(decimal.NewFromInt(55000)),
 	}
 
 	mockLogger.On("Info", mock.Anything, mock.Anything).Return()
 	mockLogger.On("Error", mock.Anything, mock.Anything).Return()
-	mockRepo.On("Update", mock.MatchedBy(func(s *domain.Strategy) bool {
-		return s.ID == "test-id" && s.BuyLower == 25000.0
-	})).Return(&domain.Strategy{
-		ID:        "test-id",
-		Symbol:    "BTC",
-		BuyLower:  25000.0,
-		SellUpper: 55000.0,
-		IsActive:  true,
-	}, nil)
 
-	resp, err := service.UpdateStrategy(req)
+	resp, err := service.UpdateStrategy(context.Background(), req)
 	assert.NoError(t, err)
 	assert.NotNil(t, resp)
-	assert.Equal(t, 25000.0, resp.BuyLower)
+	assert.Equal(t, "25000", resp.BuyLower.String())
+
+	stored, err := repo.FindByID(context.Background(), "test-id")
+	require.NoError(t, err)
+	assert.Equal(t, "25000", stored.BuyLower.String())
+	assert.Equal(t, "55000", stored.SellUpper.String())
 }
 
 func TestDeleteStrategy_Success(t *testing.T) {
-	mockRepo := new(MockRepository)
-	mockLogger := new(MockLogger)
-	service := NewStrategyService(mockRepo, mockLogger)
+	repo := newRepository(t, btcStrategy())
+	mockLogger := new(testutil.MockLogger)
+	service := NewStrategyService(repo, testutil.NewMockMarkets(), testutil.NewMockAudits(), testutil.PassthroughTransactor{}, mockLogger)
 
 	mockLogger.On("Info", mock.Anything, mock.Anything).Return()
 	mockLogger.On("Error", mock.Anything, mock.Anything).Return()
-	mockRepo.On("Delete", "test-id").Return(nil)
 
-	err := service.DeleteStrategy("test-id")
+	err := service.DeleteStrategy(context.Background(), "test-id")
 	assert.NoError(t, err)
+	_, err = repo.FindByID(context.Background(), "test-id")
+	assert.ErrorIs(t, err, domain.ErrStrategyNotFound)
 }
 
 func TestToggleStrategy_Success(t *testing.T) {
-	mockRepo := new(MockRepository)
-	mockLogger := new(MockLogger)
-	service := NewStrategyService(mockRepo, mockLogger)
-
-	strategy := &domain.Strategy{
-		ID:        "test-id",
-		Symbol:    "BTC",
-		BuyLower:  30000.0,
-		SellUpper: 50000.0,
-		IsActive:  true,
-	}
+	repo := newRepository(t, btcStrategy())
+	mockLogger := new(testutil.MockLogger)
+	service := NewStrategyService(repo, testutil.NewMockMarkets(), testutil.NewMockAudits(), testutil.PassthroughTransactor{}, mockLogger)
 
 	mockLogger.On("Info", mock.Anything, mock.Anything).Return()
 	mockLogger.On("Error", mock.Anything, mock.Anything).Return()
-	mockRepo.On("FindByID", "test-id").Return(strategy, nil)
-	mockRepo.On("Update", mock.MatchedBy(func(s *domain.Strategy) bool {
-		return s.ID == "test-id" && !s.IsActive
-	})).Return(&domain.Strategy{
-		ID:        "test-id",
-		Symbol:    "BTC",
-		BuyLower:  30000.0,
-		SellUpper: 50000.0,
-		IsActive:  false,
-	}, nil)
 
-	resp, err := service.ToggleStrategy("test-id")
+	resp, err := service.ToggleStrategy(context.Background(), "test-id")
 	assert.NoError(t, err)
 	assert.NotNil(t, resp)
 	assert.False(t, resp.IsActive)
+
+	stored, err := repo.FindByID(context.Background(), "test-id")
+	require.NoError(t, err)
+	assert.False(t, stored.IsActive)
 }
 
 func TestCreateStrategy_RepositoryError(t *testing.T) {
-	mockRepo := new(MockRepository)
-	mockLogger := new(MockLogger)
-	service := NewStrategyService(mockRepo, mockLogger)
+	repo := &failingRepository{IStrategyRepository: memory.NewStrategyRepository(), createErr: errors.New("disk full")}
+	mockLogger := new(testutil.MockLogger)
+	service := NewStrategyService(repo, testutil.NewMockMarkets(), testutil.NewMockAudits(), testutil.PassthroughTransactor{}, mockLogger)
 
 	req := &CreateStrategyRequest{
 		Symbol:    "BTC",
-		BuyLower:  30000.0,
-		SellUpper: 50000.0,
+		BuyLower:  decimal.NewFromInt(30000),
+		SellUpper: decimal.NewFromInt(50000),
 	}
 
 	mockLogger.On("Info", mock.Anything, mock.Anything).Return()
 	mockLogger.On("Error", mock.Anything, mock.Anything).Return()
-	mockRepo.On("Create", mock.MatchedBy(func(s *domain.Strategy) bool {
-		return s.Symbol == "BTC"
-	})).Return(nil, domain.ErrStrategyNotFound)
 
-	resp, err := service.CreateStrategy(req)
-	assert.Error(t, err)
+	resp, err := service.CreateStrategy(context.Background(), req)
+	assert.EqualError(t, err, "disk full")
 	assert.Nil(t, resp)
 }
 
 func TestUpdateStrategy_StrategyNotFound(t *testing.T) {
-	mockRepo := new(MockRepository)
-	mockLogger := new(MockLogger)
-	service := NewStrategyService(mockRepo, mockLogger)
+	repo := memory.NewStrategyRepository()
+	mockLogger := new(testutil.MockLogger)
+	service := NewStrategyService(repo, testutil.NewMockMarkets(), testutil.NewMockAudits(), testutil.PassthroughTransactor{}, mockLogger)
 
 	req := &UpdateStrategyRequest{
-		ID:        "non-existent",
-		Symbol:    "BTC",
-		BuyLower:  30000.0,
-		SellUpper: 50000.0,
+		ID:       "non-existent",
+		BuyLower: decimalPtr(decimal.NewFromInt(30000)),
 	}
 
 	mockLogger.On("Info", mock.Anything, mock.Anything).Return()
 	mockLogger.On("Error", mock.Anything, mock.Anything).Return()
-	mockRepo.On("Update", mock.MatchedBy(func(s *domain.Strategy) bool {
-		return s.ID == "non-existent"
-	})).Return(nil, domain.ErrStrategyNotFound)
 
-	resp, err := service.UpdateStrategy(req)
-	assert.Error(t, err)
+	resp, err := service.UpdateStrategy(context.Background(), req)
+	assert.ErrorIs(t, err, domain.ErrStrategyNotFound)
+	assert.Nil(t, resp)
+	assertNoStrategies(t, repo)
+}
+
+func TestUpdateStrategy_PartialUpdateKeepsOtherFields(t *testing.T) {
+	cooldown := 45 * time.Minute
+	createdAt := time.Date(2025, 11, 1, 9, 0, 0, 0, time.UTC)
+	repo := newRepository(t, &domain.Strategy{
+		ID:        "test-id",
+		Symbol:    "BTC/USDT",
+		BuyLower:  decimal.NewFromInt(30000),
+		SellUpper: decimal.NewFromInt(50000),
+		Cooldown:  &cooldown,
+		IsActive:  false,
+		Version:   3,
+		CreatedAt: createdAt,
+	})
+	mockLogger := new(testutil.MockLogger)
+	service := NewStrategyService(repo, testutil.NewMockMarkets(), testutil.NewMockAudits(), testutil.PassthroughTransactor{}, mockLogger)
+
+	mockLogger.On("Info", mock.Anything, mock.Anything).Return()
+
+	resp, err := service.UpdateStrategy(context.Background(), &UpdateStrategyRequest{
+		ID:        "test-id",
+		SellUpper: decimalPtr(decimal.NewFromInt(60000)),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 4, resp.Version)
+
+	stored, err := repo.FindByID(context.Background(), "test-id")
+	require.NoError(t, err)
+	assert.Equal(t, "60000", stored.SellUpper.String())
+	assert.Equal(t, "30000", stored.BuyLower.String())
+	assert.Equal(t, "BTC/USDT", stored.Symbol)
+	assert.Equal(t, cooldown, stored.CooldownPeriod())
+	assert.False(t, stored.IsActive)
+	assert.True(t, stored.CreatedAt.Equal(createdAt))
+}
+
+func TestUpdateStrategy_NothingToUpdate(t *testing.T) {
+	mockLogger := new(testutil.MockLogger)
+	service := NewStrategyService(newRepository(t, btcStrategy()), testutil.NewMockMarkets(), testutil.NewMockAudits(), testutil.PassthroughTransactor{}, mockLogger)
+
+	mockLogger.On("Info", mock.Anything, mock.Anything).Return()
+	mockLogger.On("Error", mock.Anything, mock.Anything).Return()
+
+	resp, err := service.UpdateStrategy(context.Background(), &UpdateStrategyRequest{ID: "test-id"})
+	var validationErr *domain.ValidationError
+	assert.ErrorAs(t, err, &validationErr)
 	assert.Nil(t, resp)
 }
 
+func TestUpdateStrategy_ExpectedVersionMismatch(t *testing.T) {
+	strategy := btcStrategy()
+	strategy.Version = 5
+	repo := newRepository(t, strategy)
+	mockLogger := new(testutil.MockLogger)
+	service := NewStrategyService(repo, testutil.NewMockMarkets(), testutil.NewMockAudits(), testutil.PassthroughTransactor{}, mockLogger)
+
+	mockLogger.On("Info", mock.Anything, mock.Anything).Return()
+	mockLogger.On("Error", mock.Anything, mock.Anything).Return()
+
+	expected := 4
+	resp, err := service.UpdateStrategy(context.Background(), &UpdateStrategyRequest{
+		ID:              "test-id",
+		BuyLower:        decimalPtr(decimal.NewFromInt(31000)),
+		ExpectedVersion: &expected,
+	})
+	assert.ErrorIs(t, err, domain.ErrConcurrentModification)
+	assert.Nil(t, resp)
+
+	stored, err := repo.FindByID(context.Background(), "test-id")
+	require.NoError(t, err)
+	assert.Equal(t, 5, stored.Version)
+	assert.Equal(t, "30000", stored.BuyLower.String())
+}
+
+func TestUpdateStrategy_ConcurrentModification(t *testing.T) {
+	repo := &failingRepository{IStrategyRepository: newRepository(t, btcStrategy()), updateErr: domain.ErrConcurrentModification}
+	mockAudits := new(testutil.MockAuditRepository)
+	mockLogger := new(testutil.MockLogger)
+	service := NewStrategyService(repo, testutil.NewMockMarkets(), mockAudits, testutil.PassthroughTransactor{}, mockLogger)
+
+	mockLogger.On("Info", mock.Anything, mock.Anything).Return()
+	mockLogger.On("Error", mock.Anything, mock.Anything).Return()
+
+	resp, err := service.UpdateStrategy(context.Background(), &UpdateStrategyRequest{
+		ID:       "test-id",
+		BuyLower: decimalPtr(decimal.NewFromInt(31000)),
+	})
+	assert.ErrorIs(t, err, domain.ErrConcurrentModification)
+	assert.Nil(t, resp)
+	mockAudits.AssertNotCalled(t, "Append", mock.Anything, mock.Anything)
+}
+
 func TestDeleteStrategy_Error(t *testing.T) {
-	mockRepo := new(MockRepository)
-	mockLogger := new(MockLogger)
-	service := NewStrategyService(mockRepo, mockLogger)
+	mockLogger := new(testutil.MockLogger)
+	service := NewStrategyService(memory.NewStrategyRepository(), testutil.NewMockMarkets(), testutil.NewMockAudits(), testutil.PassthroughTransactor{}, mockLogger)
 
 	mockLogger.On("Info", mock.Anything, mock.Anything).Return()
 	mockLogger.On("Error", mock.Anything, mock.Anything).Return()
-	mockRepo.On("Delete", "test-id").Return(domain.ErrStrategyNotFound)
 
-	err := service.DeleteStrategy("test-id")
-	assert.Error(t, err)
+	err := service.DeleteStrategy(context.Background(), "test-id")
+	assert.ErrorIs(t, err, domain.ErrStrategyNotFound)
 }
 
 func TestListStrategies_Error(t *testing.T) {
-	mockRepo := new(MockRepository)
-	mockLogger := new(MockLogger)
-	service := NewStrategyService(mockRepo, mockLogger)
+	repo := &failingRepository{IStrategyRepository: memory.NewStrategyRepository(), findErr: errors.New("database is locked")}
+	mockLogger := new(testutil.MockLogger)
+	service := NewStrategyService(repo, testutil.NewMockMarkets(), testutil.NewMockAudits(), testutil.PassthroughTransactor{}, mockLogger)
 
 	mockLogger.On("Info", mock.Anything, mock.Anything).Return()
 	mockLogger.On("Error", mock.Anything, mock.Anything).Return()
-	mockRepo.On("FindAll").Return(nil, domain.ErrStrategyNotFound)
 
-	resp, err := service.ListStrategies()
-	assert.Error(t, err)
+	resp, err := service.ListStrategies(context.Background(), &ListStrategiesRequest{})
+	assert.EqualError(t, err, "database is locked")
 	assert.Nil(t, resp)
 }
 
 func TestToggleStrategy_NotFound(t *testing.T) {
-	mockRepo := new(MockRepository)
-	mockLogger := new(MockLogger)
-	service := NewStrategyService(mockRepo, mockLogger)
+	mockLogger := new(testutil.MockLogger)
+	service := NewStrategyService(memory.NewStrategyRepository(), testutil.NewMockMarkets(), testutil.NewMockAudits(), testutil.PassthroughTransactor{}, mockLogger)
 
 	mockLogger.On("Info", mock.Anything, mock.Anything).Return()
 	mockLogger.On("Error", mock.Anything, mock.Anything).Return()
-	mockRepo.On("FindByID", "non-existent").Return(nil, domain.ErrStrategyNotFound)
 
-	resp, err := service.ToggleStrategy("non-existent")
-	assert.Error(t, err)
+	resp, err := service.ToggleStrategy(context.Background(), "non-existent")
+	assert.ErrorIs(t, err, domain.ErrStrategyNotFound)
 	assert.Nil(t, resp)
 }
 
 func TestToggleStrategy_UpdateError(t *testing.T) {
-	mockRepo := new(MockRepository)
-	mockLogger := new(MockLogger)
-	service := NewStrategyService(mockRepo, mockLogger)
+	repo := &failingRepository{IStrategyRepository: newRepository(t, btcStrategy()), updateErr: errors.New("disk full")}
+	mockLogger := new(testutil.MockLogger)
+	service := NewStrategyService(repo, testutil.NewMockMarkets(), testutil.NewMockAudits(), testutil.PassthroughTransactor{}, mockLogger)
 
-	strategy := &domain.Strategy{
-		ID:        "test-id",
+	mockLogger.On("Info", mock.Anything, mock.Anything).Return()
+	mockLogger.On("Error", mock.Anything, mock.Anything).Return()
+
+	resp, err := service.ToggleStrategy(context.Background(), "test-id")
+	assert.EqualError(t, err, "disk full")
+	assert.Nil(t, resp)
+}
+
+func TestCreateStrategy_NormalizesSymbol(t *testing.T) {
+	repo := memory.NewStrategyRepository()
+	mockLogger := new(testutil.MockLogger)
+	service := NewStrategyService(repo, testutil.NewMockMarkets(), testutil.NewMockAudits(), testutil.PassthroughTransactor{}, mockLogger)
+
+	mockLogger.On("Info", mock.Anything, mock.Anything).Return()
+
+	resp, err := service.CreateStrategy(context.Background(), &CreateStrategyRequest{
+		Symbol:    "btc-usdt",
+		BuyLower:  decimal.NewFromInt(30000),
+		SellUpper: decimal.NewFromInt(50000),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "BTC/USDT", resp.Symbol)
+
+	stored, err := repo.FindByID(context.Background(), resp.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "BTC/USDT", stored.Symbol)
+}
+
+func TestCreateStrategy_UnknownSymbol(t *testing.T) {
+	repo := memory.NewStrategyRepository()
+	mockMarkets := new(testutil.MockMarketRepository)
+	mockLogger := new(testutil.MockLogger)
+	service := NewStrategyService(repo, mockMarkets, testutil.NewMockAudits(), testutil.PassthroughTransactor{}, mockLogger)
+
+	mockLogger.On("Info", mock.Anything, mock.Anything).Return()
+	mockLogger.On("Error", mock.Anything, mock.Anything).Return()
+	mockMarkets.On("FindBySymbol", mock.Anything, "BTC/USD").Return(nil, domain.ErrMarketNotFound)
+
+	resp, err := service.CreateStrategy(context.Background(), &CreateStrategyRequest{
+		Symbol:    "BTC/USD",
+		BuyLower:  decimal.NewFromInt(30000),
+		SellUpper: decimal.NewFromInt(50000),
+	})
+	assert.ErrorIs(t, err, domain.ErrMarketNotFound)
+	var validationErr *domain.ValidationError
+	assert.ErrorAs(t, err, &validationErr)
+	assert.Nil(t, resp)
+	assertNoStrategies(t, repo)
+}
+
+func TestCreateStrategy_DisabledMarket(t *testing.T) {
+	repo := memory.NewStrategyRepository()
+	mockMarkets := new(testutil.MockMarketRepository)
+	mockLogger := new(testutil.MockLogger)
+	service := NewStrategyService(repo, mockMarkets, testutil.NewMockAudits(), testutil.PassthroughTransactor{}, mockLogger)
+
+	market := domain.NewMarket("ETH", "USDT", decimal.RequireFromString("0.01"), decimal.RequireFromString("0.0001"), decimal.NewFromInt(5))
+	market.Enabled = false
+
+	mockLogger.On("Info", mock.Anything, mock.Anything).Return()
+	mockLogger.On("Error", mock.Anything, mock.Anything).Return()
+	mockMarkets.On("FindBySymbol", mock.Anything, "ETH/USDT").Return(market, nil)
+
+	resp, err := service.CreateStrategy(context.Background(), &CreateStrategyRequest{
+		Symbol:    "ETH",
+		BuyLower:  decimal.NewFromInt(3000),
+		SellUpper: decimal.NewFromInt(4000),
+	})
+	assert.ErrorIs(t, err, domain.ErrMarketDisabled)
+	assert.Nil(t, resp)
+	assertNoStrategies(t, repo)
+}
+
+func TestCreateStrategy_PriceOffTickSize(t *testing.T) {
+	repo := memory.NewStrategyRepository()
+	mockMarkets := new(testutil.MockMarketRepository)
+	mockLogger := new(testutil.MockLogger)
+	service := NewStrategyService(repo, mockMarkets, testutil.NewMockAudits(), testutil.PassthroughTransactor{}, mockLogger)
+
+	market := domain.NewMarket("BTC", "USDT", decimal.RequireFromString("0.5"), decimal.RequireFromString("0.00001"), decimal.NewFromInt(5))
+
+	mockLogger.On("Info", mock.Anything, mock.Anything).Return()
+	mockLogger.On("Error", mock.Anything, mock.Anything).Return()
+	mockMarkets.On("FindBySymbol", mock.Anything, "BTC/USDT").Return(market, nil)
+
+	resp, err := service.CreateStrategy(context.Background(), &CreateStrategyRequest{
 		Symbol:    "BTC",
-		BuyLower:  30000.0,
-		SellUpper: 50000.0,
-		IsActive:  true,
+		BuyLower:  decimal.RequireFromString("30000.2"),
+		SellUpper: decimal.NewFromInt(50000),
+	})
+	assert.EqualError(t, err, "buy lower bound 30000.2 is not a multiple of the BTC/USDT tick size 0.5")
+	assert.Nil(t, resp)
+	assertNoStrategies(t, repo)
+}
+
+func TestListStrategies_AppliesFilter(t *testing.T) {
+	base := time.Date(2025, 11, 1, 0, 0, 0, 0, time.UTC)
+	strategy := func(id, symbol string, buyLower int64, active bool, createdAt time.Time) *domain.Strategy {
+		return &domain.Strategy{
+			ID:        id,
+			Symbol:    symbol,
+			BuyLower:  decimal.NewFromInt(buyLower),
+			SellUpper: decimal.NewFromInt(buyLower * 2),
+			IsActive:  active,
+			CreatedAt: createdAt,
+		}
 	}
+	repo := newRepository(t,
+		strategy("too-old", "BTC/USDT", 40000, true, base.Add(-time.Hour)),
+		strategy("eth", "ETH/USDT", 2000, true, base.Add(time.Hour)),
+		strategy("inactive", "BTC/USDT", 35000, false, base.Add(time.Hour)),
+		strategy("btc-1", "BTC/USDT", 30000, true, base.Add(time.Hour)),
+		strategy("btc-2", "BTC/USDT", 32000, true, base.Add(2*time.Hour)),
+		strategy("btc-3", "BTC/USDT", 31000, true, base.Add(3*time.Hour)),
+	)
+	mockLogger := new(testutil.MockLogger)
+	service := NewStrategyService(repo, testutil.NewMockMarkets(), testutil.NewMockAudits(), testutil.PassthroughTransactor{}, mockLogger)
+
+	mockLogger.On("Info", mock.Anything, mock.Anything).Return()
+
+	// Active BTC/USDT strategies created since base by buy lower, highest
+	// first, are btc-2, btc-3 and btc-1; the second page of two holds btc-1
+	active := true
+	resp, err := service.ListStrategies(context.Background(), &ListStrategiesRequest{
+		Symbol:       "btc",
+		Active:       &active,
+		CreatedAfter: base,
+		SortBy:       "BUY_LOWER",
+		Descending:   true,
+		Page:         2,
+		PageSize:     2,
+	})
+	require.NoError(t, err)
+	require.Len(t, resp, 1)
+	assert.Equal(t, "btc-1", resp[0].ID)
+}
+
+func TestListStrategies_InvalidRequest(t *testing.T) {
+	tests := []struct {
+		name string
+		req  *ListStrategiesRequest
+	}{
+		{name: "unknown sort column", req: &ListStrategiesRequest{SortBy: "cooldown"}},
+		{name: "negative page size", req: &ListStrategiesRequest{PageSize: -1}},
+		{name: "empty created range", req: &ListStrategiesRequest{
+			CreatedAfter:  time.Date(2025, 11, 2, 0, 0, 0, 0, time.UTC),
+			CreatedBefore: time.Date(2025, 11, 1, 0, 0, 0, 0, time.UTC),
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockLogger := new(testutil.MockLogger)
+			service := NewStrategyService(newRepository(t, btcStrategy()), testutil.NewMockMarkets(), testutil.NewMockAudits(), testutil.PassthroughTransactor{}, mockLogger)
+
+			mockLogger.On("Info", mock.Anything, mock.Anything).Return()
+			mockLogger.On("Error", mock.Anything, mock.Anything).Return()
+
+			resp, err := service.ListStrategies(context.Background(), tt.req)
+			var validationErr *domain.ValidationError
+			assert.ErrorAs(t, err, &validationErr)
+			assert.Nil(t, resp)
+		})
+	}
+}
+
+func TestCreateStrategy_RecordsAudit(t *testing.T) {
+	mockAudits := new(testutil.MockAuditRepository)
+	mockLogger := new(testutil.MockLogger)
+	service := NewStrategyService(memory.NewStrategyRepository(), testutil.NewMockMarkets(), mockAudits, testutil.PassthroughTransactor{}, mockLogger)
+
+	mockLogger.On("Info", mock.Anything, mock.Anything).Return()
+	mockAudits.On("Append", mock.Anything, mock.MatchedBy(func(a *domain.StrategyAudit) bool {
+		return a.StrategyID != "" && a.Action == domain.AuditCreate && a.Actor == "alice" &&
+			a.Before == "" && a.After != ""
+	})).Return(nil)
+
+	ctx := domain.WithActor(context.Background(), "alice")
+	_, err := service.CreateStrategy(ctx, &CreateStrategyRequest{
+		Symbol:    "BTC",
+		BuyLower:  decimal.NewFromInt(30000),
+		SellUpper: decimal.NewFromInt(50000),
+	})
+	assert.NoError(t, err)
+	mockAudits.AssertExpectations(t)
+}
+
+func TestToggleStrategy_RecordsBeforeAndAfter(t *testing.T) {
+	mockAudits := new(testutil.MockAuditRepository)
+	mockLogger := new(testutil.MockLogger)
+	service := NewStrategyService(newRepository(t, btcStrategy()), testutil.NewMockMarkets(), mockAudits, testutil.PassthroughTransactor{}, mockLogger)
+
+	mockLogger.On("Info", mock.Anything, mock.Anything).Return()
+	mockAudits.On("Append", mock.Anything, mock.MatchedBy(func(a *domain.StrategyAudit) bool {
+		before, after, err := a.Snapshots()
+		return err == nil && a.Action == domain.AuditToggle && before.IsActive && !after.IsActive
+	})).Return(nil)
+
+	resp, err := service.ToggleStrategy(context.Background(), "test-id")
+	assert.NoError(t, err)
+	assert.False(t, resp.IsActive)
+	mockAudits.AssertExpectations(t)
+}
+
+func TestDeleteStrategy_AuditErrorFailsTheChange(t *testing.T) {
+	mockAudits := new(testutil.MockAuditRepository)
+	mockLogger := new(testutil.MockLogger)
+	service := NewStrategyService(newRepository(t, btcStrategy()), testutil.NewMockMarkets(), mockAudits, testutil.PassthroughTransactor{}, mockLogger)
+
+	mockLogger.On("Info", mock.Anything, mock.Anything).Return()
+	mockLogger.On("Error", mock.Anything, mock.Anything).Return()
+	mockAudits.On("Append", mock.Anything, mock.Anything).Return(errors.New("disk full"))
+
+	err := service.DeleteStrategy(context.Background(), "test-id")
+	assert.EqualError(t, err, "disk full")
+}
+
+func TestGetStrategyHistory_Success(t *testing.T) {
+	mockAudits := new(testutil.MockAuditRepository)
+	mockLogger := new(testutil.MockLogger)
+	service := NewStrategyService(memory.NewStrategyRepository(), testutil.NewMockMarkets(), mockAudits, testutil.PassthroughTransactor{}, mockLogger)
+
+	before := &domain.Strategy{ID: "test-id", Symbol: "BTC/USDT", BuyLower: decimal.NewFromInt(30000), SellUpper: decimal.NewFromInt(50000)}
+	after := &domain.Strategy{ID: "test-id", Symbol: "BTC/USDT", BuyLower: decimal.NewFromInt(25000), SellUpper: decimal.NewFromInt(50000)}
+	entry, err := domain.NewStrategyAudit("audit-1", domain.AuditUpdate, "alice", before, after, time.Now())
+	assert.NoError(t, err)
+
+	mockLogger.On("Info", mock.Anything, mock.Anything).Return()
+	mockAudits.On("FindByStrategyID", mock.Anything, "test-id").Return([]*domain.StrategyAudit{entry}, nil)
+
+	resp, err := service.GetStrategyHistory(context.Background(), "test-id")
+	assert.NoError(t, err)
+	assert.Len(t, resp, 1)
+	assert.Equal(t, "UPDATE", resp[0].Action)
+	assert.Equal(t, "alice", resp[0].Actor)
+	assert.Equal(t, []FieldChange{{Field: "buy_lower", From: "30000", To: "25000"}}, resp[0].Changes)
+}
+
+func TestGetStrategyHistory_NotFound(t *testing.T) {
+	repo := memory.NewStrategyRepository()
+	mockAudits := new(testutil.MockAuditRepository)
+	mockLogger := new(testutil.MockLogger)
+	service := NewStrategyService(repo, testutil.NewMockMarkets(), mockAudits, testutil.PassthroughTransactor{}, mockLogger)
+
+	mockLogger.On("Info", mock.Anything, mock.Anything).Return()
+	mockLogger.On("Error", mock.Anything, mock.Anything).Return()
+	mockAudits.On("FindByStrategyID", mock.Anything, "unknown").Return([]*domain.StrategyAudit{}, nil)
+
+	resp, err := service.GetStrategyHistory(context.Background(), "unknown")
+	assert.Equal(t, domain.ErrStrategyNotFound, err)
+	assert.Nil(t, resp)
+}
+
+func TestGetStrategyHistory_StrategyWithoutAuditEntries(t *testing.T) {
+	repo := memory.NewStrategyRepository()
+	mockAudits := new(testutil.MockAuditRepository)
+	mockLogger := new(testutil.MockLogger)
+	service := NewStrategyService(repo, testutil.NewMockMarkets(), mockAudits, testutil.PassthroughTransactor{}, mockLogger)
+
+	_, err := repo.Create(context.Background(), &domain.Strategy{ID: "legacy-id", Symbol: "BTC/USDT", BuyLower: decimal.NewFromInt(30000), SellUpper: decimal.NewFromInt(50000)})
+	assert.NoError(t, err)
+	mockLogger.On("Info", mock.Anything, mock.Anything).Return()
+	mockAudits.On("FindByStrategyID", mock.Anything, "legacy-id").Return([]*domain.StrategyAudit{}, nil)
+
+	resp, err := service.GetStrategyHistory(context.Background(), "legacy-id")
+	assert.NoError(t, err)
+	assert.Empty(t, resp)
+	assert.NotNil(t, resp)
+}
+
+func TestRestoreStrategy_Success(t *testing.T) {
+	deletedAt := time.Now().Add(-time.Hour)
+	strategy := btcStrategy()
+	strategy.DeletedAt = &deletedAt
+	mockAudits := new(testutil.MockAuditRepository)
+	mockLogger := new(testutil.MockLogger)
+	service := NewStrategyService(newRepository(t, strategy), testutil.NewMockMarkets(), mockAudits, testutil.PassthroughTransactor{}, mockLogger)
+
+	mockLogger.On("Info", mock.Anything, mock.Anything).Return()
+	mockAudits.On("Append", mock.Anything, mock.MatchedBy(func(a *domain.StrategyAudit) bool {
+		return a.StrategyID == "test-id" && a.Action == domain.AuditRestore
+	})).Return(nil)
+
+	resp, err := service.RestoreStrategy(context.Background(), "test-id")
+	assert.NoError(t, err)
+	assert.Equal(t, "test-id", resp.ID)
+	assert.Nil(t, resp.DeletedAt)
+	mockAudits.AssertExpectations(t)
+}
+
+func TestRestoreStrategy_NotDeleted(t *testing.T) {
+	mockAudits := new(testutil.MockAuditRepository)
+	mockLogger := new(testutil.MockLogger)
+	service := NewStrategyService(newRepository(t, btcStrategy()), testutil.NewMockMarkets(), mockAudits, testutil.PassthroughTransactor{}, mockLogger)
 
 	mockLogger.On("Info", mock.Anything, mock.Anything).Return()
 	mockLogger.On("Error", mock.Anything, mock.Anything).Return()
-	mockRepo.On("FindByID", "test-id").Return(strategy, nil)
-	mockRepo.On("Update", mock.Anything).Return(nil, domain.ErrStrategyNotFound)
 
-	resp, err := service.ToggleStrategy("test-id")
+	resp, err := service.RestoreStrategy(context.Background(), "test-id")
+	assert.Equal(t, domain.ErrStrategyNotFound, err)
+	assert.Nil(t, resp)
+	mockAudits.AssertNotCalled(t, "Append", mock.Anything, mock.Anything)
+}
+
+func TestPurgeStrategies_UsesCutoff(t *testing.T) {
+	olderThan := 30 * 24 * time.Hour
+	deleted := func(id string, age time.Duration) *domain.Strategy {
+		deletedAt := time.Now().Add(-age)
+		strategy := btcStrategy()
+		strategy.ID = id
+		strategy.DeletedAt = &deletedAt
+		return strategy
+	}
+	repo := newRepository(t,
+		deleted("old", olderThan+time.Hour),
+		deleted("older", 2*olderThan),
+		deleted("recent", olderThan-time.Hour),
+		btcStrategy(),
+	)
+	mockLogger := new(testutil.MockLogger)
+	service := NewStrategyService(repo, testutil.NewMockMarkets(), testutil.NewMockAudits(), testutil.PassthroughTransactor{}, mockLogger)
+
+	mockLogger.On("Info", mock.Anything, mock.Anything).Return()
+
+	purged, err := service.PurgeStrategies(context.Background(), olderThan)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), purged)
+
+	remaining, err := repo.Find(context.Background(), repository.StrategyFilter{IncludeDeleted: true})
+	require.NoError(t, err)
+	require.Len(t, remaining, 2)
+	assert.ElementsMatch(t, []string{"recent", "test-id"}, []string{remaining[0].ID, remaining[1].ID})
+}
+
+func TestPurgeStrategies_NegativeAge(t *testing.T) {
+	deletedAt := time.Now().Add(-time.Hour)
+	strategy := btcStrategy()
+	strategy.DeletedAt = &deletedAt
+	repo := newRepository(t, strategy)
+	mockLogger := new(testutil.MockLogger)
+	service := NewStrategyService(repo, testutil.NewMockMarkets(), testutil.NewMockAudits(), testutil.PassthroughTransactor{}, mockLogger)
+
+	mockLogger.On("Info", mock.Anything, mock.Anything).Return()
+
+	_, err := service.PurgeStrategies(context.Background(), -time.Hour)
 	assert.Error(t, err)
+
+	remaining, err := repo.Find(context.Background(), repository.StrategyFilter{IncludeDeleted: true})
+	require.NoError(t, err)
+	assert.Len(t, remaining, 1)
+}
+
+func TestStrategyLifecycle_InMemoryRepository(t *testing.T) {
+	repo := memory.NewStrategyRepository()
+	mockLogger := new(testutil.MockLogger)
+	service := NewStrategyService(repo, testutil.NewMockMarkets(), testutil.NewMockAudits(), testutil.PassthroughTransactor{}, mockLogger)
+	ctx := context.Background()
+
+	mockLogger.On("Info", mock.Anything, mock.Anything).Return()
+	mockLogger.On("Error", mock.Anything, mock.Anything).Return()
+
+	created, err := service.CreateStrategy(ctx, &CreateStrategyRequest{
+		Symbol:    "btc",
+		BuyLower:  decimal.NewFromInt(30000),
+		SellUpper: decimal.NewFromInt(50000),
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "BTC/USDT", created.Symbol)
+	assert.Equal(t, 1, created.Version)
+
+	updated, err := service.UpdateStrategy(ctx, &UpdateStrategyRequest{
+		ID:        created.ID,
+		SellUpper: decimalPtr(decimal.NewFromInt(60000)),
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 2, updated.Version)
+	assert.Equal(t, "30000", updated.BuyLower.String())
+
+	// A writer still holding version 1 is turned away
+	stale := 1
+	_, err = service.UpdateStrategy(ctx, &UpdateStrategyRequest{
+		ID:              created.ID,
+		BuyLower:        decimalPtr(decimal.NewFromInt(31000)),
+		ExpectedVersion: &stale,
+	})
+	assert.ErrorIs(t, err, domain.ErrConcurrentModification)
+
+	toggled, err := service.ToggleStrategy(ctx, created.ID)
+	assert.NoError(t, err)
+	assert.False(t, toggled.IsActive)
+
+	assert.NoError(t, service.DeleteStrategy(ctx, created.ID))
+	_, err = service.GetStrategy(ctx, created.ID)
+	assert.ErrorIs(t, err, domain.ErrStrategyNotFound)
+
+	restored, err := service.RestoreStrategy(ctx, created.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, 5, restored.Version)
+
+	listed, err := service.ListStrategies(ctx, &ListStrategiesRequest{})
+	assert.NoError(t, err)
+	assert.Len(t, listed, 1)
+}
+
+// setupImport returns a service backed by an in-memory repository holding one
+// active BTC/USDT strategy with ID btc-1.
+func setupImport(t *testing.T) (*StrategyService, repository.IStrategyRepository, *testutil.MockAuditRepository) {
+	repo := memory.NewStrategyRepository()
+	audits := testutil.NewMockAudits()
+
+	_, err := repo.Create(context.Background(), &domain.Strategy{
+		ID:        "btc-1",
+		Symbol:    "BTC/USDT",
+		BuyLower:  decimal.NewFromInt(30000),
+		SellUpper: decimal.NewFromInt(50000),
+		IsActive:  true,
+	})
+	assert.NoError(t, err)
+
+	return NewStrategyService(repo, testutil.NewMockMarkets(), audits, testutil.PassthroughTransactor{}, testutil.NewMockLogger()), repo, audits
+}
+
+func TestImportStrategies_CreatesAndUpdates(t *testing.T) {
+	service, repo, audits := setupImport(t)
+	ctx := context.Background()
+
+	resp, err := service.ImportStrategies(ctx, &ImportStrategiesRequest{
+		Upsert: true,
+		Rows: []*ImportStrategyRow{
+			{Row: 2, ID: "btc-1", Symbol: "BTC/USDT", BuyLower: "31000", SellUpper: "50000"},
+			{Row: 3, Symbol: "btc", BuyLower: "20000.50", SellUpper: "40000", Cooldown: "30m", Active: "false"},
+			{Row: 4, ID: "btc-2", Symbol: "BTC/USDT", BuyLower: "1", SellUpper: "2"},
+		},
+	})
+	assert.NoError(t, err)
+	assert.Empty(t, resp.Errors)
+	if assert.Len(t, resp.Results, 3) {
+		assert.Equal(t, ImportUpdated, resp.Results[0].Action)
+		assert.Equal(t, 2, resp.Results[0].Strategy.Version)
+		assert.True(t, resp.Results[0].Strategy.IsActive)
+
+		assert.Equal(t, ImportCreated, resp.Results[1].Action)
+		assert.Equal(t, 3, resp.Results[1].Row)
+		assert.NotEmpty(t, resp.Results[1].Strategy.ID)
+		assert.Equal(t, "BTC/USDT", resp.Results[1].Strategy.Symbol)
+		assert.Equal(t, 30*time.Minute, resp.Results[1].Strategy.Cooldown)
+		assert.False(t, resp.Results[1].Strategy.IsActive)
+
+		assert.Equal(t, ImportCreated, resp.Results[2].Action)
+		assert.Equal(t, "btc-2", resp.Results[2].Strategy.ID)
+	}
+
+	stored, err := repo.FindByID(ctx, "btc-1")
+	assert.NoError(t, err)
+	assert.Equal(t, "31000", stored.BuyLower.String())
+	all, err := repo.FindAll(ctx)
+	assert.NoError(t, err)
+	assert.Len(t, all, 3)
+	audits.AssertNumberOfCalls(t, "Append", 3)
+}
+
+func TestImportStrategies_UnchangedRowIsNotSaved(t *testing.T) {
+	service, repo, audits := setupImport(t)
+	ctx := context.Background()
+
+	resp, err := service.ImportStrategies(ctx, &ImportStrategiesRequest{
+		Upsert: true,
+		Rows: []*ImportStrategyRow{
+			{Row: 1, ID: "btc-1", Symbol: "BTC/USDT", BuyLower: "30000", SellUpper: "50000", Cooldown: "10m", Active: "true"},
+		},
+	})
+	assert.NoError(t, err)
+	if assert.Len(t, resp.Results, 1) {
+		assert.Equal(t, ImportUnchanged, resp.Results[0].Action)
+	}
+
+	stored, err := repo.FindByID(ctx, "btc-1")
+	assert.NoError(t, err)
+	assert.Equal(t, 1, stored.Version)
+	audits.AssertNotCalled(t, "Append", mock.Anything, mock.Anything)
+}
+
+func TestImportStrategies_InvalidRowsSaveNothing(t *testing.T) {
+	service, repo, audits := setupImport(t)
+	ctx := context.Background()
+
+	resp, err := service.ImportStrategies(ctx, &ImportStrategiesRequest{
+		Upsert: true,
+		Rows: []*ImportStrategyRow{
+			{Row: 1, Symbol: "BTC/USDT", BuyLower: "30000", SellUpper: "50000"},
+			{Row: 2, Symbol: "BTC/USDT", BuyLower: "50000", SellUpper: "30000"},
+			{Row: 3, Symbol: "BTC/USDT", BuyLower: "abc", SellUpper: "30000"},
+			{Row: 4, Symbol: "DOGE/USDT", BuyLower: "1", SellUpper: "2"},
+			{Row: 5, Symbol: "BTC/USDT", BuyLower: "1", SellUpper: "2", Cooldown: "soon"},
+			{Row: 6, Symbol: "BTC/USDT", BuyLower: "1", SellUpper: "2", Active: "maybe"},
+			{Row: 7, Symbol: "", BuyLower: "1", SellUpper: "2"},
+			{Row: 8, ID: "btc-1", Symbol: "BTC/USDT", BuyLower: "1", SellUpper: "2"},
+			{Row: 9, ID: "btc-1", Symbol: "BTC/USDT", BuyLower: "1", SellUpper: "2"},
+		},
+	})
+	assert.NoError(t, err)
+	assert.Len(t, resp.Results, 2)
+	rows := make([]int, len(resp.Errors))
+	for i, rowErr := range resp.Errors {
+		rows[i] = rowErr.Row
+		var validationErr *domain.ValidationError
+		assert.ErrorAs(t, rowErr.Err, &validationErr)
+	}
+	assert.Equal(t, []int{2, 3, 4, 5, 6, 7, 9}, rows)
+	assert.ErrorIs(t, resp.Errors[2].Err, domain.ErrMarketNotFound)
+	assert.Contains(t, resp.Errors[6].Err.Error(), "row 8")
+
+	all, err := repo.FindAll(ctx)
+	assert.NoError(t, err)
+	assert.Len(t, all, 1)
+	assert.Equal(t, "30000", all[0].BuyLower.String())
+	audits.AssertNotCalled(t, "Append", mock.Anything, mock.Anything)
+}
+
+func TestImportStrategies_ExistingIDRequiresUpsert(t *testing.T) {
+	service, _, _ := setupImport(t)
+
+	resp, err := service.ImportStrategies(context.Background(), &ImportStrategiesRequest{
+		Rows: []*ImportStrategyRow{
+			{Row: 1, ID: "btc-1", Symbol: "BTC/USDT", BuyLower: "31000", SellUpper: "50000"},
+		},
+	})
+	assert.NoError(t, err)
+	if assert.Len(t, resp.Errors, 1) {
+		assert.Contains(t, resp.Errors[0].Err.Error(), "already exists")
+	}
+}
+
+func TestImportStrategies_DeletedID(t *testing.T) {
+	service, repo, _ := setupImport(t)
+	ctx := context.Background()
+	assert.NoError(t, repo.Delete(ctx, "btc-1"))
+
+	resp, err := service.ImportStrategies(ctx, &ImportStrategiesRequest{
+		Upsert: true,
+		Rows: []*ImportStrategyRow{
+			{Row: 1, ID: "btc-1", Symbol: "BTC/USDT", BuyLower: "31000", SellUpper: "50000"},
+		},
+	})
+	assert.NoError(t, err)
+	if assert.Len(t, resp.Errors, 1) {
+		assert.Contains(t, resp.Errors[0].Err.Error(), "is deleted")
+	}
+}
+
+func TestImportStrategies_Version(t *testing.T) {
+	service, repo, audits := setupImport(t)
+	ctx := context.Background()
+
+	_, err := repo.Update(ctx, &domain.Strategy{
+		ID:        "btc-1",
+		Symbol:    "BTC/USDT",
+		BuyLower:  decimal.NewFromInt(32000),
+		SellUpper: decimal.NewFromInt(50000),
+		IsActive:  true,
+		Version:   1,
+	})
+	assert.NoError(t, err)
+
+	resp, err := service.ImportStrategies(ctx, &ImportStrategiesRequest{
+		Upsert: true,
+		Rows: []*ImportStrategyRow{
+			{Row: 1, ID: "btc-1", Symbol: "BTC/USDT", BuyLower: "31000", SellUpper: "50000", Version: "1"},
+			{Row: 2, ID: "btc-2", Symbol: "BTC/USDT", BuyLower: "1", SellUpper: "2", Version: "7"},
+			{Row: 3, Symbol: "BTC/USDT", BuyLower: "1", SellUpper: "2", Version: "first"},
+		},
+	})
+	assert.NoError(t, err)
+	assert.Len(t, resp.Results, 1, "a version is ignored for a new strategy")
+	if assert.Len(t, resp.Errors, 2) {
+		assert.Equal(t, 1, resp.Errors[0].Row)
+		assert.ErrorIs(t, resp.Errors[0].Err, domain.ErrConcurrentModification)
+		var validationErr *domain.ValidationError
+		assert.ErrorAs(t, resp.Errors[0].Err, &validationErr)
+		assert.Contains(t, resp.Errors[0].Err.Error(), "expected version 1, found 2")
+		assert.Equal(t, 3, resp.Errors[1].Row)
+	}
+
+	stored, err := repo.FindByID(ctx, "btc-1")
+	assert.NoError(t, err)
+	assert.Equal(t, "32000", stored.BuyLower.String())
+	all, err := repo.FindAll(ctx)
+	assert.NoError(t, err)
+	assert.Len(t, all, 1)
+	audits.AssertNotCalled(t, "Append", mock.Anything, mock.Anything)
+
+	resp, err = service.ImportStrategies(ctx, &ImportStrategiesRequest{
+		Upsert: true,
+		Rows: []*ImportStrategyRow{
+			{Row: 1, ID: "btc-1", Symbol: "BTC/USDT", BuyLower: "31000", SellUpper: "50000", Version: "2"},
+		},
+	})
+	assert.NoError(t, err)
+	assert.Empty(t, resp.Errors)
+	if assert.Len(t, resp.Results, 1) {
+		assert.Equal(t, ImportUpdated, resp.Results[0].Action)
+		assert.Equal(t, 3, resp.Results[0].Strategy.Version)
+	}
+}
+
+func TestImportStrategies_DryRunSavesNothing(t *testing.T) {
+	service, repo, audits := setupImport(t)
+	ctx := context.Background()
+
+	resp, err := service.ImportStrategies(ctx, &ImportStrategiesRequest{
+		DryRun: true,
+		Upsert: true,
+		Rows: []*ImportStrategyRow{
+			{Row: 1, ID: "btc-1", Symbol: "BTC/USDT", BuyLower: "31000", SellUpper: "50000"},
+			{Row: 2, Symbol: "BTC/USDT", BuyLower: "1", SellUpper: "2"},
+		},
+	})
+	assert.NoError(t, err)
+	assert.Empty(t, resp.Errors)
+	if assert.Len(t, resp.Results, 2) {
+		assert.Equal(t, ImportUpdated, resp.Results[0].Action)
+		assert.Equal(t, ImportCreated, resp.Results[1].Action)
+	}
+
+	all, err := repo.FindAll(ctx)
+	assert.NoError(t, err)
+	assert.Len(t, all, 1)
+	assert.Equal(t, "30000", all[0].BuyLower.String())
+	audits.AssertNotCalled(t, "Append", mock.Anything, mock.Anything)
+}
+
+func TestImportStrategies_RepositoryErrorAborts(t *testing.T) {
+	repo := &failingRepository{IStrategyRepository: memory.NewStrategyRepository(), createErr: errors.New("disk full")}
+	mockLogger := new(testutil.MockLogger)
+	service := NewStrategyService(repo, testutil.NewMockMarkets(), testutil.NewMockAudits(), testutil.PassthroughTransactor{}, mockLogger)
+
+	mockLogger.On("Info", mock.Anything, mock.Anything).Return()
+	mockLogger.On("Error", mock.Anything, mock.Anything).Return()
+
+	resp, err := service.ImportStrategies(context.Background(), &ImportStrategiesRequest{
+		Rows: []*ImportStrategyRow{
+			{Row: 1, Symbol: "BTC/USDT", BuyLower: "1", SellUpper: "2"},
+		},
+	})
+	assert.EqualError(t, err, "row 1: disk full")
 	assert.Nil(t, resp)
 }
+
+func decimalPtr(d decimal.Decimal) *decimal.Decimal {
+	return &d
+}
@@ -0,0 +1,44 @@
+package trade
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// RecordTradeRequest represents the request to record an executed trade.
+type RecordTradeRequest struct {
+	Symbol     string          // BTC, ETH, USDT, etc.
+	Side       string          // BUY or SELL
+	Quantity   decimal.Decimal // Amount of the asset bought or sold
+	Price      decimal.Decimal // Price per unit
+	Fee        decimal.Decimal // Fee paid, in the quote currency
+	StrategyID string          // Optional: strategy the trade was made for
+	ExecutedAt time.Time       // Optional: when the order was filled, defaults to now
+}
+
+// ListTradesRequest represents the filters, ordering and page of a trade history query.
+// Zero-valued fields are ignored.
+type ListTradesRequest struct {
+	Symbol     string    // Only trades of this symbol
+	Side       string    // Only BUY or only SELL trades
+	StrategyID string    // Only trades made for this strategy
+	From       time.Time // Only trades executed at or after this time
+	To         time.Time // Only trades executed before this time
+	SortBy     string    // executed_at, symbol, quantity or price; defaults to executed_at
+	Descending bool      // Sort in descending order
+	Page       int       // 1-based page number, requires PageSize
+	PageSize   int       // Trades per page, zero returns every match
+}
+
+// TradeResponse represents the response containing trade data.
+type TradeResponse struct {
+	ID         string          // Unique identifier
+	Symbol     string          // BTC, ETH, USDT, etc.
+	Side       string          // BUY or SELL
+	Quantity   decimal.Decimal // Amount of the asset bought or sold
+	Price      decimal.Decimal // Price per unit
+	Fee        decimal.Decimal // Fee paid, in the quote currency
+	StrategyID string          // Strategy the trade was made for, empty if none
+	ExecutedAt time.Time       // When the order was filled
+}
@@ -0,0 +1,243 @@
+package trade
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"transaction/internal/adapter/repository"
+	"transaction/internal/domain"
+	"transaction/pkg/logger"
+
+	"github.com/google/uuid"
+)
+
+// TradeService implements business logic for recording manual trades.
+type TradeService struct {
+	repo    repository.ITradeRepository
+	markets repository.IMarketRepository
+	tx      repository.ITransactor
+	logger  logger.Logger
+}
+
+// NewTradeService creates a new instance of TradeService. Trades are recorded
+// under the canonical symbol of a registered market. A sell is checked against
+// the holdings in the same transaction that records it.
+func NewTradeService(
+	repo repository.ITradeRepository,
+	markets repository.IMarketRepository,
+	tx repository.ITransactor,
+	logger logger.Logger,
+) *TradeService {
+	return &TradeService{
+		repo:    repo,
+		markets: markets,
+		tx:      tx,
+		logger:  logger,
+	}
+}
+
+// RecordTrade records a trade executed on an exchange.
+func (s *TradeService) RecordTrade(ctx context.Context, req *RecordTradeRequest) (*TradeResponse, error) {
+	s.logger.Info("Recording trade", "symbol", req.Symbol, "side", req.Side)
+
+	executedAt := req.ExecutedAt
+	if executedAt.IsZero() {
+		executedAt = time.Now()
+	}
+
+	market, err := s.findMarket(ctx, req.Symbol)
+	if err != nil {
+		s.logger.Error("Trade validation failed", "error", err.Error())
+		return nil, err
+	}
+
+	trade := &domain.Trade{
+		ID:         uuid.New().String(),
+		Symbol:     market.Symbol,
+		Side:       domain.Side(strings.ToUpper(req.Side)),
+		Quantity:   req.Quantity,
+		Price:      req.Price,
+		Fee:        req.Fee,
+		ExecutedAt: executedAt,
+	}
+	if req.StrategyID != "" {
+		strategyID := req.StrategyID
+		trade.StrategyID = &strategyID
+	}
+
+	if err := validate(trade, market); err != nil {
+		s.logger.Error("Trade validation failed", "error", err.Error())
+		return nil, err
+	}
+
+	var created *domain.Trade
+	err = s.tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		if trade.Side == domain.SideSell {
+			if err := s.checkHoldings(ctx, trade); err != nil {
+				return err
+			}
+		}
+		var err error
+		created, err = s.repo.Create(ctx, trade)
+		return err
+	})
+	if errors.Is(err, domain.ErrInsufficientHoldings) {
+		s.logger.Error("Trade rejected", "error", err.Error())
+		return nil, err
+	}
+	if err != nil {
+		s.logger.Error("Failed to record trade", "error", err.Error())
+		return nil, err
+	}
+
+	return toResponse(created), nil
+}
+
+// GetTrade retrieves a trade by ID.
+func (s *TradeService) GetTrade(ctx context.Context, id string) (*TradeResponse, error) {
+	s.logger.Info("Fetching trade", "id", id)
+
+	trade, err := s.repo.FindByID(ctx, id)
+	if err != nil {
+		s.logger.Error("Trade not found", "id", id)
+		return nil, err
+	}
+
+	return toResponse(trade), nil
+}
+
+// ListTrades retrieves the trade history matching the request.
+func (s *TradeService) ListTrades(ctx context.Context, req *ListTradesRequest) ([]*TradeResponse, error) {
+	s.logger.Info("Listing trades", "symbol", req.Symbol, "side", req.Side)
+
+	filter, err := toFilter(req)
+	if err != nil {
+		s.logger.Error("Invalid trade history request", "error", err.Error())
+		return nil, domain.Invalid(err)
+	}
+
+	trades, err := s.repo.Find(ctx, filter)
+	if err != nil {
+		s.logger.Error("Failed to list trades", "error", err.Error())
+		return nil, err
+	}
+
+	responses := make([]*TradeResponse, len(trades))
+	for i, trade := range trades {
+		responses[i] = toResponse(trade)
+	}
+	return responses, nil
+}
+
+// toFilter validates a history request and converts it to a repository filter.
+func toFilter(req *ListTradesRequest) (repository.TradeFilter, error) {
+	filter := repository.TradeFilter{
+		StrategyID: req.StrategyID,
+		From:       req.From,
+		To:         req.To,
+		SortBy:     strings.ToLower(req.SortBy),
+		Descending: req.Descending,
+	}
+
+	if req.Symbol != "" {
+		filter.Symbol = domain.NormalizeSymbol(req.Symbol)
+	}
+
+	if req.Side != "" {
+		side := domain.Side(strings.ToUpper(req.Side))
+		if side != domain.SideBuy && side != domain.SideSell {
+			return filter, fmt.Errorf("side must be BUY or SELL")
+		}
+		filter.Side = side
+	}
+
+	switch filter.SortBy {
+	case "", repository.TradeSortExecutedAt, repository.TradeSortSymbol, repository.TradeSortQuantity, repository.TradeSortPrice:
+	default:
+		return filter, fmt.Errorf("cannot sort by %q: use executed_at, symbol, quantity or price", req.SortBy)
+	}
+
+	if !req.From.IsZero() && !req.To.IsZero() && !req.From.Before(req.To) {
+		return filter, fmt.Errorf("from must be before to")
+	}
+
+	if req.PageSize < 0 || req.Page < 0 {
+		return filter, fmt.Errorf("page and page size must not be negative")
+	}
+	if req.PageSize > 0 {
+		page := req.Page
+		if page == 0 {
+			page = 1
+		}
+		filter.Limit = req.PageSize
+		filter.Offset = (page - 1) * req.PageSize
+	}
+
+	return filter, nil
+}
+
+// findMarket resolves a user supplied symbol to a registered market. An
+// unknown market is a validation error. Disabled markets still accept trades,
+// so a position can be closed after its market stops taking new strategies.
+func (s *TradeService) findMarket(ctx context.Context, symbol string) (*domain.Market, error) {
+	if strings.TrimSpace(symbol) == "" {
+		return nil, domain.Invalid(errors.New("symbol must not be empty"))
+	}
+	market, err := s.markets.FindBySymbol(ctx, domain.NormalizeSymbol(symbol))
+	if err != nil {
+		if errors.Is(err, domain.ErrMarketNotFound) {
+			return nil, domain.Invalid(fmt.Errorf("%w: %q is not a registered trading pair", domain.ErrMarketNotFound, symbol))
+		}
+		return nil, err
+	}
+	return market, nil
+}
+
+// validate checks the trade on its own and against the trading rules of its
+// market, reporting a problem as a validation error.
+func validate(trade *domain.Trade, market *domain.Market) error {
+	if err := trade.Validate(); err != nil {
+		return domain.Invalid(err)
+	}
+	if err := market.CheckQuantity("quantity", trade.Quantity); err != nil {
+		return domain.Invalid(err)
+	}
+	if err := market.CheckPrice("price", trade.Price); err != nil {
+		return domain.Invalid(err)
+	}
+	if err := market.CheckNotional(trade.Quantity, trade.Price); err != nil {
+		return domain.Invalid(err)
+	}
+	return nil
+}
+
+// checkHoldings replays the symbol's trades together with the new sell and
+// returns ErrInsufficientHoldings if the position would go negative at any point.
+func (s *TradeService) checkHoldings(ctx context.Context, sell *domain.Trade) error {
+	trades, err := s.repo.FindBySymbol(ctx, sell.Symbol)
+	if err != nil {
+		return err
+	}
+	_, err = domain.BuildPositions(append(trades, sell))
+	return err
+}
+
+// toResponse converts a domain Trade to a TradeResponse.
+func toResponse(t *domain.Trade) *TradeResponse {
+	resp := &TradeResponse{
+		ID:         t.ID,
+		Symbol:     t.Symbol,
+		Side:       string(t.Side),
+		Quantity:   t.Quantity,
+		Price:      t.Price,
+		Fee:        t.Fee,
+		ExecutedAt: t.ExecutedAt,
+	}
+	if t.StrategyID != nil {
+		resp.StrategyID = *t.StrategyID
+	}
+	return resp
+}
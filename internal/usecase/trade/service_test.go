@@ -0,0 +1,365 @@
+package trade
+
+import (
+	"context"
+	"testing"
+	"time"
+	"transaction/internal/adapter/repository"
+	"transaction/internal/domain"
+	"transaction/internal/testutil"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockTradeRepository is a mock implementation of ITradeRepository.
+type MockTradeRepository struct {
+	mock.Mock
+}
+
+func (m *MockTradeRepository) Create(ctx context.Context, trade *domain.Trade) (*domain.Trade, error) {
+	args := m.Called(ctx, trade)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Trade), args.Error(1)
+}
+
+func (m *MockTradeRepository) FindByID(ctx context.Context, id string) (*domain.Trade, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Trade), args.Error(1)
+}
+
+func (m *MockTradeRepository) FindAll(ctx context.Context) ([]*domain.Trade, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.Trade), args.Error(1)
+}
+
+func (m *MockTradeRepository) FindBySymbol(ctx context.Context, symbol string) ([]*domain.Trade, error) {
+	args := m.Called(ctx, symbol)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.Trade), args.Error(1)
+}
+
+func (m *MockTradeRepository) Find(ctx context.Context, filter repository.TradeFilter) ([]*domain.Trade, error) {
+	args := m.Called(ctx, filter)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.Trade), args.Error(1)
+}
+
+// newMockMarkets returns a market registry holding BTC/USDT and ETH/USDT.
+func newMockMarkets() *testutil.MockMarketRepository {
+	return testutil.NewMockMarkets(testutil.BTCUSDT(),
+		domain.NewMarket("ETH", "USDT", decimal.RequireFromString("0.01"), decimal.RequireFromString("0.0001"), decimal.NewFromInt(5)))
+}
+
+func TestRecordTrade_Success(t *testing.T) {
+	mockRepo := new(MockTradeRepository)
+	mockLogger := new(testutil.MockLogger)
+	service := NewTradeService(mockRepo, newMockMarkets(), testutil.PassthroughTransactor{}, mockLogger)
+
+	executedAt := time.Date(2025, 11, 5, 10, 0, 0, 0, time.UTC)
+	req := &RecordTradeRequest{
+		Symbol:     " btc ",
+		Side:       "buy",
+		Quantity:   decimal.RequireFromString("0.5"),
+		Price:      decimal.NewFromInt(60000),
+		Fee:        decimal.RequireFromString("12.5"),
+		StrategyID: "strategy-1",
+		ExecutedAt: executedAt,
+	}
+
+	strategyID := "strategy-1"
+	created := &domain.Trade{ID: "trade-1", Symbol: "BTC/USDT", Side: domain.SideBuy, Quantity: decimal.RequireFromString("0.5"),
+		Price: decimal.NewFromInt(60000), Fee: decimal.RequireFromString("12.5"), StrategyID: &strategyID, ExecutedAt: executedAt}
+
+	mockLogger.On("Info", mock.Anything, mock.Anything).Return()
+	mockRepo.On("Create", mock.Anything, mock.MatchedBy(func(t *domain.Trade) bool {
+		return t.ID != "" && t.Symbol == "BTC/USDT" && t.Side == domain.SideBuy &&
+			t.StrategyID != nil && *t.StrategyID == "strategy-1" && t.ExecutedAt.Equal(executedAt)
+	})).Return(created, nil)
+
+	resp, err := service.RecordTrade(context.Background(), req)
+	assert.NoError(t, err)
+	assert.Equal(t, "BTC/USDT", resp.Symbol)
+	assert.Equal(t, "BUY", resp.Side)
+	assert.Equal(t, "0.5", resp.Quantity.String())
+	assert.Equal(t, "strategy-1", resp.StrategyID)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestRecordTrade_DefaultsExecutionTimeToNow(t *testing.T) {
+	mockRepo := new(MockTradeRepository)
+	mockLogger := new(testutil.MockLogger)
+	service := NewTradeService(mockRepo, newMockMarkets(), testutil.PassthroughTransactor{}, mockLogger)
+
+	before := time.Now()
+	mockLogger.On("Info", mock.Anything, mock.Anything).Return()
+	mockRepo.On("FindBySymbol", mock.Anything, "ETH/USDT").Return([]*domain.Trade{
+		{Symbol: "ETH/USDT", Side: domain.SideBuy, Quantity: decimal.NewFromInt(2), Price: decimal.NewFromInt(2500), ExecutedAt: before.Add(-time.Hour)},
+	}, nil)
+	mockRepo.On("Create", mock.Anything, mock.MatchedBy(func(t *domain.Trade) bool {
+		return !t.ExecutedAt.Before(before) && t.StrategyID == nil
+	})).Return(&domain.Trade{ID: "trade-1", Symbol: "ETH/USDT", Side: domain.SideSell}, nil)
+
+	resp, err := service.RecordTrade(context.Background(), &RecordTradeRequest{Symbol: "ETH", Side: "SELL", Quantity: decimal.NewFromInt(1), Price: decimal.NewFromInt(3000)})
+	assert.NoError(t, err)
+	assert.Empty(t, resp.StrategyID)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestRecordTrade_InvalidQuantity(t *testing.T) {
+	mockRepo := new(MockTradeRepository)
+	mockLogger := new(testutil.MockLogger)
+	service := NewTradeService(mockRepo, newMockMarkets(), testutil.PassthroughTransactor{}, mockLogger)
+
+	mockLogger.On("Info", mock.Anything, mock.Anything).Return()
+	mockLogger.On("Error", mock.Anything, mock.Anything).Return()
+
+	resp, err := service.RecordTrade(context.Background(), &RecordTradeRequest{Symbol: "BTC", Side: "BUY", Quantity: decimal.Zero, Price: decimal.NewFromInt(60000)})
+	assert.Error(t, err)
+	assert.Nil(t, resp)
+	mockRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+}
+
+func TestGetTrade_NotFound(t *testing.T) {
+	mockRepo := new(MockTradeRepository)
+	mockLogger := new(testutil.MockLogger)
+	service := NewTradeService(mockRepo, newMockMarkets(), testutil.PassthroughTransactor{}, mockLogger)
+
+	mockLogger.On("Info", mock.Anything, mock.Anything).Return()
+	mockLogger.On("Error", mock.Anything, mock.Anything).Return()
+	mockRepo.On("FindByID", mock.Anything, "missing").Return(nil, domain.ErrTradeNotFound)
+
+	resp, err := service.GetTrade(context.Background(), "missing")
+	assert.ErrorIs(t, err, domain.ErrTradeNotFound)
+	assert.Nil(t, resp)
+}
+
+func TestRecordTrade_SellExceedingHoldings(t *testing.T) {
+	mockRepo := new(MockTradeRepository)
+	mockLogger := new(testutil.MockLogger)
+	service := NewTradeService(mockRepo, newMockMarkets(), testutil.PassthroughTransactor{}, mockLogger)
+
+	executedAt := time.Date(2025, 11, 5, 10, 0, 0, 0, time.UTC)
+
+	mockLogger.On("Info", mock.Anything, mock.Anything).Return()
+	mockLogger.On("Error", mock.Anything, mock.Anything).Return()
+	mockRepo.On("FindBySymbol", mock.Anything, "BTC/USDT").Return([]*domain.Trade{
+		{Symbol: "BTC/USDT", Side: domain.SideBuy, Quantity: decimal.RequireFromString("0.5"), Price: decimal.NewFromInt(60000), ExecutedAt: executedAt.Add(-time.Hour)},
+	}, nil)
+
+	resp, err := service.RecordTrade(context.Background(), &RecordTradeRequest{
+		Symbol: "BTC", Side: "SELL", Quantity: decimal.NewFromInt(1), Price: decimal.NewFromInt(65000), ExecutedAt: executedAt,
+	})
+	assert.ErrorIs(t, err, domain.ErrInsufficientHoldings)
+	assert.Nil(t, resp)
+	mockRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+}
+
+// txKey marks the context of a unit of work run by markingTransactor.
+type txKey struct{}
+
+// markingTransactor runs the unit of work with a context marked by txKey.
+type markingTransactor struct{}
+
+func (markingTransactor) WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(context.WithValue(ctx, txKey{}, true))
+}
+
+func TestRecordTrade_SellChecksHoldingsInTheTransaction(t *testing.T) {
+	mockRepo := new(MockTradeRepository)
+	mockLogger := testutil.NewMockLogger()
+	service := NewTradeService(mockRepo, newMockMarkets(), markingTransactor{}, mockLogger)
+
+	executedAt := time.Date(2025, 11, 5, 10, 0, 0, 0, time.UTC)
+	inTx := mock.MatchedBy(func(ctx context.Context) bool { return ctx.Value(txKey{}) != nil })
+
+	mockRepo.On("FindBySymbol", inTx, "BTC/USDT").Return([]*domain.Trade{
+		{Symbol: "BTC/USDT", Side: domain.SideBuy, Quantity: decimal.NewFromInt(1), Price: decimal.NewFromInt(60000), ExecutedAt: executedAt.Add(-time.Hour)},
+	}, nil)
+	mockRepo.On("Create", inTx, mock.Anything).Return(&domain.Trade{ID: "trade-1", Symbol: "BTC/USDT", Side: domain.SideSell}, nil)
+
+	_, err := service.RecordTrade(context.Background(), &RecordTradeRequest{
+		Symbol: "BTC", Side: "SELL", Quantity: decimal.RequireFromString("0.5"), Price: decimal.NewFromInt(65000), ExecutedAt: executedAt,
+	})
+	assert.NoError(t, err)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestRecordTrade_BackdatedSellBeforeBuy(t *testing.T) {
+	mockRepo := new(MockTradeRepository)
+	mockLogger := new(testutil.MockLogger)
+	service := NewTradeService(mockRepo, newMockMarkets(), testutil.PassthroughTransactor{}, mockLogger)
+
+	boughtAt := time.Date(2025, 11, 5, 10, 0, 0, 0, time.UTC)
+
+	mockLogger.On("Info", mock.Anything, mock.Anything).Return()
+	mockLogger.On("Error", mock.Anything, mock.Anything).Return()
+	mockRepo.On("FindBySymbol", mock.Anything, "BTC/USDT").Return([]*domain.Trade{
+		{Symbol: "BTC/USDT", Side: domain.SideBuy, Quantity: decimal.NewFromInt(1), Price: decimal.NewFromInt(60000), ExecutedAt: boughtAt},
+	}, nil)
+
+	_, err := service.RecordTrade(context.Background(), &RecordTradeRequest{
+		Symbol: "BTC", Side: "SELL", Quantity: decimal.NewFromInt(1), Price: decimal.NewFromInt(65000), ExecutedAt: boughtAt.Add(-time.Hour),
+	})
+	assert.ErrorIs(t, err, domain.ErrInsufficientHoldings)
+}
+
+func TestRecordTrade_SellUnderAnotherSpellingOfTheSymbol(t *testing.T) {
+	mockRepo := new(MockTradeRepository)
+	mockLogger := new(testutil.MockLogger)
+	service := NewTradeService(mockRepo, newMockMarkets(), testutil.PassthroughTransactor{}, mockLogger)
+
+	boughtAt := time.Date(2025, 11, 5, 10, 0, 0, 0, time.UTC)
+
+	mockLogger.On("Info", mock.Anything, mock.Anything).Return()
+	mockRepo.On("FindBySymbol", mock.Anything, "BTC/USDT").Return([]*domain.Trade{
+		{Symbol: "BTC/USDT", Side: domain.SideBuy, Quantity: decimal.RequireFromString("0.5"), Price: decimal.NewFromInt(60000), ExecutedAt: boughtAt},
+	}, nil)
+	mockRepo.On("Create", mock.Anything, mock.MatchedBy(func(t *domain.Trade) bool {
+		return t.Symbol == "BTC/USDT"
+	})).Return(&domain.Trade{ID: "trade-2", Symbol: "BTC/USDT", Side: domain.SideSell}, nil)
+
+	for _, symbol := range []string{"btc-usdt", "BTC_USDT"} {
+		_, err := service.RecordTrade(context.Background(), &RecordTradeRequest{
+			Symbol: symbol, Side: "SELL", Quantity: decimal.RequireFromString("0.25"), Price: decimal.NewFromInt(65000), ExecutedAt: boughtAt.Add(time.Hour),
+		})
+		assert.NoError(t, err, symbol)
+	}
+	mockRepo.AssertNumberOfCalls(t, "Create", 2)
+}
+
+func TestRecordTrade_UnknownSymbol(t *testing.T) {
+	mockRepo := new(MockTradeRepository)
+	mockLogger := new(testutil.MockLogger)
+	service := NewTradeService(mockRepo, newMockMarkets(), testutil.PassthroughTransactor{}, mockLogger)
+
+	mockLogger.On("Info", mock.Anything, mock.Anything).Return()
+	mockLogger.On("Error", mock.Anything, mock.Anything).Return()
+
+	resp, err := service.RecordTrade(context.Background(), &RecordTradeRequest{
+		Symbol: "BTC/USD", Side: "BUY", Quantity: decimal.NewFromInt(1), Price: decimal.NewFromInt(60000),
+	})
+	assert.ErrorIs(t, err, domain.ErrMarketNotFound)
+	var validationErr *domain.ValidationError
+	assert.ErrorAs(t, err, &validationErr)
+	assert.Nil(t, resp)
+	mockRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+}
+
+func TestRecordTrade_MarketRules(t *testing.T) {
+	tests := []struct {
+		name     string
+		quantity string
+		price    string
+		wantErr  string
+	}{
+		{name: "quantity finer than the lot size", quantity: "0.123456", price: "60000", wantErr: "quantity 0.123456 must have at most 5 decimal places on BTC/USDT"},
+		{name: "price finer than the tick size", quantity: "0.1", price: "60000.001", wantErr: "price 60000.001 must have at most 2 decimal places on BTC/USDT"},
+		{name: "order below the minimum notional", quantity: "0.00001", price: "60000", wantErr: "order value 0.6 is below the BTC/USDT minimum notional 5 USDT"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := new(MockTradeRepository)
+			mockLogger := new(testutil.MockLogger)
+			service := NewTradeService(mockRepo, newMockMarkets(), testutil.PassthroughTransactor{}, mockLogger)
+
+			mockLogger.On("Info", mock.Anything, mock.Anything).Return()
+			mockLogger.On("Error", mock.Anything, mock.Anything).Return()
+
+			resp, err := service.RecordTrade(context.Background(), &RecordTradeRequest{
+				Symbol: "BTC", Side: "BUY", Quantity: decimal.RequireFromString(tt.quantity), Price: decimal.RequireFromString(tt.price),
+			})
+			assert.EqualError(t, err, tt.wantErr)
+			var validationErr *domain.ValidationError
+			assert.ErrorAs(t, err, &validationErr)
+			assert.Nil(t, resp)
+			mockRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func TestListTrades_BuildsFilter(t *testing.T) {
+	mockRepo := new(MockTradeRepository)
+	mockLogger := new(testutil.MockLogger)
+	service := NewTradeService(mockRepo, newMockMarkets(), testutil.PassthroughTransactor{}, mockLogger)
+
+	from := time.Date(2025, 10, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	trades := []*domain.Trade{
+		{ID: "trade-1", Symbol: "BTC/USDT", Side: domain.SideSell, Quantity: decimal.RequireFromString("0.1"), Price: decimal.NewFromInt(65000)},
+	}
+
+	mockLogger.On("Info", mock.Anything, mock.Anything).Return()
+	mockRepo.On("Find", mock.Anything, repository.TradeFilter{
+		Symbol:     "BTC/USDT",
+		Side:       domain.SideSell,
+		StrategyID: "strategy-1",
+		From:       from,
+		To:         to,
+		SortBy:     repository.TradeSortPrice,
+		Descending: true,
+		Limit:      20,
+		Offset:     40,
+	}).Return(trades, nil)
+
+	resp, err := service.ListTrades(context.Background(), &ListTradesRequest{
+		Symbol:     "btc",
+		Side:       "sell",
+		StrategyID: "strategy-1",
+		From:       from,
+		To:         to,
+		SortBy:     "PRICE",
+		Descending: true,
+		Page:       3,
+		PageSize:   20,
+	})
+	assert.NoError(t, err)
+	assert.Len(t, resp, 1)
+	assert.Equal(t, "SELL", resp[0].Side)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestListTrades_InvalidRequest(t *testing.T) {
+	from := time.Date(2025, 10, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name string
+		req  *ListTradesRequest
+	}{
+		{name: "unknown side", req: &ListTradesRequest{Side: "HOLD"}},
+		{name: "unknown sort column", req: &ListTradesRequest{SortBy: "fee"}},
+		{name: "empty date range", req: &ListTradesRequest{From: from, To: from}},
+		{name: "negative page size", req: &ListTradesRequest{PageSize: -1}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := new(MockTradeRepository)
+			mockLogger := new(testutil.MockLogger)
+			service := NewTradeService(mockRepo, newMockMarkets(), testutil.PassthroughTransactor{}, mockLogger)
+
+			mockLogger.On("Info", mock.Anything, mock.Anything).Return()
+			mockLogger.On("Error", mock.Anything, mock.Anything).Return()
+
+			resp, err := service.ListTrades(context.Background(), tt.req)
+			var validationErr *domain.ValidationError
+			assert.ErrorAs(t, err, &validationErr)
+			assert.Nil(t, resp)
+			mockRepo.AssertNotCalled(t, "Find", mock.Anything, mock.Anything)
+		})
+	}
+}
@@ -4,8 +4,49 @@ import (
 	"fmt"
 	"log"
 	"os"
+	"strings"
 )
 
+// Level is the minimum severity a logger writes.
+type Level int
+
+const (
+	// LevelInfo writes every message.
+	LevelInfo Level = iota
+
+	// LevelWarn writes warnings and errors.
+	LevelWarn
+
+	// LevelError writes errors only.
+	LevelError
+)
+
+// String returns the name of the level as accepted by ParseLevel.
+func (l Level) String() string {
+	switch l {
+	case LevelWarn:
+		return "warn"
+	case LevelError:
+		return "error"
+	default:
+		return "info"
+	}
+}
+
+// ParseLevel converts info, warn or error, in any case, to a Level.
+func ParseLevel(name string) (Level, error) {
+	switch strings.ToLower(strings.TrimSpace(name)) {
+	case "info":
+		return LevelInfo, nil
+	case "warn", "warning":
+		return LevelWarn, nil
+	case "error":
+		return LevelError, nil
+	default:
+		return LevelInfo, fmt.Errorf("invalid log level %q: use info, warn or error", name)
+	}
+}
+
 // Logger defines the logging interface for the application.
 type Logger interface {
 	// Info logs an informational message.
@@ -19,23 +60,35 @@ type Logger interface {
 }
 
 // SimpleLogger is a basic implementation of Logger using standard library log.
+// Every message goes to stderr, leaving stdout to the output of commands.
 type SimpleLogger struct {
+	level       Level
 	infoLogger  *log.Logger
 	errorLogger *log.Logger
 	warnLogger  *log.Logger
 }
 
-// NewSimpleLogger creates a new SimpleLogger instance.
+// NewSimpleLogger creates a new SimpleLogger instance writing every message.
 func NewSimpleLogger() *SimpleLogger {
+	return NewSimpleLoggerWithLevel(LevelInfo)
+}
+
+// NewSimpleLoggerWithLevel creates a new SimpleLogger instance that drops
+// messages below level.
+func NewSimpleLoggerWithLevel(level Level) *SimpleLogger {
 	return &SimpleLogger{
-		infoLogger:  log.New(os.Stdout, "[INFO] ", log.LstdFlags),
+		level:       level,
+		infoLogger:  log.New(os.Stderr, "[INFO] ", log.LstdFlags),
 		errorLogger: log.New(os.Stderr, "[ERROR] ", log.LstdFlags),
-		warnLogger:  log.New(os.Stdout, "[WARN] ", log.LstdFlags),
+		warnLogger:  log.New(os.Stderr, "[WARN] ", log.LstdFlags),
 	}
 }
 
 // Info logs an informational message.
 func (l *SimpleLogger) Info(msg string, args ...interface{}) {
+	if l.level > LevelInfo {
+		return
+	}
 	l.infoLogger.Println(formatMessage(msg, args...))
 }
 
@@ -46,6 +99,9 @@ func (l *SimpleLogger) Error(msg string, args ...interface{}) {
 
 // Warn logs a warning message.
 func (l *SimpleLogger) Warn(msg string, args ...interface{}) {
+	if l.level > LevelWarn {
+		return
+	}
 	l.warnLogger.Println(formatMessage(msg, args...))
 }
 
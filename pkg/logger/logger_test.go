@@ -1,6 +1,8 @@
 package logger
 
 import (
+	"bytes"
+	"log"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -89,3 +91,28 @@ func TestFormatMessage(t *testing.T) {
 		})
 	}
 }
+
+// TestParseLevel tests parsing level names
+func TestParseLevel(t *testing.T) {
+	level, err := ParseLevel("WARN")
+	assert.NoError(t, err)
+	assert.Equal(t, LevelWarn, level)
+	assert.Equal(t, "warn", level.String())
+
+	_, err = ParseLevel("verbose")
+	assert.Error(t, err)
+}
+
+// TestLoggerLevel tests that messages below the level are dropped
+func TestLoggerLevel(t *testing.T) {
+	var out bytes.Buffer
+	l := NewSimpleLoggerWithLevel(LevelWarn)
+	l.infoLogger = log.New(&out, "[INFO] ", 0)
+	l.warnLogger = log.New(&out, "[WARN] ", 0)
+	l.errorLogger = log.New(&out, "[ERROR] ", 0)
+
+	l.Info("hidden")
+	l.Warn("shown")
+	l.Error("also shown")
+	assert.Equal(t, "[WARN] shown\n[ERROR] also shown\n", out.String())
+}